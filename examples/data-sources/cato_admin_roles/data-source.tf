@@ -0,0 +1,21 @@
+## Providers ###
+provider "cato" {
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = var.cato_token
+  account_id = var.account_id
+}
+
+### Data Source Usage ###
+
+### Retrieve all admin roles ###
+data "cato_admin_roles" "all" {}
+
+### Retrieve custom admin roles only ###
+data "cato_admin_roles" "custom" {
+  include_predefined = false
+}
+
+### Retrieve admin roles by name ###
+data "cato_admin_roles" "viewer" {
+  name_filter = ["Viewer"]
+}
@@ -0,0 +1,26 @@
+## Providers ###
+provider "cato" {
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = var.cato_token
+  account_id = var.account_id
+}
+
+### Data Source Usage ###
+
+### Retrieve the status of all BGP peers of a site ###
+data "cato_bgp_peer_status" "all" {
+  site_id = cato_ipsec_site.site1.id
+}
+
+### Assert that peering is established after apply ###
+check "bgp_peer_established" {
+  data "cato_bgp_peer_status" "peer" {
+    site_id = cato_bgp_peer.bgp_peer.site_id
+    peer_ip = cato_bgp_peer.bgp_peer.peer_ip
+  }
+
+  assert {
+    condition     = alltrue([for peer in data.cato_bgp_peer_status.peer.peers : peer.established])
+    error_message = "BGP peering with ${cato_bgp_peer.bgp_peer.peer_ip} is not established"
+  }
+}
@@ -0,0 +1,21 @@
+## Providers ###
+provider "cato" {
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = var.cato_token
+  account_id = var.account_id
+}
+
+### Data Source Usage ###
+
+### Retrieve all predefined data types ###
+data "cato_dlp_data_types" "all" {}
+
+### Retrieve predefined data types by category ###
+data "cato_dlp_data_types" "pci" {
+  category_filter = ["PCI"]
+}
+
+### Retrieve predefined data types by name ###
+data "cato_dlp_data_types" "by_name" {
+  name_filter = ["Credit Card Number", "US Social Security Number"]
+}
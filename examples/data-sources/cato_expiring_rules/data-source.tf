@@ -0,0 +1,44 @@
+## Providers ###
+provider "cato" {
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = var.cato_token
+  account_id = var.account_id
+}
+
+### Data Source Usage ###
+
+### List rules expiring within the next 14 days ###
+### TLS inspection and WAN network rules only keep their lifecycle_window in Terraform, so pass it in ###
+data "cato_expiring_rules" "next_two_weeks" {
+  within_days = 14
+  lifecycle_windows = [
+    {
+      policy_type = "TLS_INSPECTION"
+      rule_id     = cato_tls_rule.bypass_banking.rule.id
+      expires_at  = cato_tls_rule.bypass_banking.lifecycle_window.expires_at
+    },
+    {
+      policy_type = "WAN_NETWORK"
+      rule_id     = cato_wnw_rule.migration.rule.id
+      expires_at  = cato_wnw_rule.migration.lifecycle_window.expires_at
+    },
+  ]
+}
+
+output "expiring_rules" {
+  value = [for rule in data.cato_expiring_rules.next_two_weeks.rules : "${rule.policy_type}/${rule.name} expires in ${rule.days_left} days"]
+}
+
+### Fail the run when expired Internet Firewall rules are still in the policy ###
+check "no_expired_if_rules" {
+  data "cato_expiring_rules" "expired" {
+    within_days     = 0
+    policy_types    = ["INTERNET_FIREWALL"]
+    include_expired = true
+  }
+
+  assert {
+    condition     = length(data.cato_expiring_rules.expired.rules) == 0
+    error_message = "Expired Internet Firewall rules: ${join(", ", [for rule in data.cato_expiring_rules.expired.rules : rule.name])}"
+  }
+}
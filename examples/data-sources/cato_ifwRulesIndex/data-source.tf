@@ -6,4 +6,14 @@ provider "cato" {
 }
 
 ### Data Source ###
-data "cato_ifwRulesIndex" "all_rules" {}
\ No newline at end of file
+data "cato_ifwRulesIndex" "all_rules" {}
+
+### Internet Firewall rules with no hits in the last 90 days ###
+data "cato_ifwRulesIndex" "with_hits" {
+  include_hit_counts      = true
+  hit_count_lookback_days = 90
+}
+
+output "unused_rules" {
+  value = [for rule in data.cato_ifwRulesIndex.with_hits.rules : rule.name if rule.hit_count == 0]
+}
\ No newline at end of file
@@ -0,0 +1,29 @@
+## Providers ###
+provider "cato" {
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = var.cato_token
+  account_id = var.account_id
+}
+
+### Data Source Usage ###
+
+### Analyze the Internet Firewall policy ###
+data "cato_policy_analysis" "if" {
+  policy_type = "INTERNET_FIREWALL"
+}
+
+output "if_findings" {
+  value = [for f in data.cato_policy_analysis.if.findings : "${f.type} #${f.rule_index} ${f.rule_name}: ${f.detail}"]
+}
+
+### Fail the run when a WAN Firewall rule is shadowed by an earlier rule ###
+check "no_shadowed_wf_rules" {
+  data "cato_policy_analysis" "wf" {
+    policy_type = "WAN_FIREWALL"
+  }
+
+  assert {
+    condition     = length(data.cato_policy_analysis.wf.shadowed_rule_ids) == 0
+    error_message = "Shadowed WAN Firewall rules: ${join(", ", [for f in data.cato_policy_analysis.wf.findings : "${f.rule_name} (by ${f.related_rule_name})" if f.type == "SHADOWED"])}"
+  }
+}
@@ -0,0 +1,21 @@
+## Providers ###
+provider "cato" {
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = var.cato_token
+  account_id = var.account_id
+}
+
+### Data Source Usage ###
+
+### Retrieve all SDP users ###
+data "cato_users" "all" {}
+
+### Retrieve SDP users by email ###
+data "cato_users" "by_email" {
+  email_filter = ["jane.doe@example.com", "john.doe@example.com"]
+}
+
+### Retrieve SDP users by name ###
+data "cato_users" "by_name" {
+  name_filter = ["Jane Doe"]
+}
@@ -0,0 +1,25 @@
+resource "cato_app_connector" "aws" {
+  name       = "aws-us-east-1"
+  group_name = "aws"
+  type       = "VIRTUAL"
+  location = {
+    city_name    = "Ashburn"
+    country_code = "US"
+    state_code   = "US-VA"
+    timezone     = "America/New_York"
+  }
+}
+
+// the token is generated on every run and never stored in the state
+ephemeral "cato_app_connector_token" "aws" {
+  connector_id = cato_app_connector.aws.id
+}
+
+// hand the token to the connector instance through a write-only attribute,
+// the instance reads it from the parameter store at boot
+resource "aws_ssm_parameter" "app_connector_token" {
+  name             = "/cato/app-connector/aws-us-east-1/token"
+  type             = "SecureString"
+  value_wo         = ephemeral.cato_app_connector_token.aws.token
+  value_wo_version = 1
+}
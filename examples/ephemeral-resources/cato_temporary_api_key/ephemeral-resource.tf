@@ -0,0 +1,13 @@
+// a read-only key for the duration of the run, deleted at the end of the run
+ephemeral "cato_temporary_api_key" "audit" {
+  name        = "terraform-audit"
+  role        = { name = "Viewer" }
+  ttl_minutes = 30
+}
+
+provider "cato" {
+  alias      = "audit"
+  baseurl    = "https://api.catonetworks.com/api/v1/graphql2"
+  token      = ephemeral.cato_temporary_api_key.audit.secret
+  account_id = var.account_id
+}
@@ -0,0 +1,21 @@
+resource "cato_admin_role" "firewall_operator" {
+  name        = "Firewall Operator"
+  description = "Manages the firewall policies, read-only access to sites and users"
+  permissions = [
+    { area = "INTERNET_FIREWALL", access = "EDIT" },
+    { area = "WAN_FIREWALL", access = "EDIT" },
+    { area = "SITES", access = "VIEW" },
+    { area = "USERS", access = "VIEW" },
+  ]
+}
+
+// assign the role to an admin
+resource "cato_admin" "firewall_admin" {
+  email      = "fw.admin@example.com"
+  first_name = "Jane"
+  last_name  = "Doe"
+
+  managed_roles = [
+    { id = cato_admin_role.firewall_operator.id },
+  ]
+}
@@ -0,0 +1,22 @@
+resource "cato_api_key" "ci_pipeline" {
+  name        = "CI Pipeline"
+  description = "Used by the network automation pipeline"
+  role        = { id = cato_admin_role.firewall_operator.id }
+  allowed_ips = ["198.51.100.0/24"]
+
+  // the key expires after 90 days and is rotated on the first apply after 60 days
+  expiration_days = 90
+  rotation_days   = 60
+
+  // keep the secret in the state, to pass it to the secret manager
+  store_secret = true
+}
+
+// store the secret in a secret manager, the secret changes when the key is rotated
+resource "vault_kv_secret_v2" "ci_pipeline_key" {
+  mount = "secret"
+  name  = "cato/ci-pipeline"
+  data_json = jsonencode({
+    api_key = cato_api_key.ci_pipeline.secret
+  })
+}
@@ -33,4 +33,29 @@ resource "cato_bgp_peer" "bgp_peer" {
     receive_interval  = 100
     multiplier        = 10
   }
-}
\ No newline at end of file
+  as_path_prepend = 2
+  inbound_filter = [
+    {
+      action = "ACCEPT"
+      prefix = "10.0.0.0/8"
+      ge     = 16
+      le     = 24
+    },
+    {
+      action = "DROP"
+      community = [
+        {
+          from = 65100
+          to   = 65199
+        }
+      ]
+    }
+  ]
+  outbound_filter = [
+    {
+      action = "ACCEPT"
+      prefix = "172.16.0.0/12"
+      le     = 24
+    }
+  ]
+}
@@ -0,0 +1,28 @@
+resource "cato_custom_app" "crm" {
+  name        = "Internal CRM"
+  description = "CRM SaaS tenant and the on-prem API gateway"
+  criteria = [
+    {
+      protocol = "TCP"
+      ports    = [443, 8443]
+      domains  = ["crm.example.com"]
+    },
+    {
+      protocol = "UDP"
+      port_range = {
+        from = 5000
+        to   = 5100
+      }
+      ip_ranges = [
+        {
+          from = "198.51.100.10"
+          to   = "198.51.100.20"
+        }
+      ]
+    },
+    {
+      fqdns   = ["api.crm.example.com"]
+      subnets = ["203.0.113.0/24"]
+    }
+  ]
+}
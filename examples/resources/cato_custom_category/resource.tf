@@ -0,0 +1,26 @@
+resource "cato_custom_category" "business_apps" {
+  name        = "Business Applications"
+  description = "Applications allowed for all employees"
+  applications = [
+    { id = "salesforce" },
+    { name = "Slack" },
+  ]
+  custom_apps = [
+    { id = cato_custom_app.crm.id },
+  ]
+  domains = ["example.com"]
+  fqdns   = ["portal.example.net"]
+}
+
+// reference the category in a TLS inspection rule
+resource "cato_tls_inspection_rule" "bypass_business_apps" {
+  # ...
+  rule = {
+    # ...
+    application = {
+      custom_category = [
+        { id = cato_custom_category.business_apps.id },
+      ]
+    }
+  }
+}
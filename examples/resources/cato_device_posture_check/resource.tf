@@ -0,0 +1,36 @@
+resource "cato_device_posture_check" "windows_antivirus" {
+  name        = "Windows Antivirus"
+  description = "Up-to-date antivirus with real-time protection"
+  platform    = "WINDOWS"
+  antivirus = {
+    real_time_protection     = true
+    max_definitions_age_days = 7
+  }
+}
+
+resource "cato_device_posture_check" "windows_version" {
+  name     = "Windows 10 22H2"
+  platform = "WINDOWS"
+  os_version = {
+    min_version = "10.0.19045"
+  }
+}
+
+resource "cato_device_posture_check" "corporate_certificate" {
+  name     = "Corporate Certificate"
+  platform = "WINDOWS"
+  certificate = {
+    issuer = "CN=Example Corp Issuing CA"
+    store  = "MACHINE"
+  }
+}
+
+resource "cato_device_posture_check" "managed_device" {
+  name     = "Managed Device"
+  platform = "WINDOWS"
+  registry = {
+    path       = "HKLM\\SOFTWARE\\Example\\Management"
+    value_name = "Enrolled"
+    value_data = "1"
+  }
+}
@@ -0,0 +1,17 @@
+resource "cato_device_posture_profile" "corporate_windows" {
+  name        = "Corporate Windows"
+  description = "Managed Windows devices"
+  checks = [
+    { id = cato_device_posture_check.windows_antivirus.id },
+    { id = cato_device_posture_check.windows_version.id },
+    { name = "Corporate Certificate" },
+  ]
+}
+
+// reference the profile in a private access rule
+resource "cato_private_access_rule" "corporate_only" {
+  # ...
+  devices = [
+    { id = cato_device_posture_profile.corporate_windows.id },
+  ]
+}
@@ -0,0 +1,32 @@
+data "cato_dlp_data_types" "pci" {
+  category_filter = ["PCI"]
+}
+
+resource "cato_dlp_content_profile" "credit_cards" {
+  name        = "Credit Cards"
+  description = "Documents with several credit card numbers"
+  operator    = "ANY"
+  conditions = [
+    for data_type in data.cato_dlp_data_types.pci.items : {
+      data_type = { id = data_type.id }
+      threshold = 5
+    }
+  ]
+}
+
+// reference the profile in an application control data rule
+resource "cato_application_control_rule" "block_credit_card_upload" {
+  # ...
+  rule = {
+    # ...
+    rule_type = "DATA"
+    data_rule = {
+      # ...
+      dlp_profile = {
+        content_profile = [
+          { id = cato_dlp_content_profile.credit_cards.id },
+        ]
+      }
+    }
+  }
+}
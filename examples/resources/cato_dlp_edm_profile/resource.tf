@@ -0,0 +1,27 @@
+// customers.csv:
+// name,email,account_number
+// Jane Doe,jane.doe@example.com,100200300
+resource "cato_dlp_edm_profile" "customers" {
+  name        = "Customer Records"
+  description = "Hashed customer records exported from the CRM"
+  source_file = "${path.module}/data/customers.csv"
+  delimiter   = ","
+  has_header  = true
+}
+
+// reference the profile in an application control data rule
+resource "cato_application_control_rule" "block_customer_records" {
+  # ...
+  rule = {
+    # ...
+    rule_type = "DATA"
+    data_rule = {
+      # ...
+      dlp_profile = {
+        edm_profile = [
+          { id = cato_dlp_edm_profile.customers.id },
+        ]
+      }
+    }
+  }
+}
@@ -0,0 +1,5 @@
+resource "cato_if_policy" "internet_firewall" {
+  enabled            = true
+  default_action     = "BLOCK"
+  log_implicit_rules = true
+}
@@ -0,0 +1,53 @@
+resource "cato_if_policy_document" "internet_firewall" {
+  sections = [
+    {
+      name  = "Block risky traffic"
+      rules = ["Block Gambling", "Block Tor"]
+    },
+    {
+      name  = "Allowed applications"
+      rules = ["Allow Office 365"]
+    },
+  ]
+
+  rules = {
+    "Block Gambling" = {
+      enabled = true
+      action  = "BLOCK"
+      source  = {}
+      destination = {
+        app_category = [{ name = "Gambling" }]
+      }
+      tracking = {
+        event = { enabled = true }
+      }
+    }
+    "Block Tor" = {
+      enabled = true
+      action  = "BLOCK"
+      source  = {}
+      destination = {
+        application = [{ name = "Tor" }]
+      }
+      tracking = {
+        event = { enabled = true }
+      }
+    }
+    "Allow Office 365" = {
+      description = "Office 365 for all users"
+      enabled     = true
+      action      = "ALLOW"
+      source      = {}
+      destination = {
+        application = [{ name = "Office365" }]
+      }
+      tracking = {
+        event = { enabled = false }
+      }
+    }
+  }
+}
+
+output "unmanaged_if_rules" {
+  value = cato_if_policy_document.internet_firewall.unmanaged_rules
+}
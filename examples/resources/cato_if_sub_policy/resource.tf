@@ -1,8 +1,8 @@
 // Internet Firewall sub-policy (a nested policy scoped by a SUB_POLICY_SCOPE rule).
 //
-// NOTE: The Cato API has no updateSubPolicy mutation, so changing `name` or
-// `description` forces replacement, which also removes any rules contained in
-// the sub-policy. The scope must set at least one non-ANY match field.
+// NOTE: Changing `name` or `description` renames the sub-policy in place
+// through its SUB_POLICY_SCOPE rule, the rules it contains are kept. The
+// scope must set at least one non-ANY match field.
 
 // minimal sub-policy
 resource "cato_if_sub_policy" "minimal" {
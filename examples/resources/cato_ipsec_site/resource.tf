@@ -62,4 +62,46 @@ resource "cato_ipsec_site" "test-dev-site" {
       ]
     }
   }
-}
\ No newline at end of file
+}
+// IKEv1 responder-only site with DPD and ICMP tunnel monitoring
+resource "cato_ipsec_site" "ikev1-site" {
+  name                 = "ipsec-ikev1-site"
+  site_type            = "BRANCH"
+  description          = "IPSec IKEv1 site"
+  native_network_range = "172.98.20.0/24"
+  site_location = {
+    country_code = "US"
+    state_code   = "US-NY"
+    timezone     = "America/New_York"
+  }
+  ipsec = {
+    ike_version         = "IKEV1"
+    connection_mode     = "RESPONDER_ONLY"
+    identification_type = "IPV4"
+    dpd = {
+      interval = 10
+      timeout  = 30
+    }
+    init_message = {
+      cipher    = "AES_CBC_256"
+      dh_group  = "DH_14_MODP2048"
+      integrity = "SHA256"
+    }
+    primary = {
+      public_cato_ip_id = "30111"
+      tunnels = [
+        {
+          public_site_ip = "1.1.1.3"
+          psk            = "abcde12345"
+          routing_type   = "POLICY_BASED"
+          monitoring = {
+            type              = "ICMP"
+            destination_ip    = "172.98.20.1"
+            interval          = 5
+            failure_threshold = 3
+          }
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,3 @@
+resource "cato_lf_policy" "socket_lan" {
+  enabled = true
+}
@@ -0,0 +1,20 @@
+data "cato_licensingInfo" "pooled" {
+  sku = "CATO_PB"
+}
+
+resource "cato_license_pool_allocation" "branches" {
+  license_id = data.cato_licensingInfo.pooled.licenses[0].id
+
+  // split 500 Mbps of the pool: the data center gets a fixed 200 Mbps,
+  // the branches share the rest by weight
+  total_bandwidth = 500
+  allocations = {
+    (cato_socket_site.data_center.id) = { bandwidth = 200 }
+    (cato_socket_site.branch_1.id)    = { weight = 2 }
+    (cato_socket_site.branch_2.id)    = { weight = 1 }
+  }
+}
+
+output "branch_allocations" {
+  value = cato_license_pool_allocation.branches.allocated_bandwidth
+}
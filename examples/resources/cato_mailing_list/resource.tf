@@ -0,0 +1,4 @@
+resource "cato_mailing_list" "security_team" {
+  name   = "Security Team"
+  emails = ["soc@example.com", "secops@example.com"]
+}
@@ -0,0 +1,28 @@
+// apps.csv, exported from the CMDB:
+// name,address,protocol,ports,port_from,port_to,connector_group,domain
+// crm,10.10.1.20,TCP,443,,,dc-east,crm.corp.example.com
+// file-share,10.10.2.5,TCP,445,,,dc-east,
+// voip-gw,10.10.3.7,UDP,,10000,20000,dc-west,
+locals {
+  apps = csvdecode(file("${path.module}/apps.csv"))
+}
+
+resource "cato_private_apps_bulk" "cmdb" {
+  apps = {
+    for app in local.apps : app.name => {
+      internal_app_address = app.address
+      protocol_ports = [{
+        protocol   = app.protocol
+        ports      = app.ports != "" ? [tonumber(app.ports)] : null
+        port_range = app.port_from != "" ? { from = tonumber(app.port_from), to = tonumber(app.port_to) } : null
+      }]
+      published_app_domain = app.domain != "" ? app.domain : null
+      connector_group_name = app.domain != "" ? app.connector_group : null
+    }
+  }
+}
+
+// apps that could not be applied, retried on the next apply
+output "private_app_errors" {
+  value = cato_private_apps_bulk.cmdb.errors
+}
@@ -0,0 +1,20 @@
+resource "cato_sdp_user" "jane" {
+  first_name   = "Jane"
+  last_name    = "Doe"
+  email        = "jane.doe@example.com"
+  phone_number = "+14155550100"
+  license_id   = "1234"
+  user_groups = [
+    { name = "Engineering" },
+  ]
+}
+
+// reference the user in a private access rule source
+resource "cato_private_access_rule" "jane_access" {
+  # ...
+  source = {
+    users = [
+      { id = cato_sdp_user.jane.id },
+    ]
+  }
+}
@@ -0,0 +1,18 @@
+// static route through a LAN interface selected by index
+resource "cato_site_static_route" "route1" {
+  site_id         = cato_socket_site.site1.id
+  name            = "datacenter"
+  subnet          = "192.168.50.0/24"
+  next_hop        = "10.10.0.254"
+  interface_index = "INT_5"
+}
+
+// static route through a LAN interface selected by ID, with a custom metric
+resource "cato_site_static_route" "route2" {
+  site_id      = cato_socket_site.site1.id
+  name         = "backup-datacenter"
+  subnet       = "192.168.60.0/24"
+  next_hop     = "10.10.0.253"
+  interface_id = cato_lan_interface.lan5.id
+  metric       = 10
+}
@@ -0,0 +1,98 @@
+// A standard Internet Firewall rule set defined once and instantiated in many
+// site-scoped sub-policies.
+//
+// NOTE: The template only exists in Terraform state. Every sub-policy that
+// sets `template = cato_sub_policy_template.<name>.ref` adds, updates, removes
+// and reorders its child rules (matched by rule name) to match the template,
+// in a single published revision per sub-policy. Child rules that are not in
+// the template are removed, so do not combine a template with cato_if_rule
+// resources that use the same sub_policy_id.
+resource "cato_sub_policy_template" "standard_site" {
+  name        = "Standard Site Rules"
+  description = "Baseline internet access for managed sites"
+
+  if_rules = [
+    {
+      name              = "Block Test.com"
+      enabled           = true
+      action            = "BLOCK"
+      connection_origin = "ANY"
+      source            = {}
+      destination = {
+        domain = ["test.com"]
+      }
+      tracking = {
+        event = {
+          enabled = true
+        }
+      }
+    },
+    {
+      name        = "Allow all & logs"
+      enabled     = true
+      action      = "ALLOW"
+      source      = {}
+      destination = {}
+      tracking = {
+        event = {
+          enabled = true
+        }
+      }
+    },
+  ]
+}
+
+// one sub-policy per site, all running the same template
+resource "cato_if_sub_policy" "site" {
+  for_each = toset(["Site-A", "Site-B"])
+
+  name        = "${each.key} Sub-Policy"
+  description = "Standard rules for ${each.key}"
+
+  at = {
+    position = "LAST_IN_POLICY"
+  }
+
+  scope = {
+    enabled = true
+    source = {
+      site = [
+        {
+          name = each.key
+        }
+      ]
+    }
+    destination = {}
+    tracking = {
+      event = {
+        enabled = true
+      }
+    }
+  }
+
+  template = cato_sub_policy_template.standard_site.ref
+}
+
+// the same concept for WAN Firewall sub-policies uses wf_rules
+resource "cato_sub_policy_template" "standard_wan" {
+  name = "Standard WAN Rules"
+
+  wf_rules = [
+    {
+      name        = "Allow branch to datacenter"
+      enabled     = true
+      action      = "ALLOW"
+      direction   = "TO"
+      source      = {}
+      application = {}
+      destination = {
+        ip = ["10.0.0.0/8"]
+      }
+      tracking = {
+        event = {
+          enabled = true
+        }
+      }
+    },
+  ]
+}
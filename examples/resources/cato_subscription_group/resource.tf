@@ -0,0 +1,28 @@
+resource "cato_subscription_group" "soc_alerts" {
+  name = "SOC Alerts"
+  mailing_lists = [
+    { id = cato_mailing_list.security_team.id },
+  ]
+  webhooks = [
+    { id = cato_webhook.pagerduty.id },
+  ]
+  emails = ["oncall@example.com"]
+}
+
+// send the alerts of an internet firewall rule to the group
+resource "cato_if_rule" "block_risky_apps" {
+  # ...
+  rule = {
+    # ...
+    tracking = {
+      event = { enabled = true }
+      alert = {
+        enabled   = true
+        frequency = "IMMEDIATE"
+        subscription_group = [
+          { id = cato_subscription_group.soc_alerts.id },
+        ]
+      }
+    }
+  }
+}
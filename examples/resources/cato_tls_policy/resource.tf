@@ -0,0 +1,5 @@
+resource "cato_tls_policy" "tls_inspection" {
+  enabled            = true
+  default_action     = "BYPASS"
+  log_implicit_rules = true
+}
@@ -0,0 +1,51 @@
+// TLS Inspection sub-policy (a nested policy scoped by a SUB_POLICY_SCOPE rule).
+//
+// NOTE: Changing `name` or `description` renames the sub-policy in place
+// through its SUB_POLICY_SCOPE rule, the rules it contains are kept. The
+// scope must set at least one non-ANY match field.
+
+// sub-policy scoped to a site, with a rule owned by it
+resource "cato_tls_sub_policy" "branch" {
+  name        = "Branch Sites TLS Sub-Policy"
+  description = "TLS Inspection rules that only apply to branch sites"
+
+  at = {
+    position = "LAST_IN_POLICY"
+  }
+
+  scope = {
+    enabled           = true
+    connection_origin = "SITE"
+    source = {
+      site = [
+        {
+          name = "Your Site Name"
+        },
+      ]
+    }
+    application = {}
+  }
+}
+
+// a rule placed inside the sub-policy via sub_policy_id
+resource "cato_tls_rule" "in_sub_policy" {
+  sub_policy_id = cato_tls_sub_policy.branch.id
+
+  // Rules owned by a sub-policy are always positioned before the sub-policy
+  // cleanup rule; the `at` position below is required by the schema but the
+  // provider anchors the rule inside the sub-policy automatically.
+  at = {
+    position = "LAST_IN_POLICY"
+  }
+
+  rule = {
+    name              = "Inspect branch traffic"
+    enabled           = true
+    action            = "INSPECT"
+    connection_origin = "ANY"
+    source            = {}
+    application       = {}
+  }
+
+  depends_on = [cato_tls_sub_policy.branch]
+}
@@ -0,0 +1,29 @@
+variable "pagerduty_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "cato_webhook" "pagerduty" {
+  name   = "PagerDuty Hook"
+  url    = "https://events.pagerduty.com/v2/enqueue"
+  method = "POST"
+  headers = {
+    "Content-Type" = "application/json"
+  }
+  payload_template = jsonencode({
+    routing_key  = "$${routing_key}"
+    event_action = "trigger"
+    payload = {
+      summary  = "$${event_description}"
+      source   = "Cato Networks"
+      severity = "critical"
+    }
+  })
+
+  // secret headers are not stored in the state, bump the version to send new values
+  secret_headers_wo = {
+    "Authorization" = "Token token=${var.pagerduty_token}"
+  }
+  secret_headers_wo_version = 1
+}
@@ -0,0 +1,5 @@
+resource "cato_wf_policy" "wan_firewall" {
+  enabled            = true
+  default_action     = "BLOCK"
+  log_implicit_rules = true
+}
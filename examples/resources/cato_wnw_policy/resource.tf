@@ -0,0 +1,3 @@
+resource "cato_wnw_policy" "wan_network" {
+  enabled = true
+}
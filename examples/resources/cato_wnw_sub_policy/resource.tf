@@ -0,0 +1,63 @@
+// WAN Network sub-policy (a nested policy scoped by a SUB_POLICY_SCOPE rule).
+//
+// NOTE: Changing `name` or `description` renames the sub-policy in place
+// through its SUB_POLICY_SCOPE rule, the rules it contains are kept. The
+// scope must set at least one non-ANY match field.
+
+// sub-policy scoped to a source subnet, with a rule owned by it
+resource "cato_wnw_sub_policy" "datacenter" {
+  name        = "Datacenter WAN Network Sub-Policy"
+  description = "WAN Network rules scoped to datacenter hosts"
+
+  at = {
+    position = "LAST_IN_POLICY"
+  }
+
+  scope = {
+    enabled    = true
+    rule_type  = "WAN"
+    route_type = "NONE"
+    source = {
+      subnet = ["10.0.0.0/8"]
+    }
+    destination = {}
+    application = {}
+  }
+}
+
+// a rule placed inside the sub-policy via sub_policy_id
+resource "cato_wnw_rule" "in_sub_policy" {
+  sub_policy_id = cato_wnw_sub_policy.datacenter.id
+
+  // Rules owned by a sub-policy are always positioned before the sub-policy
+  // cleanup rule; the `at` position below is required by the schema but the
+  // provider anchors the rule inside the sub-policy automatically.
+  at = {
+    position = "LAST_IN_POLICY"
+  }
+
+  rule = {
+    name        = "Optimize datacenter to branch"
+    enabled     = true
+    rule_type   = "WAN"
+    route_type  = "OPTIMIZED"
+    source      = {}
+    destination = {
+      subnet = ["10.1.0.0/16"]
+    }
+    application = {}
+    configuration = {
+      active_tcp_acceleration = true
+      packet_loss_mitigation  = true
+      preserve_source_port    = false
+      primary_transport = {
+        transport_type = "AUTOMATIC"
+      }
+      secondary_transport = {
+        transport_type = "AUTOMATIC"
+      }
+    }
+  }
+
+  depends_on = [cato_wnw_sub_policy.datacenter]
+}
@@ -39,11 +39,12 @@ func TestAccIpsecSite_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(res, "description", cfg.resName+" description"),
 					resource.TestCheckResourceAttrSet(res, "id"),
 					resource.TestCheckResourceAttrSet(res, "interface_id"),
-					resource.TestCheckResourceAttr(res, "ipsec.%", "8"),
+					resource.TestCheckResourceAttr(res, "ipsec.%", "10"),
+					resource.TestCheckResourceAttr(res, "ipsec.ike_version", "IKEV2"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.%", "4"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.public_cato_ip_id", cfg.allocatedIPIDs[0]),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.#", "1"),
-					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.%", "6"),
+					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.%", "8"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.last_mile_bw.%", "4"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.last_mile_bw.downstream", "10"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.last_mile_bw.upstream", "10"),
@@ -76,11 +77,12 @@ func TestAccIpsecSite_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(res, "description", cfg.resName+" description 2"),
 					resource.TestCheckResourceAttrSet(res, "id"),
 					resource.TestCheckResourceAttrSet(res, "interface_id"),
-					resource.TestCheckResourceAttr(res, "ipsec.%", "8"),
+					resource.TestCheckResourceAttr(res, "ipsec.%", "10"),
+					resource.TestCheckResourceAttr(res, "ipsec.ike_version", "IKEV2"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.%", "4"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.public_cato_ip_id", cfg.allocatedIPIDs[0]),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.#", "1"),
-					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.%", "6"),
+					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.%", "8"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.last_mile_bw.%", "4"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.last_mile_bw.downstream", "20"),
 					resource.TestCheckResourceAttr(res, "ipsec.primary.tunnels.0.last_mile_bw.upstream", "15"),
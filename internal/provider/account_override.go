@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const accountIDOverrideDescription = "Account ID to send API calls for this object to, overriding the provider `account_id`. " +
+	"Used to manage a tenant account with the API key of its reseller (partner) account."
+
+// accountIDOverrideAttribute is the optional per-resource account_id override; moving a resource
+// to another account recreates it there.
+func accountIDOverrideAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: accountIDOverrideDescription + " Prefix an import ID with `<account_id>:` to import an object of that account.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// accountIDOverrideDataSourceAttribute is the optional per-data-source account_id override
+func accountIDOverrideDataSourceAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Description: accountIDOverrideDescription,
+		Optional:    true,
+	}
+}
+
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// accountIDOverride reads the account_id override from a plan, state or config; it is null
+// when unset or when the schema has no such attribute.
+func accountIDOverride(ctx context.Context, src attributeGetter) types.String {
+	var accountID types.String
+	if diags := src.GetAttribute(ctx, path.Root("account_id"), &accountID); diags.HasError() {
+		return types.StringNull()
+	}
+	return accountID
+}
+
+// forAccount returns client data routing API calls to accountID, or d itself when no override is set.
+// Callers scope a per-call copy of their resource with scopedToAccount rather than replacing the shared
+// client, as Terraform may run operations on the same resource instance concurrently. The copy shares the API client and the account snapshot cache (keyed by account) with d, and
+// stale policy drafts of the account are discarded on its first use.
+func (d *catoClientData) forAccount(ctx context.Context, accountID types.String) *catoClientData {
+	if d == nil || accountID.IsNull() || accountID.IsUnknown() || accountID.ValueString() == "" ||
+		accountID.ValueString() == d.AccountId {
+		return d
+	}
+
+	scoped := *d
+	scoped.AccountId = accountID.ValueString()
+	cleanupDrafts(ctx, &scoped)
+	return &scoped
+}
+
+// accountScoped is a resource, data source or ephemeral resource calling the API through the client
+// data of the provider; clientData returns its client field.
+type accountScoped[T any] interface {
+	*T
+	clientData() **catoClientData
+}
+
+// scopedToAccount returns a per-call copy of v whose client data routes API calls to accountID, see
+// forAccount. Operations start with v = scopedToAccount(ctx, v, accountID).
+func scopedToAccount[T any, P accountScoped[T]](ctx context.Context, v P, accountID types.String) P {
+	scoped := P(new(T))
+	*scoped = *v
+	client := scoped.clientData()
+	*client = (*client).forAccount(ctx, accountID)
+	return scoped
+}
+
+// keepAccountID writes the account_id override into the response state, which models hydrated
+// from the API do not carry. It is a no-op when the state was not set or was removed.
+func keepAccountID(ctx context.Context, accountID types.String, state *tfsdk.State, diags *diag.Diagnostics) {
+	if state.Raw.IsNull() || accountID.IsUnknown() {
+		return
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("account_id"), accountID)...)
+}
+
+// importAccountID accepts import IDs of the form "<account_id>:<id>" to import an object of a tenant
+// account: it sets account_id in the imported state and strips the account prefix from req.ID. It
+// returns the account ID, null when the import ID has no account prefix.
+func importAccountID(ctx context.Context, req *resource.ImportStateRequest, resp *resource.ImportStateResponse) types.String {
+	accountID, id, ok := strings.Cut(req.ID, ":")
+	if !ok || accountID == "" || id == "" || strings.Trim(accountID, "0123456789") != "" {
+		return types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), accountID)...)
+	req.ID = id
+	return types.StringValue(accountID)
+}
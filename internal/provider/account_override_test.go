@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForAccountWithoutOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &catoClientData{AccountId: "reseller", draftsCleaned: &sync.Map{}}
+
+	for _, accountID := range []types.String{
+		types.StringNull(),
+		types.StringUnknown(),
+		types.StringValue(""),
+		types.StringValue("reseller"),
+	} {
+		require.Same(t, client, client.forAccount(ctx, accountID))
+	}
+	require.Nil(t, (*catoClientData)(nil).forAccount(ctx, types.StringValue("tenant")))
+}
+
+func TestForAccountRoutesToOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drafts := &sync.Map{}
+	drafts.Store("tenant", true) // drafts already discarded, no API call
+	client := &catoClientData{
+		AccountId:            "reseller",
+		accountSnapshotCache: newAccountSnapshotCache(),
+		draftsCleaned:        drafts,
+	}
+
+	scoped := client.forAccount(ctx, types.StringValue("tenant"))
+	require.NotSame(t, client, scoped)
+	require.Equal(t, "tenant", scoped.AccountId)
+	require.Equal(t, "reseller", client.AccountId)
+	require.Same(t, client.accountSnapshotCache, scoped.accountSnapshotCache)
+	require.Same(t, client.draftsCleaned, scoped.draftsCleaned)
+}
+
+func TestScopedToAccount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drafts := &sync.Map{}
+	drafts.Store("tenant", true) // drafts already discarded, no API call
+	client := &catoClientData{AccountId: "reseller", draftsCleaned: drafts}
+	lanClient := &lanPolicyMockClient{}
+	r := &lanRulesIndexResource{client: client, catov2Client: lanClient}
+
+	scoped := scopedToAccount(ctx, r, types.StringValue("tenant"))
+	require.NotSame(t, r, scoped)
+	require.Equal(t, "tenant", scoped.client.AccountId)
+	require.Same(t, lanClient, scoped.catov2Client)
+	require.Same(t, client, r.client, "the shared resource keeps the provider client data")
+
+	require.Same(t, client, scopedToAccount(ctx, r, types.StringNull()).client)
+}
+
+func importStateForTest(t *testing.T, r resource.ResourceWithImportState, id string) *resource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+	return resp
+}
+
+func TestImportAccountID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		importID, wantID, wantAccount string
+	}{
+		{importID: "12345:67890", wantID: "67890", wantAccount: "12345"},
+		{importID: "67890", wantID: "67890"},
+		{importID: "abc:67890", wantID: "abc:67890"},
+		{importID: "12345:", wantID: "12345:"},
+	}
+	for _, tc := range cases {
+		client := &catoClientData{AccountId: "reseller"}
+		r := &mailingListResource{client: client}
+		resp := importStateForTest(t, r, tc.importID)
+		require.False(t, resp.Diagnostics.HasError(), "%s: %v", tc.importID, resp.Diagnostics)
+
+		var id, accountID types.String
+		require.False(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+		require.False(t, resp.State.GetAttribute(context.Background(), path.Root("account_id"), &accountID).HasError())
+		require.Equal(t, tc.wantID, id.ValueString(), tc.importID)
+		require.Equal(t, tc.wantAccount, accountID.ValueString(), tc.importID)
+		require.Equal(t, tc.wantAccount == "", accountID.IsNull(), tc.importID)
+		require.Same(t, client, r.client, tc.importID)
+	}
+}
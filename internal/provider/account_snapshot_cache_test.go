@@ -205,3 +205,27 @@ func TestAccountSnapshotCacheKeySortsIDs(t *testing.T) {
 		t.Fatalf("expected key to be order-stable, got %q and %q", first, second)
 	}
 }
+
+func TestAccountSnapshotCacheKeyedPerAccount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newAccountSnapshotCache()
+	var calls atomic.Int64
+
+	fetch := func(context.Context) (*cato.AccountSnapshot, error) {
+		calls.Add(1)
+		return &cato.AccountSnapshot{}, nil
+	}
+
+	for _, accountID := range []string{"reseller", "tenant", "reseller", "tenant"} {
+		key := accountSnapshotCacheKey(accountID, []string{"site-1"}, nil)
+		if _, err := cache.get(ctx, key, false, fetch); err != nil {
+			t.Fatalf("unexpected fetch error: %v", err)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected one API call per account, got %d", got)
+	}
+}
@@ -11,6 +11,12 @@ import (
 // InternetFirewallBulkPolicyClient is the minimal Cato client surface used by
 // cato_bulk_if_move_rule (mocked in unit tests via mockery).
 type InternetFirewallBulkPolicyClient interface {
+	PolicyInternetFirewall(
+		ctx context.Context,
+		internetFirewallPolicyInput *cato_models.InternetFirewallPolicyInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.Policy, error)
 	PolicyInternetFirewallSectionsIndex(
 		ctx context.Context,
 		accountID string,
@@ -49,11 +55,64 @@ type InternetFirewallBulkPolicyClient interface {
 		accountID string,
 		interceptors ...clientv2.RequestInterceptor,
 	) (*cato_go_sdk.PolicyInternetFirewallPublishPolicyRevision, error)
+	PolicyInternetFirewallUpdateRule(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		internetFirewallUpdateRuleInput cato_models.InternetFirewallUpdateRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallUpdateRule, error)
+	PolicyInternetFirewallRemoveRule(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error)
+	PolicyInternetFirewallDiscardPolicyRevision(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)
+}
+
+// InternetFirewallPolicyDocumentClient is the minimal Cato client surface used by
+// cato_if_policy_document (mocked in unit tests via mockery).
+type InternetFirewallPolicyDocumentClient interface {
+	InternetFirewallBulkPolicyClient
+	PolicyInternetFirewallAddRule(
+		ctx context.Context,
+		internetFirewallAddRuleInput cato_models.InternetFirewallAddRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallAddRule, error)
+	PolicyInternetFirewallRemoveSection(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		policyRemoveSectionInput cato_models.PolicyRemoveSectionInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallRemoveSection, error)
+	PolicyInternetFirewallDiscardPolicyRevision(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)
 }
 
 // WanFirewallBulkPolicyClient is the minimal Cato client surface used by
 // cato_bulk_wf_move_rule (mocked in unit tests via mockery).
 type WanFirewallBulkPolicyClient interface {
+	PolicyWanFirewall(
+		ctx context.Context,
+		wanFirewallPolicyInput *cato_models.WanFirewallPolicyInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.Policy, error)
 	PolicyWanFirewallSectionsIndex(
 		ctx context.Context,
 		accountID string,
@@ -83,4 +142,22 @@ type WanFirewallBulkPolicyClient interface {
 		accountID string,
 		interceptors ...clientv2.RequestInterceptor,
 	) (*cato_go_sdk.PolicyWanFirewallPublishPolicyRevision, error)
+	PolicyWanFirewallUpdateRule(
+		ctx context.Context,
+		wanFirewallUpdateRuleInput cato_models.WanFirewallUpdateRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallUpdateRule, error)
+	PolicyWanFirewallRemoveRule(
+		ctx context.Context,
+		wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error)
+	PolicyWanFirewallDiscardPolicyRevision(
+		ctx context.Context,
+		policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error)
 }
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type BulkPlannedMove struct {
+	RuleName    types.String `tfsdk:"rule_name"`
+	FromSection types.String `tfsdk:"from_section"`
+	FromIndex   types.Int64  `tfsdk:"from_index"`
+	ToSection   types.String `tfsdk:"to_section"`
+	ToIndex     types.Int64  `tfsdk:"to_index"`
+	Anchor      types.String `tfsdk:"anchor"`
+}
+
+var BulkPlannedMoveTypes = map[string]attr.Type{
+	"rule_name":    types.StringType,
+	"from_section": types.StringType,
+	"from_index":   types.Int64Type,
+	"to_section":   types.StringType,
+	"to_index":     types.Int64Type,
+	"anchor":       types.StringType,
+}
+
+// bulkPlannedMovesAttribute is the computed planned_moves attribute of the bulk move resources
+// applying the order with one move per rule
+func bulkPlannedMovesAttribute(sectionLabel string) schema.ListNestedAttribute {
+	return bulkPlannedPlacementsAttribute(sectionLabel,
+		"Rule moves computed at plan time from the live policy when the configuration changes, in "+
+			"the order they are planned. Known after apply only when a rule is not in the policy yet at plan time. "+
+			"Kept from the last apply while the configuration is unchanged.",
+		"Where the rule is placed by the move, relative to its neighbour or its "+sectionLabel)
+}
+
+// bulkPlannedReorderAttribute is the computed planned_moves attribute of the bulk move resources
+// applying the order with a single policy reorder
+func bulkPlannedReorderAttribute(sectionLabel string) schema.ListNestedAttribute {
+	return bulkPlannedPlacementsAttribute(sectionLabel,
+		"Rules placed at another index or "+sectionLabel+" by the policy reorder computed at plan time from the "+
+			"live policy when the configuration changes, in policy order. The whole order is applied with a single "+
+			"reorder of the policy, this lists its outcome rather than separate moves. Known after apply only when "+
+			"a rule is not in the policy yet at plan time. Kept from the last apply while the configuration is unchanged.",
+		"Where the rule is placed by the reorder, first in to_section or after the rule preceding it there")
+}
+
+func bulkPlannedPlacementsAttribute(sectionLabel, description, anchorDescription string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"rule_name": schema.StringAttribute{
+					Description: "Name of the moved rule",
+					Computed:    true,
+				},
+				"from_section": schema.StringAttribute{
+					Description: "Name of the " + sectionLabel + " currently housing the rule",
+					Computed:    true,
+				},
+				"from_index": schema.Int64Attribute{
+					Description: "Current 1-based index of the rule in from_section",
+					Computed:    true,
+				},
+				"to_section": schema.StringAttribute{
+					Description: "Name of the " + sectionLabel + " the rule is moved to",
+					Computed:    true,
+				},
+				"to_index": schema.Int64Attribute{
+					Description: "1-based index of the rule in to_section once the policy is in order",
+					Computed:    true,
+				},
+				"anchor": schema.StringAttribute{
+					Description: anchorDescription,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// bulkPlannedMovesValue converts the move previews to the planned_moves attribute value
+func bulkPlannedMovesValue(ctx context.Context, previews []BulkRuleMovePreview, diags *diag.Diagnostics) types.List {
+	moves := make([]BulkPlannedMove, 0, len(previews))
+	for _, p := range previews {
+		moves = append(moves, BulkPlannedMove{
+			RuleName:    types.StringValue(p.RuleName),
+			FromSection: types.StringValue(p.FromSection),
+			FromIndex:   types.Int64Value(p.FromIndex),
+			ToSection:   types.StringValue(p.ToSection),
+			ToIndex:     types.Int64Value(p.ToIndex),
+			Anchor:      types.StringValue(p.Anchor),
+		})
+	}
+	value, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: BulkPlannedMoveTypes}, moves)
+	diags.Append(d...)
+	return value
+}
+
+// bulkPlannedMovesUnknown is the planned_moves value when the moves are only known at apply time
+func bulkPlannedMovesUnknown() types.List {
+	return types.ListUnknown(types.ObjectType{AttrTypes: BulkPlannedMoveTypes})
+}
+
+// bulkPlannedMovesApplied is the planned_moves value stored after apply, moves only known at
+// apply time are recorded as none
+func bulkPlannedMovesApplied(ctx context.Context, moves types.List, diags *diag.Diagnostics) types.List {
+	if moves.IsUnknown() {
+		return bulkPlannedMovesValue(ctx, nil, diags)
+	}
+	return moves
+}
+
+// modifyBulkPlannedMoves sets planned_moves in the plan of a bulk move resource. Without any other
+// change the prior value is kept, else it is the moves returned by preview, or unknown when preview
+// cannot tell them before apply. Preview errors do not fail the plan, the apply reports them.
+func modifyBulkPlannedMoves(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	preview func() (previews []BulkRuleMovePreview, known bool, err error),
+) {
+	if req.Plan.Raw.IsNull() { // resource destruction
+		return
+	}
+	movesPath := path.Root("planned_moves")
+	if !req.State.Raw.IsNull() {
+		var prior types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, movesPath, &prior)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, movesPath, prior)...)
+		if resp.Diagnostics.HasError() || resp.Plan.Raw.Equal(req.State.Raw) {
+			return
+		}
+	}
+
+	moves := bulkPlannedMovesUnknown()
+	previews, known, err := preview()
+	switch {
+	case err != nil:
+		tflog.Warn(ctx, "Rule moves not previewed", map[string]interface{}{
+			"error": err.Error(),
+		})
+	case known:
+		moves = bulkPlannedMovesValue(ctx, previews, &resp.Diagnostics)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, movesPath, moves)...)
+}
+
+// bulkPlannedRuleIndexes reads the rule placements of a rule_data map, known is false while any
+// placement is only known at apply time
+func bulkPlannedRuleIndexes(ruleData types.Map) (planned []BulkPlannedRuleIndex, known bool) {
+	if ruleData.IsUnknown() {
+		return nil, false
+	}
+	for _, value := range ruleData.Elements() {
+		rule, ok := value.(types.Object)
+		if !ok || rule.IsUnknown() {
+			return nil, false
+		}
+		attrs := rule.Attributes()
+		ruleName, _ := attrs["rule_name"].(types.String)
+		sectionName, _ := attrs["section_name"].(types.String)
+		index, _ := attrs["index_in_section"].(types.Int64)
+		if ruleName.IsUnknown() || sectionName.IsUnknown() || index.IsUnknown() {
+			return nil, false
+		}
+		planned = append(planned, BulkPlannedRuleIndex{
+			SectionName:    sectionName.ValueString(),
+			RuleName:       ruleName.ValueString(),
+			IndexInSection: index.ValueInt64(),
+		})
+	}
+	return planned, true
+}
+
+// dryRunBulkRuleOrder returns the rule_data and section_data of a dry-run state with the rules and
+// sections at their current place in the policy, so the next plan still shows the moves the dry run
+// did not apply. Both are returned as is when the policy is in the planned order.
+func dryRunBulkRuleOrder(ctx context.Context, ruleData, sectionData types.Map,
+	sections []BulkPolicySectionRef, rules []BulkPolicyRuleRow, diags *diag.Diagnostics,
+) (types.Map, types.Map) {
+	if planned, known := bulkPlannedRuleIndexes(ruleData); known && len(planned) > 0 {
+		current, err := currentBulkRuleIndexes(sections, rules, planned)
+		if err != nil {
+			tflog.Warn(ctx, "Rule order not refreshed", map[string]interface{}{"error": err.Error()})
+		}
+		byName := make(map[string]BulkPlannedRuleIndex, len(current))
+		for _, c := range current {
+			byName[c.RuleName] = c
+		}
+		ruleData = updateBulkMapObjects(ctx, ruleData, func(attrs map[string]attr.Value) {
+			name, _ := attrs["rule_name"].(types.String)
+			if c, ok := byName[name.ValueString()]; ok {
+				attrs["section_name"] = types.StringValue(c.SectionName)
+				attrs["index_in_section"] = types.Int64Value(c.IndexInSection)
+			}
+		}, diags)
+	}
+
+	plannedSections := make(map[string]int64, len(sectionData.Elements()))
+	for _, value := range sectionData.Elements() {
+		section, ok := value.(types.Object)
+		if !ok {
+			continue
+		}
+		name, _ := section.Attributes()["section_name"].(types.String)
+		index, _ := section.Attributes()["section_index"].(types.Int64)
+		plannedSections[name.ValueString()] = index.ValueInt64()
+	}
+	currentSections := currentBulkSectionIndexes(sections, plannedSections)
+	sectionData = updateBulkMapObjects(ctx, sectionData, func(attrs map[string]attr.Value) {
+		name, _ := attrs["section_name"].(types.String)
+		if index, ok := currentSections[name.ValueString()]; ok {
+			attrs["section_index"] = types.Int64Value(index)
+		}
+	}, diags)
+	return ruleData, sectionData
+}
+
+// updateBulkMapObjects returns the map of objects with the attributes of each object rewritten by update
+func updateBulkMapObjects(ctx context.Context, data types.Map, update func(attrs map[string]attr.Value), diags *diag.Diagnostics) types.Map {
+	elemType, ok := data.ElementType(ctx).(types.ObjectType)
+	if !ok || data.IsNull() || data.IsUnknown() {
+		return data
+	}
+	elements := make(map[string]attr.Value, len(data.Elements()))
+	for key, value := range data.Elements() {
+		object, ok := value.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			elements[key] = value
+			continue
+		}
+		attrs := maps.Clone(object.Attributes())
+		update(attrs)
+		updated, d := types.ObjectValue(elemType.AttrTypes, attrs)
+		diags.Append(d...)
+		elements[key] = updated
+	}
+	updated, d := types.MapValue(elemType, elements)
+	diags.Append(d...)
+	return updated
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	unmanagedRulesIgnore  = "ignore"
+	unmanagedRulesWarn    = "warn"
+	unmanagedRulesDisable = "disable"
+	unmanagedRulesDelete  = "delete"
+)
+
+// bulkUnmanagedRulesAttribute is the unmanaged_rules attribute of the bulk move resources
+func bulkUnmanagedRulesAttribute(ruleDataLabel string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Handling of the rules of the policy not in " + ruleDataLabel + " on apply: `ignore` leaves them " +
+			"in place, `warn` reports them in a warning, `disable` disables them and `delete` deletes them. System " +
+			"rules and sub-policies are never handled. A `disable` or `delete` failing part way discards the policy draft, " +
+			"so that none of the rules is handled. When set, the rules are listed in unmanaged_rule_names.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(unmanagedRulesIgnore, unmanagedRulesWarn, unmanagedRulesDisable, unmanagedRulesDelete),
+		},
+	}
+}
+
+// bulkUnmanagedRuleNamesAttribute is the computed unmanaged_rule_names attribute of the bulk move resources
+func bulkUnmanagedRuleNamesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "Names of the unmanaged rules of the policy in policy order, only the enabled ones with " +
+			"unmanaged_rules `disable`. Rules left there by a `disable` or `delete` are handled on the next apply. " +
+			"Null when unmanaged_rules is not set.",
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// unmanagedPolicyRules returns the rules of the policy sections not in managed in policy order,
+// system rules, sub-policy scope rules and sub-policy rules outside of any section are left out
+func unmanagedPolicyRules(rules []BulkPolicyRuleRow, managed []string) []BulkPolicyRuleRow {
+	managedNames := make(map[string]struct{}, len(managed))
+	for _, name := range managed {
+		managedNames[name] = struct{}{}
+	}
+	var unmanaged []BulkPolicyRuleRow
+	for _, rule := range rules {
+		if rule.IsSystem || rule.IsSubPolicyScope || rule.SectionID == "" {
+			continue
+		}
+		if _, ok := managedNames[rule.RuleName]; !ok {
+			unmanaged = append(unmanaged, rule)
+		}
+	}
+	sort.SliceStable(unmanaged, func(i, j int) bool { return unmanaged[i].Index < unmanaged[j].Index })
+	return unmanaged
+}
+
+// flagSubPolicyScopeRules sets IsSubPolicyScope on the rules listed in scopeRuleIDs, for the
+// rules indexes that do not tell the rule type
+func flagSubPolicyScopeRules(rules []BulkPolicyRuleRow, scopeRuleIDs map[string]struct{}) []BulkPolicyRuleRow {
+	for i := range rules {
+		if _, ok := scopeRuleIDs[rules[i].RuleID]; ok {
+			rules[i].IsSubPolicyScope = true
+		}
+	}
+	return rules
+}
+
+// pendingUnmanagedRules returns the unmanaged rules the mode still has to act on, the enabled
+// ones for disable and all of them otherwise
+func pendingUnmanagedRules(mode string, unmanaged []BulkPolicyRuleRow) []BulkPolicyRuleRow {
+	if mode != unmanagedRulesDisable {
+		return unmanaged
+	}
+	var pending []BulkPolicyRuleRow
+	for _, rule := range unmanaged {
+		if rule.Enabled {
+			pending = append(pending, rule)
+		}
+	}
+	return pending
+}
+
+// bulkManagedRuleNames lists the rule names of a rule_data map
+func bulkManagedRuleNames(ruleData types.Map) []string {
+	planned, _ := bulkPlannedRuleIndexes(ruleData)
+	names := make([]string, 0, len(planned))
+	for _, rule := range planned {
+		names = append(names, rule.RuleName)
+	}
+	return names
+}
+
+// unmanagedRuleNamesValue is the unmanaged_rule_names value of the unmanaged rules still pending
+// for mode, null when unmanaged_rules is not set
+func unmanagedRuleNamesValue(mode types.String, unmanaged []BulkPolicyRuleRow) types.List {
+	if mode.IsNull() || mode.IsUnknown() {
+		return types.ListNull(types.StringType)
+	}
+	names := []string{}
+	for _, rule := range pendingUnmanagedRules(mode.ValueString(), unmanaged) {
+		names = append(names, rule.RuleName)
+	}
+	value, _ := types.ListValueFrom(context.Background(), types.StringType, names)
+	return value
+}
+
+// applyUnmanagedRules acts on the unmanaged rules of policyLabel as set by mode and returns the
+// unmanaged_rule_names value once done. Rules are disabled or deleted one at a time, the first
+// rule failing with an error diagnostic stops the others and discard drops the rules disabled or
+// deleted so far from the policy draft, so that no later publish applies them half-way.
+func applyUnmanagedRules(
+	ctx context.Context,
+	mode types.String,
+	policyLabel string,
+	unmanaged []BulkPolicyRuleRow,
+	disable, remove func(rule BulkPolicyRuleRow, diags *diag.Diagnostics),
+	discard func(diags *diag.Diagnostics),
+	diags *diag.Diagnostics,
+) types.List {
+	pending := pendingUnmanagedRules(mode.ValueString(), unmanaged)
+	if mode.IsNull() || len(pending) == 0 {
+		return unmanagedRuleNamesValue(mode, unmanaged)
+	}
+
+	switch mode.ValueString() {
+	case unmanagedRulesWarn:
+		diags.AddWarning(
+			fmt.Sprintf("%d %s rules are not managed by this resource", len(pending), policyLabel),
+			formatUnmanagedRules(pending),
+		)
+	case unmanagedRulesDisable, unmanagedRulesDelete:
+		act := disable
+		if mode.ValueString() == unmanagedRulesDelete {
+			act = remove
+		}
+		for _, rule := range pending {
+			tflog.Info(ctx, "Handling unmanaged rule", map[string]interface{}{
+				"mode": mode.ValueString(),
+				"rule": rule.RuleName,
+			})
+			var ruleDiags diag.Diagnostics
+			act(rule, &ruleDiags)
+			diags.Append(ruleDiags...)
+			if ruleDiags.HasError() {
+				discard(diags)
+				return unmanagedRuleNamesValue(mode, unmanaged)
+			}
+		}
+		diags.AddWarning(
+			fmt.Sprintf("%d unmanaged %s rules were %sd", len(pending), policyLabel, mode.ValueString()),
+			formatUnmanagedRules(pending),
+		)
+		return unmanagedRuleNamesValue(mode, nil)
+	}
+	return unmanagedRuleNamesValue(mode, unmanaged)
+}
+
+// formatUnmanagedRules lists unmanaged rules one per line with the section or network rule housing them
+func formatUnmanagedRules(rules []BulkPolicyRuleRow) string {
+	lines := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		lines = append(lines, fmt.Sprintf("rule %q in %q", rule.RuleName, rule.SectionName))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// modifyBulkUnmanagedRules plans an update while unmanaged rules are left to disable or delete,
+// so a rule created in the policy since the last apply is handled without any configuration change
+func modifyBulkUnmanagedRules(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() { // resource creation or destruction
+		return
+	}
+	var mode types.String
+	var pending types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("unmanaged_rules"), &mode)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("unmanaged_rule_names"), &pending)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if mode.ValueString() != unmanagedRulesDisable && mode.ValueString() != unmanagedRulesDelete {
+		return
+	}
+	if pending.IsNull() || len(pending.Elements()) == 0 {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_rule_names"), types.ListUnknown(types.StringType))...)
+}
@@ -25,6 +25,8 @@ type accountSnapshotSiteDataSource struct {
 	client *catoClientData
 }
 
+func (d *accountSnapshotSiteDataSource) clientData() **catoClientData { return &d.client }
+
 type SiteSnapshot struct {
 	ID       *string `tfsdk:"id"`
 	SiteName *string `tfsdk:"site_name"`
@@ -39,6 +41,7 @@ type SiteSnapshot struct {
 	Info *SiteInfo `tfsdk:"info"`
 	// HostCount          *int64           `tfsdk:"hostCount"`
 	// AltWanStatus       *string          `tfsdk:"altWanStatus"`
+	AccountID *string `tfsdk:"account_id"`
 }
 
 // type HaStatus struct {
@@ -224,6 +227,7 @@ func (d *accountSnapshotSiteDataSource) Schema(_ context.Context, _ datasource.S
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -238,6 +242,9 @@ func (d *accountSnapshotSiteDataSource) Configure(_ context.Context, req datasou
 
 //nolint:gocyclo
 func (d *accountSnapshotSiteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var state SiteSnapshot
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+func AdminRolesDataSource() datasource.DataSource {
+	return &adminRolesDataSource{}
+}
+
+type adminRolesDataSource struct {
+	client *catoClientData
+}
+
+func (d *adminRolesDataSource) clientData() **catoClientData { return &d.client }
+
+func (d *adminRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_roles"
+}
+
+func (d *adminRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_admin_roles` data source fetches the admin roles of the account, predefined and custom. " +
+			"The returned `id` can be used in the `managed_roles` attribute of `cato_admin`.",
+		Attributes: map[string]schema.Attribute{
+			"include_predefined": schema.BoolAttribute{
+				Description: "Include the predefined roles (default true)",
+				Optional:    true,
+			},
+			"name_filter": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of role names to filter by",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "List of roles matching the filter criteria",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Description: "Role description",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Role ID",
+							Computed:    true,
+						},
+						"is_predefined": schema.BoolAttribute{
+							Description: "The role is predefined by Cato and cannot be modified",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Role name",
+							Computed:    true,
+						},
+						"permissions": schema.SetNestedAttribute{
+							Description: "Permissions of the role",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"access": schema.StringAttribute{
+										Description: "Access level to the area",
+										Computed:    true,
+									},
+									"area": schema.StringAttribute{
+										Description: "Area of the permission",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *adminRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*catoClientData)
+}
+
+func (d *adminRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
+	var lookup AdminRolesLookup
+	if diags := req.Config.Get(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	result, err := d.client.catov2.AccountRoles(ctx, d.client.AccountId, nil)
+	tflog.Debug(ctx, "Read.AccountRoles.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(result),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API AccountRoles error", err.Error())
+		return
+	}
+
+	var namesMap map[string]struct{}
+	if utils.HasValue(lookup.NameFilter) {
+		namesMap = make(map[string]struct{}, len(lookup.NameFilter.Elements()))
+		for _, value := range lookup.NameFilter.Elements() {
+			if s, ok := value.(types.String); ok {
+				namesMap[s.ValueString()] = struct{}{}
+			}
+		}
+	}
+	includePredefined := lookup.IncludePredefined.IsNull() || lookup.IncludePredefined.ValueBool()
+
+	objects := make([]attr.Value, 0)
+	for _, role := range result.GetAccountRoles().GetItems() {
+		if role == nil || (role.IsPredefined && !includePredefined) {
+			continue
+		}
+		if namesMap != nil && !contains(namesMap, role.Name) {
+			continue
+		}
+		obj, diags := types.ObjectValue(
+			AdminRoleItemAttrTypes,
+			map[string]attr.Value{
+				"description":   types.StringPointerValue(role.Description),
+				"id":            types.StringValue(role.ID),
+				"is_predefined": types.BoolValue(role.IsPredefined),
+				"name":          types.StringValue(role.Name),
+				"permissions":   adminRolePermissions(ctx, role.Permissions, &resp.Diagnostics),
+			},
+		)
+		if diags.HasError() || resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		objects = append(objects, obj)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: AdminRoleItemAttrTypes}, objects)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	lookup.Items = list
+	if diags := resp.State.Set(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+}
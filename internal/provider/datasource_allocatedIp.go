@@ -16,8 +16,9 @@ import (
 )
 
 type AllocatedIPLookup struct {
-	NameFilter types.List `tfsdk:"name_filter"`
-	Items      types.List `tfsdk:"items"`
+	NameFilter types.List   `tfsdk:"name_filter"`
+	Items      types.List   `tfsdk:"items"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
 
 type AllocatedIP struct {
@@ -35,6 +36,8 @@ type allocatedIPDataSource struct {
 	client *catoClientData
 }
 
+func (d *allocatedIPDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *allocatedIPDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_allocatedIp"
 }
@@ -73,6 +76,7 @@ func (d *allocatedIPDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -86,6 +90,9 @@ func (d *allocatedIPDataSource) Configure(_ context.Context, req datasource.Conf
 }
 
 func (d *allocatedIPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var allocatedIPLookup AllocatedIPLookup
 	if diags := req.Config.Get(ctx, &allocatedIPLookup); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -9,8 +9,9 @@ import (
 )
 
 type AppConnectorGroupDataSourceModel struct {
-	Items []types.String `tfsdk:"items"`
-	Total types.Int64    `tfsdk:"total"`
+	AccountID types.String   `tfsdk:"account_id"`
+	Items     []types.String `tfsdk:"items"`
+	Total     types.Int64    `tfsdk:"total"`
 }
 
 func AppConnectorGroupDataSource() datasource.DataSource {
@@ -21,6 +22,8 @@ type appConnectorGroupDataSource struct {
 	client *catoClientData
 }
 
+func (d *appConnectorGroupDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *appConnectorGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_app_connector_group"
 }
@@ -38,6 +41,7 @@ func (d *appConnectorGroupDataSource) Schema(_ context.Context, _ datasource.Sch
 				Description: "Total number of groups",
 				Computed:    true,
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -51,6 +55,9 @@ func (d *appConnectorGroupDataSource) Configure(_ context.Context, req datasourc
 }
 
 func (d *appConnectorGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var groups AppConnectorGroupDataSourceModel
 	if diags := req.Config.Get(ctx, &groups); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
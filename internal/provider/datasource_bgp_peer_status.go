@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+type BgpPeerStatusLookup struct {
+	SiteID    types.String `tfsdk:"site_id"`
+	PeerIP    types.String `tfsdk:"peer_ip"`
+	Peers     types.List   `tfsdk:"peers"`
+	AccountID types.String `tfsdk:"account_id"`
+}
+
+// bgpSessionEstablished is the BGP finite state machine state of an operational session
+const bgpSessionEstablished = "Established"
+
+var (
+	bgpPeerStatusAttrTypes = map[string]attr.Type{
+		"peer_ip":                 types.StringType,
+		"peer_asn":                types.Int64Type,
+		"cato_ip":                 types.StringType,
+		"cato_asn":                types.Int64Type,
+		"session_state":           types.StringType,
+		"established":             types.BoolType,
+		"bfd_session_state":       types.StringType,
+		"uptime":                  types.StringType,
+		"received_routes_count":   types.Int64Type,
+		"advertised_routes_count": types.Int64Type,
+		"received_routes":         types.ListType{ElemType: types.StringType},
+		"advertised_routes":       types.ListType{ElemType: types.StringType},
+	}
+)
+
+func BgpPeerStatusDataSource() datasource.DataSource {
+	return &bgpPeerStatusDataSource{}
+}
+
+type bgpPeerStatusDataSource struct {
+	client *catoClientData
+}
+
+func (d *bgpPeerStatusDataSource) clientData() **catoClientData { return &d.client }
+
+func (d *bgpPeerStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_peer_status"
+}
+
+func (d *bgpPeerStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the live BGP session status of the peers of a site, " +
+			"e.g. to assert in a check block that peering is established after apply.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				Description: "Site ID",
+				Required:    true,
+			},
+			"peer_ip": schema.StringAttribute{
+				Description: "Only return the status of the peer with this IP address",
+				Optional:    true,
+			},
+			"peers": schema.ListNestedAttribute{
+				Description: "BGP peer session status",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"peer_ip": schema.StringAttribute{
+							Description: "IP address of the BGP peer",
+							Computed:    true,
+						},
+						"peer_asn": schema.Int64Attribute{
+							Description: "AS number of the BGP peer",
+							Computed:    true,
+						},
+						"cato_ip": schema.StringAttribute{
+							Description: "IP address of the Cato BGP endpoint",
+							Computed:    true,
+						},
+						"cato_asn": schema.Int64Attribute{
+							Description: "AS number of the Cato BGP endpoint",
+							Computed:    true,
+						},
+						"session_state": schema.StringAttribute{
+							Description: "BGP session state (e.g. Idle, Connect, Active, OpenSent, OpenConfirm, Established)",
+							Computed:    true,
+						},
+						"established": schema.BoolAttribute{
+							Description: "True when the BGP session is established",
+							Computed:    true,
+						},
+						"bfd_session_state": schema.StringAttribute{
+							Description: "BFD session state, empty when BFD is not enabled",
+							Computed:    true,
+						},
+						"uptime": schema.StringAttribute{
+							Description: "Time since the BGP session state last changed",
+							Computed:    true,
+						},
+						"received_routes_count": schema.Int64Attribute{
+							Description: "Number of routes received from the peer",
+							Computed:    true,
+						},
+						"advertised_routes_count": schema.Int64Attribute{
+							Description: "Number of routes advertised to the peer",
+							Computed:    true,
+						},
+						"received_routes": schema.ListAttribute{
+							Description: "Subnets of the routes received from the peer",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"advertised_routes": schema.ListAttribute{
+							Description: "Subnets of the routes advertised to the peer",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *bgpPeerStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*catoClientData)
+}
+
+func (d *bgpPeerStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
+	var lookup BgpPeerStatusLookup
+	if diags := req.Config.Get(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	input := cato_models.SiteBgpStatusInput{
+		Site: &cato_models.SiteRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: lookup.SiteID.ValueString(),
+		},
+	}
+	tflog.Debug(ctx, "Read.SiteBgpStatus.request", map[string]interface{}{
+		"request": utils.InterfaceToJSONString(input),
+	})
+	result, err := d.client.catov2.SiteBgpStatus(ctx, input, d.client.AccountId)
+	tflog.Debug(ctx, "Read.SiteBgpStatus.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(result),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API SiteBgpStatus error", err.Error())
+		return
+	}
+
+	peers := make([]attr.Value, 0)
+	for _, status := range result.GetSiteBgpStatus().GetStatus() {
+		if utils.HasValue(lookup.PeerIP) && status.RemoteIP != lookup.PeerIP.ValueString() {
+			continue
+		}
+		obj, diags := bgpPeerStatusObject(ctx, status)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		peers = append(peers, obj)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: bgpPeerStatusAttrTypes}, peers)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	lookup.Peers = list
+	if diags := resp.State.Set(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func bgpPeerStatusObject(ctx context.Context, status *cato_go_sdk.SiteBgpStatus_SiteBgpStatus_Status) (types.Object, diag.Diagnostics) {
+	receivedRoutes := make([]string, 0, len(status.RoutesFromPeer))
+	for _, route := range status.RoutesFromPeer {
+		receivedRoutes = append(receivedRoutes, route.Subnet)
+	}
+	advertisedRoutes := make([]string, 0, len(status.RoutesToPeer))
+	for _, route := range status.RoutesToPeer {
+		advertisedRoutes = append(advertisedRoutes, route.Subnet)
+	}
+
+	peerAsn, _ := strconv.ParseInt(string(status.RemoteAsn), 10, 64)
+	catoAsn, _ := strconv.ParseInt(string(status.LocalAsn), 10, 64)
+	sessionState, uptime := parseBgpSessionState(status.BgpSession)
+
+	var diags diag.Diagnostics
+	receivedList, d := types.ListValueFrom(ctx, types.StringType, receivedRoutes)
+	diags.Append(d...)
+	advertisedList, d := types.ListValueFrom(ctx, types.StringType, advertisedRoutes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(bgpPeerStatusAttrTypes), diags
+	}
+
+	return types.ObjectValue(bgpPeerStatusAttrTypes, map[string]attr.Value{
+		"peer_ip":                 types.StringValue(status.RemoteIP),
+		"peer_asn":                types.Int64Value(peerAsn),
+		"cato_ip":                 types.StringValue(status.LocalIP),
+		"cato_asn":                types.Int64Value(catoAsn),
+		"session_state":           types.StringValue(sessionState),
+		"established":             types.BoolValue(isBgpSessionEstablished(sessionState)),
+		"bfd_session_state":       types.StringValue(status.BfdSession),
+		"uptime":                  types.StringValue(uptime),
+		"received_routes_count":   types.Int64Value(int64(len(receivedRoutes))),
+		"advertised_routes_count": types.Int64Value(int64(len(advertisedRoutes))),
+		"received_routes":         receivedList,
+		"advertised_routes":       advertisedList,
+	})
+}
+
+// parseBgpSessionState splits the session status reported by the API, e.g. "Established (up 2d03h15m)",
+// into the session state and the time since the state last changed
+func parseBgpSessionState(session string) (state, uptime string) {
+	state, rest, found := strings.Cut(strings.TrimSpace(session), " ")
+	if !found {
+		return state, ""
+	}
+	uptime = strings.Trim(strings.TrimSpace(rest), "()")
+	uptime = strings.TrimSpace(strings.TrimPrefix(uptime, "up"))
+	return state, uptime
+}
+
+func isBgpSessionEstablished(state string) bool {
+	return strings.EqualFold(state, bgpSessionEstablished)
+}
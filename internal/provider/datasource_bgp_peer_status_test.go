@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBgpSessionState(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		session         string
+		wantState       string
+		wantUptime      string
+		wantEstablished bool
+	}{
+		"established with uptime": {
+			session: "Established (up 2d03h15m)", wantState: "Established", wantUptime: "2d03h15m", wantEstablished: true,
+		},
+		"lower case established": {session: "established", wantState: "established", wantEstablished: true},
+		"active":                 {session: "Active (down 00:05:12)", wantState: "Active", wantUptime: "down 00:05:12"},
+		"idle without uptime":    {session: "Idle", wantState: "Idle"},
+		"empty":                  {session: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state, uptime := parseBgpSessionState(tt.session)
+			require.Equal(t, tt.wantState, state)
+			require.Equal(t, tt.wantUptime, uptime)
+			require.Equal(t, tt.wantEstablished, isBgpSessionEstablished(state))
+		})
+	}
+}
@@ -16,8 +16,9 @@ import (
 )
 
 type dhcpRelayGroupLookup struct {
-	NameFilter types.List `tfsdk:"name_filter"`
-	Items      types.List `tfsdk:"items"`
+	NameFilter types.List   `tfsdk:"name_filter"`
+	Items      types.List   `tfsdk:"items"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
 
 type DhcpRelayGroup struct {
@@ -34,6 +35,8 @@ type dhcpRelayGroupDataSource struct {
 	client *catoClientData
 }
 
+func (d *dhcpRelayGroupDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *dhcpRelayGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dhcpRelayGroup"
 }
@@ -69,6 +72,7 @@ func (d *dhcpRelayGroupDataSource) Schema(_ context.Context, _ datasource.Schema
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -82,6 +86,9 @@ func (d *dhcpRelayGroupDataSource) Configure(_ context.Context, req datasource.C
 }
 
 func (d *dhcpRelayGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var dhcpRelayGroupLookup dhcpRelayGroupLookup
 	if diags := req.Config.Get(ctx, &dhcpRelayGroupLookup); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
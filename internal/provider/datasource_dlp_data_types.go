@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+func DlpDataTypesDataSource() datasource.DataSource {
+	return &dlpDataTypesDataSource{}
+}
+
+type dlpDataTypesDataSource struct {
+	client *catoClientData
+}
+
+func (d *dlpDataTypesDataSource) clientData() **catoClientData { return &d.client }
+
+func (d *dlpDataTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dlp_data_types"
+}
+
+func (d *dlpDataTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	filterAttr := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: description,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_dlp_data_types` data source fetches the predefined DLP data types with optional filters. " +
+			"When several filters are set, a data type must match all of them. " +
+			"The returned `id` and `name` can be used as `data_type` references in the `cato_dlp_content_profile` conditions.",
+		Attributes: map[string]schema.Attribute{
+			"category_filter": filterAttr("List of data type categories to filter by"),
+			"name_filter":     filterAttr("List of data type names to filter by"),
+			"items": schema.ListNestedAttribute{
+				Description: "List of data types matching the filter criteria",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							Description: "Data type category",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Data type description",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Data type ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Data type name",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *dlpDataTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*catoClientData)
+}
+
+func (d *dlpDataTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
+	var lookup DlpDataTypesLookup
+	if diags := req.Config.Get(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	result, err := d.client.catov2.DlpDataTypes(ctx, d.client.AccountId)
+	tflog.Debug(ctx, "Read.DlpDataTypes.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(result),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API DlpDataTypes error", err.Error())
+		return
+	}
+
+	toMap := func(list types.List) map[string]struct{} {
+		if list.IsNull() || list.IsUnknown() {
+			return nil
+		}
+		m := make(map[string]struct{}, len(list.Elements()))
+		for _, value := range list.Elements() {
+			if s, ok := value.(types.String); ok {
+				m[s.ValueString()] = struct{}{}
+			}
+		}
+		return m
+	}
+	namesMap := toMap(lookup.NameFilter)
+	categoriesMap := toMap(lookup.CategoryFilter)
+
+	objects := make([]attr.Value, 0)
+	for _, item := range result.GetDlp().GetDataTypes().GetItems() {
+		// custom data types are managed by the account, only the predefined ones are listed
+		if item == nil || !item.Predefined {
+			continue
+		}
+		if namesMap != nil && !contains(namesMap, item.Name) {
+			continue
+		}
+		if categoriesMap != nil && !contains(categoriesMap, item.Category) {
+			continue
+		}
+		obj, diags := types.ObjectValue(
+			DlpDataTypeItemAttrTypes,
+			map[string]attr.Value{
+				"category":    types.StringValue(item.Category),
+				"description": types.StringPointerValue(item.Description),
+				"id":          types.StringValue(item.ID),
+				"name":        types.StringValue(item.Name),
+			},
+		)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		objects = append(objects, obj)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: DlpDataTypeItemAttrTypes}, objects)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	lookup.Items = list
+	if diags := resp.State.Set(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+}
@@ -0,0 +1,469 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
+)
+
+const (
+	expiringRulesPolicyIfw        = "INTERNET_FIREWALL"
+	expiringRulesPolicyWan        = "WAN_FIREWALL"
+	expiringRulesPolicyWanNetwork = "WAN_NETWORK"
+	expiringRulesPolicyTLS        = "TLS_INSPECTION"
+	expiringRulesPolicyAppTenant  = "APP_TENANT_RESTRICTION"
+	expiringRulesPolicyAppControl = "APPLICATION_CONTROL"
+
+	expiringRuleSourceActivePeriod    = "ACTIVE_PERIOD"
+	expiringRuleSourceLifecycleWindow = "LIFECYCLE_WINDOW"
+)
+
+var expiringRulesPolicyTypes = []string{
+	expiringRulesPolicyIfw,
+	expiringRulesPolicyWan,
+	expiringRulesPolicyWanNetwork,
+	expiringRulesPolicyTLS,
+	expiringRulesPolicyAppTenant,
+	expiringRulesPolicyAppControl,
+}
+
+type ExpiringRulesLookup struct {
+	WithinDays       types.Int64  `tfsdk:"within_days"`
+	PolicyTypes      types.List   `tfsdk:"policy_types"`
+	IncludeExpired   types.Bool   `tfsdk:"include_expired"`
+	LifecycleWindows types.List   `tfsdk:"lifecycle_windows"`
+	Rules            types.List   `tfsdk:"rules"`
+	AccountID        types.String `tfsdk:"account_id"`
+}
+
+// ExpiringRulesLifecycleWindow is a lifecycle_window of a rule resource passed
+// to the data source, as the API does not store it for every rule type.
+type ExpiringRulesLifecycleWindow struct {
+	PolicyType types.String `tfsdk:"policy_type"`
+	RuleID     types.String `tfsdk:"rule_id"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+var expiringRuleAttrTypes = map[string]attr.Type{
+	"policy_type": types.StringType,
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"enabled":     types.BoolType,
+	"expires_at":  types.StringType,
+	"days_left":   types.Int64Type,
+	"source":      types.StringType,
+}
+
+// expiringRule is a policy rule with an expiry date, from its active period or
+// from a lifecycle_window. Rules listed for a lifecycle_window lookup have a
+// zero expiresAt.
+type expiringRule struct {
+	policyType string
+	id         string
+	name       string
+	enabled    bool
+	expiresAt  time.Time
+	source     string
+}
+
+func ExpiringRulesDataSource() datasource.DataSource {
+	return &expiringRulesDataSource{}
+}
+
+type expiringRulesDataSource struct {
+	client *catoClientData
+}
+
+func (d *expiringRulesDataSource) clientData() **catoClientData { return &d.client }
+
+func (d *expiringRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expiring_rules"
+}
+
+func (d *expiringRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists policy rules expiring within the given number of days. Internet and WAN Firewall rules are found " +
+			"through the expiry stored on the rule in the Cato policy (rule.active_period.expires_at), which is also where their " +
+			"lifecycle_window is sent. WAN network, TLS inspection, app tenant restriction and application control rules have no " +
+			"expiry in the API; their lifecycle_window is only known to Terraform, so pass it in lifecycle_windows to have them reported.",
+		Attributes: map[string]schema.Attribute{
+			"within_days": schema.Int64Attribute{
+				Description: "Return rules expiring within this many days from now",
+				Required:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"policy_types": schema.ListAttribute{
+				Description: "Policies to search (INTERNET_FIREWALL, WAN_FIREWALL, WAN_NETWORK, TLS_INSPECTION, APP_TENANT_RESTRICTION, " +
+					"APPLICATION_CONTROL); defaults to all",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(expiringRulesPolicyTypes...)),
+				},
+			},
+			"include_expired": schema.BoolAttribute{
+				Description: "Also return rules whose expiry has already passed",
+				Optional:    true,
+			},
+			"lifecycle_windows": schema.ListNestedAttribute{
+				Description: "lifecycle_window expiries of rule resources, e.g. rule.id and lifecycle_window.expires_at of a " +
+					"cato_tls_rule. Rules no longer in the policy are skipped.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_type": schema.StringAttribute{
+							Description: "Policy housing the rule (INTERNET_FIREWALL, WAN_FIREWALL, WAN_NETWORK, TLS_INSPECTION, " +
+								"APP_TENANT_RESTRICTION, APPLICATION_CONTROL)",
+							Required:   true,
+							Validators: []validator.String{stringvalidator.OneOf(expiringRulesPolicyTypes...)},
+						},
+						"rule_id": schema.StringAttribute{
+							Description: "Rule ID",
+							Required:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "lifecycle_window.expires_at of the rule (2006-01-02T15:04:05Z); a null value never expires",
+							Optional:    true,
+							Validators:  []validator.String{validators.DateTimeValidator{}},
+						},
+					},
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Expiring rules, soonest first",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_type": schema.StringAttribute{
+							Description: "Policy housing the rule (INTERNET_FIREWALL, WAN_FIREWALL, WAN_NETWORK, TLS_INSPECTION, " +
+								"APP_TENANT_RESTRICTION, APPLICATION_CONTROL)",
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Rule ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Rule name",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the rule is enabled",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Expiry of the rule (2006-01-02T15:04:05Z)",
+							Computed:    true,
+						},
+						"days_left": schema.Int64Attribute{
+							Description: "Whole days until the rule expires, negative once expired",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Where the expiry comes from (ACTIVE_PERIOD, LIFECYCLE_WINDOW)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *expiringRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*catoClientData)
+}
+
+func (d *expiringRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
+	var lookup ExpiringRulesLookup
+	resp.Diagnostics.Append(req.Config.Get(ctx, &lookup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyTypes := expiringRulesPolicyTypes
+	if !lookup.PolicyTypes.IsNull() && !lookup.PolicyTypes.IsUnknown() {
+		policyTypes = nil
+		resp.Diagnostics.Append(lookup.PolicyTypes.ElementsAs(ctx, &policyTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var windows []ExpiringRulesLifecycleWindow
+	if !lookup.LifecycleWindows.IsNull() && !lookup.LifecycleWindows.IsUnknown() {
+		resp.Diagnostics.Append(lookup.LifecycleWindows.ElementsAs(ctx, &windows, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	windowPolicies := make(map[string]bool, len(windows))
+	for _, w := range windows {
+		windowPolicies[w.PolicyType.ValueString()] = true
+	}
+
+	var candidates, policyRules []expiringRule
+	for _, policyType := range policyTypes {
+		switch policyType {
+		case expiringRulesPolicyIfw:
+			body, err := d.client.catov2.PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, d.client.AccountId)
+			if err != nil {
+				resp.Diagnostics.AddError("Catov2 API PolicyInternetFirewall error", err.Error())
+				return
+			}
+			candidates = append(candidates, ifwExpiringRules(body)...)
+		case expiringRulesPolicyWan:
+			body, err := d.client.catov2.PolicyWanFirewall(ctx, &cato_models.WanFirewallPolicyInput{}, d.client.AccountId)
+			if err != nil {
+				resp.Diagnostics.AddError("Catov2 API PolicyWanFirewall error", err.Error())
+				return
+			}
+			candidates = append(candidates, wanExpiringRules(body)...)
+		case expiringRulesPolicyWanNetwork:
+			if !windowPolicies[policyType] {
+				continue
+			}
+			body, err := d.client.catov2.WanNetworkPolicy(ctx, d.client.AccountId)
+			if err != nil {
+				resp.Diagnostics.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+				return
+			}
+			policyRules = append(policyRules, wanNetworkPolicyRules(body)...)
+		case expiringRulesPolicyTLS:
+			if !windowPolicies[policyType] {
+				continue
+			}
+			body, err := d.client.catov2.Tlsinspectpolicy(ctx, d.client.AccountId)
+			if err != nil {
+				resp.Diagnostics.AddError("Catov2 API PolicyTlsInspect error", err.Error())
+				return
+			}
+			policyRules = append(policyRules, tlsPolicyRules(body)...)
+		case expiringRulesPolicyAppTenant:
+			if !windowPolicies[policyType] {
+				continue
+			}
+			body, err := d.client.catov2.AppTenantRestrictionPolicy(ctx, d.client.AccountId)
+			if err != nil {
+				resp.Diagnostics.AddError("Cato API AppTenantRestrictionPolicy error", err.Error())
+				return
+			}
+			policyRules = append(policyRules, appTenantRestrictionPolicyRules(body)...)
+		case expiringRulesPolicyAppControl:
+			if !windowPolicies[policyType] {
+				continue
+			}
+			body, err := d.client.catov2.ApplicationControlPolicy(ctx, d.client.AccountId)
+			if err != nil {
+				resp.Diagnostics.AddError("Cato API ApplicationControlPolicy error", err.Error())
+				return
+			}
+			policyRules = append(policyRules, applicationControlPolicyRules(body)...)
+		}
+	}
+	candidates = append(candidates, lifecycleWindowExpiringRules(windows, candidates, policyRules)...)
+
+	now := time.Now().UTC()
+	found := filterExpiringRules(candidates, now, lookup.WithinDays.ValueInt64(), lookup.IncludeExpired.ValueBool())
+	tflog.Debug(ctx, "Read.ExpiringRules", map[string]interface{}{
+		"candidates": len(candidates),
+		"found":      len(found),
+	})
+
+	rules := make([]attr.Value, 0, len(found))
+	for _, r := range found {
+		obj, diags := types.ObjectValue(expiringRuleAttrTypes, map[string]attr.Value{
+			"policy_type": types.StringValue(r.policyType),
+			"id":          types.StringValue(r.id),
+			"name":        types.StringValue(r.name),
+			"enabled":     types.BoolValue(r.enabled),
+			"expires_at":  types.StringValue(r.expiresAt.Format(time.RFC3339)),
+			"days_left":   types.Int64Value(expiringRuleDaysLeft(r.expiresAt, now)),
+			"source":      types.StringValue(r.source),
+		})
+		resp.Diagnostics.Append(diags...)
+		rules = append(rules, obj)
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: expiringRuleAttrTypes}, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup.Rules = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &lookup)...)
+}
+
+// ifwExpiringRules returns the Internet Firewall rules that have an expiry set.
+func ifwExpiringRules(body *cato_go_sdk.Policy) []expiringRule {
+	var out []expiringRule
+	for _, item := range body.GetPolicy().GetInternetFirewall().GetPolicy().GetRules() {
+		rule := item.GetRule()
+		if rule == nil || !rule.ActivePeriod.UseExpiresAt || rule.ActivePeriod.ExpiresAt == nil {
+			continue
+		}
+		expiresAt, err := parseFlexibleTimeString(*rule.ActivePeriod.ExpiresAt)
+		if err != nil {
+			continue
+		}
+		out = append(out, expiringRule{
+			policyType: expiringRulesPolicyIfw,
+			id:         rule.ID,
+			name:       rule.Name,
+			enabled:    rule.Enabled,
+			expiresAt:  expiresAt.UTC(),
+			source:     expiringRuleSourceActivePeriod,
+		})
+	}
+	return out
+}
+
+// wanExpiringRules mirrors ifwExpiringRules for WAN.
+func wanExpiringRules(body *cato_go_sdk.Policy) []expiringRule {
+	var out []expiringRule
+	for _, item := range body.GetPolicy().GetWanFirewall().GetPolicy().GetRules() {
+		rule := item.GetRule()
+		if rule == nil || !rule.ActivePeriod.UseExpiresAt || rule.ActivePeriod.ExpiresAt == nil {
+			continue
+		}
+		expiresAt, err := parseFlexibleTimeString(*rule.ActivePeriod.ExpiresAt)
+		if err != nil {
+			continue
+		}
+		out = append(out, expiringRule{
+			policyType: expiringRulesPolicyWan,
+			id:         rule.ID,
+			name:       rule.Name,
+			enabled:    rule.Enabled,
+			expiresAt:  expiresAt.UTC(),
+			source:     expiringRuleSourceActivePeriod,
+		})
+	}
+	return out
+}
+
+// wanNetworkPolicyRules lists the WAN network rules for lifecycle_window
+// lookups.
+func wanNetworkPolicyRules(body *cato_go_sdk.WanNetworkPolicy) []expiringRule {
+	var out []expiringRule
+	for _, item := range body.GetPolicy().GetWanNetwork().GetPolicy().GetRules() {
+		if rule := item.GetRule(); rule != nil {
+			out = append(out, expiringRule{policyType: expiringRulesPolicyWanNetwork, id: rule.ID, name: rule.Name, enabled: rule.Enabled})
+		}
+	}
+	return out
+}
+
+// tlsPolicyRules mirrors wanNetworkPolicyRules for TLS inspection.
+func tlsPolicyRules(body *cato_go_sdk.Tlsinspectpolicy) []expiringRule {
+	var out []expiringRule
+	for _, item := range body.GetPolicy().GetTLSInspect().GetPolicy().GetRules() {
+		if rule := item.GetRule(); rule != nil {
+			out = append(out, expiringRule{policyType: expiringRulesPolicyTLS, id: rule.ID, name: rule.Name, enabled: rule.Enabled})
+		}
+	}
+	return out
+}
+
+// appTenantRestrictionPolicyRules mirrors wanNetworkPolicyRules for app tenant
+// restriction.
+func appTenantRestrictionPolicyRules(body *cato_go_sdk.AppTenantRestrictionPolicy) []expiringRule {
+	var out []expiringRule
+	for _, item := range body.GetPolicy().GetAppTenantRestriction().GetPolicy().GetRules() {
+		if rule := item.GetRule(); rule != nil {
+			out = append(out, expiringRule{policyType: expiringRulesPolicyAppTenant, id: rule.GetID(), name: rule.GetName(), enabled: rule.GetEnabled()})
+		}
+	}
+	return out
+}
+
+// applicationControlPolicyRules mirrors wanNetworkPolicyRules for application
+// control.
+func applicationControlPolicyRules(body *cato_go_sdk.ApplicationControlPolicy) []expiringRule {
+	var out []expiringRule
+	for _, item := range body.GetPolicy().GetApplicationControl().GetPolicy().GetRules() {
+		if rule := item.GetRule(); rule != nil {
+			out = append(out, expiringRule{policyType: expiringRulesPolicyAppControl, id: rule.GetID(), name: rule.GetName(), enabled: rule.GetEnabled()})
+		}
+	}
+	return out
+}
+
+// lifecycleWindowExpiringRules returns the rules of policyRules that have a
+// lifecycle_window expiry in windows. Windows without expires_at, on rules no
+// longer in the policy, or on rules already found through their active period
+// are skipped.
+func lifecycleWindowExpiringRules(windows []ExpiringRulesLifecycleWindow, found, policyRules []expiringRule) []expiringRule {
+	type ruleKey struct{ policyType, id string }
+	seen := make(map[ruleKey]bool, len(found))
+	for _, r := range found {
+		seen[ruleKey{r.policyType, r.id}] = true
+	}
+	rules := make(map[ruleKey]expiringRule, len(policyRules))
+	for _, r := range policyRules {
+		rules[ruleKey{r.policyType, r.id}] = r
+	}
+
+	var out []expiringRule
+	for _, w := range windows {
+		key := ruleKey{w.PolicyType.ValueString(), w.RuleID.ValueString()}
+		rule, ok := rules[key]
+		if !ok || seen[key] || w.ExpiresAt.IsNull() || w.ExpiresAt.IsUnknown() {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, w.ExpiresAt.ValueString())
+		if err != nil {
+			continue
+		}
+		seen[key] = true
+		rule.expiresAt = expiresAt.UTC()
+		rule.source = expiringRuleSourceLifecycleWindow
+		out = append(out, rule)
+	}
+	return out
+}
+
+// filterExpiringRules keeps the rules expiring between now and now+withinDays,
+// plus already expired ones when includeExpired is set, ordered soonest first.
+func filterExpiringRules(rules []expiringRule, now time.Time, withinDays int64, includeExpired bool) []expiringRule {
+	limit := now.Add(time.Duration(withinDays) * 24 * time.Hour)
+	out := make([]expiringRule, 0)
+	for _, r := range rules {
+		if r.expiresAt.After(limit) {
+			continue
+		}
+		if !includeExpired && !r.expiresAt.After(now) {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].expiresAt.Before(out[j].expiresAt) })
+	return out
+}
+
+// expiringRuleDaysLeft rounds the time left down to whole days.
+func expiringRuleDaysLeft(expiresAt, now time.Time) int64 {
+	return int64(math.Floor(expiresAt.Sub(now).Hours() / 24))
+}
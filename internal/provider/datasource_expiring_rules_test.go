@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterExpiringRules(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rules := []expiringRule{
+		{id: "later", expiresAt: now.Add(40 * 24 * time.Hour)},
+		{id: "soon", expiresAt: now.Add(10 * 24 * time.Hour)},
+		{id: "sooner", expiresAt: now.Add(2 * time.Hour)},
+		{id: "expired", expiresAt: now.Add(-3 * 24 * time.Hour)},
+	}
+
+	ids := func(in []expiringRule) []string {
+		out := make([]string, 0, len(in))
+		for _, r := range in {
+			out = append(out, r.id)
+		}
+		return out
+	}
+
+	require.Equal(t, []string{"sooner", "soon"}, ids(filterExpiringRules(rules, now, 30, false)))
+	require.Equal(t, []string{"expired", "sooner", "soon"}, ids(filterExpiringRules(rules, now, 30, true)))
+	require.Equal(t, []string{"sooner"}, ids(filterExpiringRules(rules, now, 1, false)))
+	require.Empty(t, filterExpiringRules(nil, now, 30, true))
+}
+
+func TestExpiringRuleDaysLeft(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, int64(0), expiringRuleDaysLeft(now.Add(2*time.Hour), now))
+	require.Equal(t, int64(9), expiringRuleDaysLeft(now.Add(9*24*time.Hour+time.Hour), now))
+	require.Equal(t, int64(-1), expiringRuleDaysLeft(now.Add(-time.Hour), now))
+}
+
+func TestLifecycleWindowExpiringRules(t *testing.T) {
+	t.Parallel()
+
+	window := func(policyType, ruleID, expiresAt string) ExpiringRulesLifecycleWindow {
+		w := ExpiringRulesLifecycleWindow{
+			PolicyType: types.StringValue(policyType),
+			RuleID:     types.StringValue(ruleID),
+			ExpiresAt:  types.StringNull(),
+		}
+		if expiresAt != "" {
+			w.ExpiresAt = types.StringValue(expiresAt)
+		}
+		return w
+	}
+	found := []expiringRule{
+		{policyType: expiringRulesPolicyIfw, id: "ifw-1", source: expiringRuleSourceActivePeriod},
+	}
+	policyRules := []expiringRule{
+		{policyType: expiringRulesPolicyTLS, id: "tls-1", name: "Bypass banking", enabled: true},
+		{policyType: expiringRulesPolicyTLS, id: "tls-2", name: "Open ended"},
+		{policyType: expiringRulesPolicyWanNetwork, id: "tls-1", name: "Same ID, other policy"},
+	}
+
+	got := lifecycleWindowExpiringRules([]ExpiringRulesLifecycleWindow{
+		window(expiringRulesPolicyTLS, "tls-1", "2026-04-01T00:00:00Z"),
+		window(expiringRulesPolicyTLS, "tls-2", ""),
+		window(expiringRulesPolicyTLS, "gone", "2026-04-01T00:00:00Z"),
+		window(expiringRulesPolicyIfw, "ifw-1", "2026-04-01T00:00:00Z"),
+	}, found, policyRules)
+
+	require.Equal(t, []expiringRule{{
+		policyType: expiringRulesPolicyTLS,
+		id:         "tls-1",
+		name:       "Bypass banking",
+		enabled:    true,
+		expiresAt:  time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		source:     expiringRuleSourceLifecycleWindow,
+	}}, got)
+}
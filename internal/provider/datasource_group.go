@@ -24,6 +24,8 @@ type groupDataSource struct {
 	client *catoClientData
 }
 
+func (d *groupDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group"
 }
@@ -92,6 +94,7 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -106,6 +109,9 @@ func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureR
 
 //nolint:gocyclo,funlen
 func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var config GroupsLookup
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -16,9 +16,10 @@ import (
 )
 
 type HostLookup struct {
-	NameFilter types.List `tfsdk:"name_filter"`
-	IPFilter   types.List `tfsdk:"ip_filter"`
-	Items      types.List `tfsdk:"items"`
+	NameFilter types.List   `tfsdk:"name_filter"`
+	IPFilter   types.List   `tfsdk:"ip_filter"`
+	Items      types.List   `tfsdk:"items"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
 
 type Host struct {
@@ -62,6 +63,8 @@ type hostDataSource struct {
 	client *catoClientData
 }
 
+func (d *hostDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *hostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_host"
 }
@@ -109,6 +112,7 @@ func (d *hostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -122,6 +126,9 @@ func (d *hostDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 }
 
 func (d *hostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var hostLookup HostLookup
 	if diags := req.Config.Get(ctx, &hostLookup); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
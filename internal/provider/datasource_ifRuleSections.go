@@ -15,8 +15,9 @@ import (
 )
 
 type ifRuleSectionLookup struct {
-	NameFilter types.List `tfsdk:"name_filter"`
-	Items      types.List `tfsdk:"items"`
+	NameFilter types.List   `tfsdk:"name_filter"`
+	Items      types.List   `tfsdk:"items"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
 
 func IfRuleSectionsDataSource() datasource.DataSource {
@@ -27,6 +28,8 @@ type ifRuleSectionsDataSource struct {
 	client *catoClientData
 }
 
+func (d *ifRuleSectionsDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *ifRuleSectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ifRuleSections"
 }
@@ -57,6 +60,7 @@ func (d *ifRuleSectionsDataSource) Schema(_ context.Context, _ datasource.Schema
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -70,6 +74,9 @@ func (d *ifRuleSectionsDataSource) Configure(_ context.Context, req datasource.C
 }
 
 func (d *ifRuleSectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var ifRuleSectionLookup ifRuleSectionLookup
 	if diags := req.Config.Get(ctx, &ifRuleSectionLookup); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
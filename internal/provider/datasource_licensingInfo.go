@@ -24,6 +24,8 @@ type licensingInfoDataSource struct {
 	client *catoClientData
 }
 
+func (d *licensingInfoDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *licensingInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_licensingInfo"
 }
@@ -230,6 +232,7 @@ func (d *licensingInfoDataSource) Schema(_ context.Context, _ datasource.SchemaR
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -244,6 +247,9 @@ func (d *licensingInfoDataSource) Configure(_ context.Context, req datasource.Co
 
 //nolint:gocyclo,funlen
 func (d *licensingInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var state LicenseDataSource
 	if diags := req.Config.Get(ctx, &state); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
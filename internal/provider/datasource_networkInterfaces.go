@@ -71,6 +71,7 @@ type NetworkInterfaceLookup struct {
 	SiteID                types.String `tfsdk:"site_id"`
 	NetworkInterfaceIndex types.String `tfsdk:"network_interface_index"`
 	Items                 types.List   `tfsdk:"items"`
+	AccountID             types.String `tfsdk:"account_id"`
 }
 
 type NetworkInterface struct {
@@ -90,6 +91,8 @@ type networkInterfacesDataSource struct {
 	client *catoClientData
 }
 
+func (d *networkInterfacesDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *networkInterfacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_networkInterfaces"
 }
@@ -147,6 +150,7 @@ func (d *networkInterfacesDataSource) Schema(_ context.Context, _ datasource.Sch
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -161,6 +165,9 @@ func (d *networkInterfacesDataSource) Configure(_ context.Context, req datasourc
 
 //nolint:gocyclo,funlen
 func (d *networkInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var networkInterfacesDataSource NetworkInterfaceLookup
 	if diags := req.Config.Get(ctx, &networkInterfacesDataSource); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
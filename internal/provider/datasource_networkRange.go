@@ -26,6 +26,8 @@ type networkRangesDataSource struct {
 	client *catoClientData
 }
 
+func (d *networkRangesDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *networkRangesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_networkRanges"
 }
@@ -91,6 +93,7 @@ func (d *networkRangesDataSource) Schema(_ context.Context, _ datasource.SchemaR
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -105,6 +108,9 @@ func (d *networkRangesDataSource) Configure(_ context.Context, req datasource.Co
 
 //nolint:gocyclo,funlen
 func (d *networkRangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var networkRangesDataSource tf.NetworkRangeLookup
 	if diags := req.Config.Get(ctx, &networkRangesDataSource); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	policyAnalysisIfw = "INTERNET_FIREWALL"
+	policyAnalysisWan = "WAN_FIREWALL"
+	policyAnalysisWnw = "WAN_NETWORK"
+	policyAnalysisLan = "LAN_FIREWALL"
+)
+
+type PolicyAnalysisLookup struct {
+	PolicyType         types.String `tfsdk:"policy_type"`
+	RuleCount          types.Int64  `tfsdk:"rule_count"`
+	Findings           types.List   `tfsdk:"findings"`
+	ShadowedRuleIDs    types.List   `tfsdk:"shadowed_rule_ids"`
+	RedundantRuleIDs   types.List   `tfsdk:"redundant_rule_ids"`
+	AnyAnyAllowRuleIDs types.List   `tfsdk:"any_any_allow_rule_ids"`
+	DisabledRuleIDs    types.List   `tfsdk:"disabled_rule_ids"`
+	AccountID          types.String `tfsdk:"account_id"`
+}
+
+var policyFindingAttrTypes = map[string]attr.Type{
+	"type":              types.StringType,
+	"rule_id":           types.StringType,
+	"rule_name":         types.StringType,
+	"rule_index":        types.Int64Type,
+	"related_rule_id":   types.StringType,
+	"related_rule_name": types.StringType,
+	"detail":            types.StringType,
+}
+
+func PolicyAnalysisDataSource() datasource.DataSource {
+	return &policyAnalysisDataSource{}
+}
+
+type policyAnalysisDataSource struct {
+	client *catoClientData
+}
+
+func (d *policyAnalysisDataSource) clientData() **catoClientData { return &d.client }
+
+func (d *policyAnalysisDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_analysis"
+}
+
+func (d *policyAnalysisDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ruleIDs := func(desc string) schema.ListAttribute {
+		return schema.ListAttribute{Description: desc, Computed: true, ElementType: types.StringType}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Analyzes the rules of a policy in evaluation order and reports shadowed, redundant, any/any ALLOW and disabled rules, " +
+			"e.g. to assert in a check block that a policy has no unreachable rules. A rule is reported as shadowed or redundant only " +
+			"when every one of its match criteria is contained in an earlier enabled rule without exceptions; rules of a sub-policy are " +
+			"only compared with rules of the main policy and of the same sub-policy.",
+		Attributes: map[string]schema.Attribute{
+			"policy_type": schema.StringAttribute{
+				Description: "Policy to analyze (INTERNET_FIREWALL, WAN_FIREWALL, WAN_NETWORK, LAN_FIREWALL)",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyAnalysisIfw, policyAnalysisWan, policyAnalysisWnw, policyAnalysisLan),
+				},
+			},
+			"rule_count": schema.Int64Attribute{
+				Description: "Number of rules analyzed",
+				Computed:    true,
+			},
+			"findings": schema.ListNestedAttribute{
+				Description: "Findings in policy order",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Finding type (SHADOWED, REDUNDANT, ANY_ANY_ALLOW, DISABLED)",
+							Computed:    true,
+						},
+						"rule_id": schema.StringAttribute{
+							Description: "ID of the rule the finding is about",
+							Computed:    true,
+						},
+						"rule_name": schema.StringAttribute{
+							Description: "Name of the rule the finding is about",
+							Computed:    true,
+						},
+						"rule_index": schema.Int64Attribute{
+							Description: "1-based position of the rule in the policy",
+							Computed:    true,
+						},
+						"related_rule_id": schema.StringAttribute{
+							Description: "ID of the earlier rule that covers a SHADOWED or REDUNDANT rule",
+							Computed:    true,
+						},
+						"related_rule_name": schema.StringAttribute{
+							Description: "Name of the earlier rule that covers a SHADOWED or REDUNDANT rule",
+							Computed:    true,
+						},
+						"detail": schema.StringAttribute{
+							Description: "Explanation of the finding",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"shadowed_rule_ids":      ruleIDs("IDs of rules covered by an earlier rule with a different action"),
+			"redundant_rule_ids":     ruleIDs("IDs of rules covered by an earlier rule with the same action"),
+			"any_any_allow_rule_ids": ruleIDs("IDs of enabled ALLOW rules whose every matching criterion is any"),
+			"disabled_rule_ids":      ruleIDs("IDs of disabled rules"),
+			"account_id":             accountIDOverrideDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *policyAnalysisDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*catoClientData)
+}
+
+func (d *policyAnalysisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
+	var lookup PolicyAnalysisLookup
+	resp.Diagnostics.Append(req.Config.Get(ctx, &lookup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []policyAnalysisRule
+	var shape policyAnalysisShape
+	var diags diag.Diagnostics
+	switch lookup.PolicyType.ValueString() {
+	case policyAnalysisIfw:
+		rules, diags = d.ifwRules(ctx)
+		shape = ifwPolicyAnalysisShape
+	case policyAnalysisWan:
+		rules, diags = d.wanRules(ctx)
+		shape = wanPolicyAnalysisShape
+	case policyAnalysisWnw:
+		rules, diags = d.wnwRules(ctx)
+		shape = wnwPolicyAnalysisShape
+	case policyAnalysisLan:
+		rules, diags = d.lanRules(ctx)
+		shape = lanPolicyAnalysisShape
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	findings := analyzePolicy(rules, shape)
+	tflog.Debug(ctx, "Read.PolicyAnalysis", map[string]interface{}{
+		"policy_type": lookup.PolicyType.ValueString(),
+		"rules":       len(rules),
+		"findings":    len(findings),
+	})
+
+	objs := make([]attr.Value, 0, len(findings))
+	ids := map[string][]string{}
+	for _, f := range findings {
+		relatedID, relatedName := types.StringNull(), types.StringNull()
+		if f.related != nil {
+			relatedID = types.StringValue(f.related.str("id"))
+			relatedName = types.StringValue(f.related.str("name"))
+		}
+		obj, diags := types.ObjectValue(policyFindingAttrTypes, map[string]attr.Value{
+			"type":              types.StringValue(f.kind),
+			"rule_id":           types.StringValue(f.rule.str("id")),
+			"rule_name":         types.StringValue(f.rule.str("name")),
+			"rule_index":        types.Int64Value(f.rule.index),
+			"related_rule_id":   relatedID,
+			"related_rule_name": relatedName,
+			"detail":            types.StringValue(f.detail),
+		})
+		resp.Diagnostics.Append(diags...)
+		objs = append(objs, obj)
+		ids[f.kind] = append(ids[f.kind], f.rule.str("id"))
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: policyFindingAttrTypes}, objs)
+	resp.Diagnostics.Append(diags...)
+	lookup.Findings = list
+	lookup.RuleCount = types.Int64Value(int64(len(rules)))
+	for kind, target := range map[string]*types.List{
+		policyFindingShadowed:    &lookup.ShadowedRuleIDs,
+		policyFindingRedundant:   &lookup.RedundantRuleIDs,
+		policyFindingAnyAnyAllow: &lookup.AnyAnyAllowRuleIDs,
+		policyFindingDisabled:    &lookup.DisabledRuleIDs,
+	} {
+		values := ids[kind]
+		if values == nil {
+			values = []string{}
+		}
+		*target, diags = types.ListValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &lookup)...)
+}
+
+// policyAnalysisRuleFrom converts a hydrated rule model into the attributes
+// the analyzer compares.
+func policyAnalysisRuleFrom(ctx context.Context, attrTypes map[string]attr.Type, model any, index int, group string) (policyAnalysisRule, diag.Diagnostics) {
+	obj, diags := types.ObjectValueFrom(ctx, attrTypes, model)
+	return policyAnalysisRule{index: int64(index), group: group, attrs: obj.Attributes()}, diags
+}
+
+func (d *policyAnalysisDataSource) ifwRules(ctx context.Context) ([]policyAnalysisRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body, err := d.client.catov2.PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, d.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyInternetFirewall error", err.Error())
+		return nil, diags
+	}
+	var rules []policyAnalysisRule
+	for i, item := range body.GetPolicy().GetInternetFirewall().GetPolicy().GetRules() {
+		if rt := item.GetRuleType(); item.GetRule() == nil || (rt != nil && *rt == subPolicyScopeRuleType) {
+			continue
+		}
+		hydrated := hydrateIfwRuleState(ctx, InternetFirewallRule{Rule: types.ObjectNull(InternetFirewallRuleRuleAttrTypes)}, item.GetRule())
+		rule, d := policyAnalysisRuleFrom(ctx, InternetFirewallRuleRuleAttrTypes, hydrated, i+1, item.GetSubPolicy().GetID())
+		diags.Append(d...)
+		rules = append(rules, rule)
+	}
+	return rules, diags
+}
+
+func (d *policyAnalysisDataSource) wanRules(ctx context.Context) ([]policyAnalysisRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body, err := d.client.catov2.PolicyWanFirewall(ctx, &cato_models.WanFirewallPolicyInput{}, d.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyWanFirewall error", err.Error())
+		return nil, diags
+	}
+	var rules []policyAnalysisRule
+	for i, item := range body.GetPolicy().GetWanFirewall().GetPolicy().GetRules() {
+		if rt := item.GetRuleType(); item.GetRule() == nil || (rt != nil && *rt == subPolicyScopeRuleType) {
+			continue
+		}
+		hydrated, d := hydrateWanRuleState(ctx, WanFirewallRule{Rule: types.ObjectNull(WanFirewallRuleRuleAttrTypes)}, item.GetRule())
+		diags.Append(d...)
+		rule, d := policyAnalysisRuleFrom(ctx, WanFirewallRuleRuleAttrTypes, hydrated, i+1, item.GetSubPolicy().GetID())
+		diags.Append(d...)
+		rules = append(rules, rule)
+	}
+	return rules, diags
+}
+
+func (d *policyAnalysisDataSource) wnwRules(ctx context.Context) ([]policyAnalysisRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body, err := d.client.catov2.WanNetworkPolicy(ctx, d.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+		return nil, diags
+	}
+	var rules []policyAnalysisRule
+	for i, item := range body.GetPolicy().GetWanNetwork().GetPolicy().GetRules() {
+		if rt := item.GetRuleType(); item.GetRule() == nil || (rt != nil && *rt == subPolicyScopeRuleType) {
+			continue
+		}
+		hydrated, d := hydrateWanNetworkRuleState(ctx, WanNetworkRule{Rule: types.ObjectNull(WanNetworkRuleRuleAttrTypes)}, item.GetRule())
+		diags.Append(d...)
+		rule, d := policyAnalysisRuleFrom(ctx, WanNetworkRuleRuleAttrTypes, hydrated, i+1, item.GetSubPolicy().GetID())
+		diags.Append(d...)
+		rules = append(rules, rule)
+	}
+	return rules, diags
+}
+
+// lanRules returns the firewall rules of every LAN network rule; each network
+// rule's firewall rules form their own group.
+func (d *policyAnalysisDataSource) lanRules(ctx context.Context) ([]policyAnalysisRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body, err := d.client.catov2.PolicySocketLanPolicy(ctx, d.client.AccountId, nil)
+	if err != nil {
+		diags.AddError("Catov2 API PolicySocketLanPolicy error", err.Error())
+		return nil, diags
+	}
+	var rules []policyAnalysisRule
+	index := 0
+	for _, network := range body.Policy.SocketLan.Policy.Rules {
+		for _, fw := range network.Rule.Firewall {
+			index++
+			hydrated := hydrateSocketLanFirewallRuleState(ctx, SocketLanFirewallRule{Rule: types.ObjectNull(SocketLanFirewallRuleRuleAttrTypes)}, &fw.Rule)
+			rule, d := policyAnalysisRuleFrom(ctx, SocketLanFirewallRuleRuleAttrTypes, hydrated, index, network.Rule.ID)
+			diags.Append(d...)
+			rules = append(rules, rule)
+		}
+	}
+	return rules, diags
+}
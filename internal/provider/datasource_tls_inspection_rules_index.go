@@ -20,6 +20,8 @@ type tlsRulesIndexDataSource struct {
 	client *catoClientData
 }
 
+func (d *tlsRulesIndexDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *tlsRulesIndexDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tlsRulesIndex"
 }
@@ -82,6 +84,7 @@ func (d *tlsRulesIndexDataSource) Schema(_ context.Context, _ datasource.SchemaR
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -107,11 +110,16 @@ var TLSRuleIndexAttrTypes = map[string]attr.Type{
 }
 
 type TLSRuleIndexLookup struct {
-	Rules types.List `tfsdk:"rules"`
+	Rules     types.List   `tfsdk:"rules"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
-func (d *tlsRulesIndexDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *tlsRulesIndexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var tlsRuleIndexLookup TLSRuleIndexLookup
+	tlsRuleIndexLookup.AccountID = accountID
 	ruleIndexAPIData, err := d.client.catov2.Tlsinspectpolicy(ctx, d.client.AccountId)
 	tflog.Debug(ctx, "Read.Tlsinspectpolicy.response", map[string]interface{}{
 		"response": utils.InterfaceToJSONString(ruleIndexAPIData),
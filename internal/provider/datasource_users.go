@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spf13/cast"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+func UsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *catoClientData
+}
+
+func (d *usersDataSource) clientData() **catoClientData { return &d.client }
+
+// userFilter holds the lookup maps of the configured filters; a nil map means the filter is not used
+type userFilter struct {
+	idsMap    map[string]struct{}
+	namesMap  map[string]struct{}
+	emailsMap map[string]struct{}
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	filterAttr := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: description,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_users` data source fetches the SDP (remote) users with optional filters. " +
+			"When several filters are set, a user must match all of them. " +
+			"The returned `id` and `name` can be used as user references in policy rules.",
+		Attributes: map[string]schema.Attribute{
+			"id_filter":    filterAttr("List of user IDs to filter by"),
+			"name_filter":  filterAttr("List of user names to filter by"),
+			"email_filter": filterAttr("List of user email addresses to filter by (case insensitive)"),
+			"items": schema.ListNestedAttribute{
+				Description: "List of users matching the filter criteria",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "User ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "User name",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "User email address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*catoClientData)
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
+	var lookup UsersLookup
+	if diags := req.Config.Get(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	zeroInt64 := int64(0)
+	result, err := d.client.catov2.EntityLookup(
+		ctx, d.client.AccountId, cato_models.EntityTypeVpnUser, &zeroInt64, nil, nil, nil, nil, nil, nil, nil,
+	)
+	tflog.Debug(ctx, "Read.EntityLookup.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(result),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API EntityLookup error", err.Error())
+		return
+	}
+
+	filter := makeUserFilter(&lookup)
+	objects := make([]attr.Value, 0)
+
+	for _, item := range result.GetEntityLookup().GetItems() {
+		entity := item.GetEntity()
+		name := cast.ToString(entity.Name)
+		email := cast.ToString(item.GetHelperFields()["email"])
+		if !filter.accept(entity.ID, name, email) {
+			continue
+		}
+		obj, diags := types.ObjectValue(
+			UserItemAttrTypes,
+			map[string]attr.Value{
+				"id":    types.StringValue(entity.ID),
+				"name":  types.StringValue(name),
+				"email": types.StringValue(email),
+			},
+		)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		objects = append(objects, obj)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: UserItemAttrTypes}, objects)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	lookup.Items = list
+	if diags := resp.State.Set(ctx, &lookup); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// makeUserFilter prepares the lookup maps for the configured filters, emails are lower-cased
+func makeUserFilter(lookup *UsersLookup) *userFilter {
+	toMap := func(list types.List, normalize func(string) string) map[string]struct{} {
+		if list.IsNull() || list.IsUnknown() {
+			return nil
+		}
+		m := make(map[string]struct{}, len(list.Elements()))
+		for _, value := range list.Elements() {
+			if s, ok := value.(types.String); ok {
+				m[normalize(s.ValueString())] = struct{}{}
+			}
+		}
+		return m
+	}
+	keep := func(s string) string { return s }
+
+	return &userFilter{
+		idsMap:    toMap(lookup.IDFilter, keep),
+		namesMap:  toMap(lookup.NameFilter, keep),
+		emailsMap: toMap(lookup.EmailFilter, strings.ToLower),
+	}
+}
+
+// accept returns true if the user matches all the configured filters
+func (f *userFilter) accept(id, name, email string) bool {
+	if f.idsMap != nil && !contains(f.idsMap, id) {
+		return false
+	}
+	if f.namesMap != nil && !contains(f.namesMap, name) {
+		return false
+	}
+	if f.emailsMap != nil && !contains(f.emailsMap, strings.ToLower(email)) {
+		return false
+	}
+	return true
+}
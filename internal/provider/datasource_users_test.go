@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserFilterAccept(t *testing.T) {
+	t.Parallel()
+
+	stringList := func(values ...string) types.List {
+		if values == nil {
+			return types.ListNull(types.StringType)
+		}
+		elems := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+
+	tests := map[string]struct {
+		lookup UsersLookup
+		id     string
+		name   string
+		email  string
+		want   bool
+	}{
+		"no filters": {
+			lookup: UsersLookup{IDFilter: stringList(), NameFilter: stringList(), EmailFilter: stringList()},
+			id:     "1", name: "Jane Doe", email: "jane@example.com", want: true,
+		},
+		"email filter is case insensitive": {
+			lookup: UsersLookup{IDFilter: stringList(), NameFilter: stringList(), EmailFilter: stringList("Jane@Example.com")},
+			id:     "1", name: "Jane Doe", email: "jane@example.COM", want: true,
+		},
+		"name filter mismatch": {
+			lookup: UsersLookup{IDFilter: stringList(), NameFilter: stringList("John Doe"), EmailFilter: stringList()},
+			id:     "1", name: "Jane Doe", email: "jane@example.com",
+		},
+		"all filters must match": {
+			lookup: UsersLookup{IDFilter: stringList("1"), NameFilter: stringList("Jane Doe"), EmailFilter: stringList("john@example.com")},
+			id:     "1", name: "Jane Doe", email: "jane@example.com",
+		},
+		"id and name match": {
+			lookup: UsersLookup{IDFilter: stringList("1", "2"), NameFilter: stringList("Jane Doe"), EmailFilter: stringList()},
+			id:     "2", name: "Jane Doe", email: "jane@example.com", want: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, makeUserFilter(&tt.lookup).accept(tt.id, tt.name, tt.email))
+		})
+	}
+}
@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -22,6 +24,8 @@ type wanRulesIndexDataSource struct {
 	client *catoClientData
 }
 
+func (d *wanRulesIndexDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *wanRulesIndexDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wfRulesIndex"
 }
@@ -87,9 +91,27 @@ func (d *wanRulesIndexDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Optional:    true,
 							ElementType: types.StringType,
 						},
+						"hit_count": schema.Int64Attribute{
+							Description: "Number of events the rule matched within hit_count_lookback_days, null unless include_hit_counts is set",
+							Computed:    true,
+						},
+						"last_hit": schema.StringAttribute{
+							Description: "Time of the last event the rule matched within hit_count_lookback_days (2006-01-02T15:04:05Z), null when there was none",
+							Computed:    true,
+						},
 					},
 				},
 			},
+			"include_hit_counts": schema.BoolAttribute{
+				Description: "Populate hit_count and last_hit on every rule from the events API. Off by default as it adds an events query per read.",
+				Optional:    true,
+			},
+			"hit_count_lookback_days": schema.Int64Attribute{
+				Description: "Number of days of events counted in hit_count and last_hit (default 30)",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -115,14 +137,27 @@ var WanRuleIndexAttrTypes = map[string]attr.Type{
 	"properties": types.ListType{
 		ElemType: types.StringType,
 	},
+	"hit_count": types.Int64Type,
+	"last_hit":  types.StringType,
 }
 
 type WanRuleIndexLookup struct {
-	Rules types.List `tfsdk:"rules"`
+	Rules                types.List   `tfsdk:"rules"`
+	IncludeHitCounts     types.Bool   `tfsdk:"include_hit_counts"`
+	HitCountLookbackDays types.Int64  `tfsdk:"hit_count_lookback_days"`
+	AccountID            types.String `tfsdk:"account_id"`
 }
 
-func (d *wanRulesIndexDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *wanRulesIndexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var wanRuleIndexLookup WanRuleIndexLookup
+	resp.Diagnostics.Append(req.Config.Get(ctx, &wanRuleIndexLookup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	wanRuleIndexLookup.AccountID = accountID
 	ruleIndexAPIData, err := d.client.catov2.PolicyWanFirewallRulesIndex(ctx, d.client.AccountId)
 	tflog.Debug(ctx, "Read.PolicyWanFirewallRulesIndex.response", map[string]interface{}{
 		"response": utils.InterfaceToJSONString(ruleIndexAPIData),
@@ -149,6 +184,18 @@ func (d *wanRulesIndexDataSource) Read(ctx context.Context, _ datasource.ReadReq
 		return
 	}
 
+	var hits map[string]ruleHit
+	if wanRuleIndexLookup.IncludeHitCounts.ValueBool() {
+		hits, err = fetchRuleHits(ctx, d.client.catov2, d.client.AccountId, ruleHitsSubTypeWan, wanRuleIndexLookup.HitCountLookbackDays)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Catov2 API Events error",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	var objects []attr.Value
 
 	sectionIDList := make(map[string]int64)
@@ -177,6 +224,7 @@ func (d *wanRulesIndexDataSource) Read(ctx context.Context, _ datasource.ReadReq
 				"response": utils.InterfaceToJSONString(item),
 			})
 
+			hitCount, lastHit := ruleHitValues(hits, item.Rule.ID)
 			ruleIndexStateData, diags := types.ObjectValue(
 				WanRuleIndexAttrTypes,
 				map[string]attr.Value{
@@ -190,6 +238,8 @@ func (d *wanRulesIndexDataSource) Read(ctx context.Context, _ datasource.ReadReq
 					"enabled":          types.BoolValue(item.Rule.Enabled),
 					"name":             types.StringValue(item.Rule.Name),
 					"properties":       propertiesValue,
+					"hit_count":        hitCount,
+					"last_hit":         lastHit,
 				},
 			)
 			resp.Diagnostics.Append(diags...)
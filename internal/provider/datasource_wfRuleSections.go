@@ -15,8 +15,9 @@ import (
 )
 
 type wfRuleSectionLookup struct {
-	NameFilter types.List `tfsdk:"name_filter"`
-	Items      types.List `tfsdk:"items"`
+	NameFilter types.List   `tfsdk:"name_filter"`
+	Items      types.List   `tfsdk:"items"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
 
 func WfRuleSectionsDataSource() datasource.DataSource {
@@ -27,6 +28,8 @@ type wfRuleSectionsDataSource struct {
 	client *catoClientData
 }
 
+func (d *wfRuleSectionsDataSource) clientData() **catoClientData { return &d.client }
+
 func (d *wfRuleSectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wfRuleSections"
 }
@@ -57,6 +60,7 @@ func (d *wfRuleSectionsDataSource) Schema(_ context.Context, _ datasource.Schema
 					},
 				},
 			},
+			"account_id": accountIDOverrideDataSourceAttribute(),
 		},
 	}
 }
@@ -70,6 +74,9 @@ func (d *wfRuleSectionsDataSource) Configure(_ context.Context, req datasource.C
 }
 
 func (d *wfRuleSectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.Config)
+	d = scopedToAccount(ctx, d, accountID)
+
 	var wfRuleSectionLookup wfRuleSectionLookup
 	if diags := req.Config.Get(ctx, &wfRuleSectionLookup); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
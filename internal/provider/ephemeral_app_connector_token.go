@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &appConnectorTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &appConnectorTokenEphemeralResource{}
+)
+
+func NewAppConnectorTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &appConnectorTokenEphemeralResource{}
+}
+
+type appConnectorTokenEphemeralResource struct {
+	client *catoClientData
+}
+
+func (e *appConnectorTokenEphemeralResource) clientData() **catoClientData { return &e.client }
+
+func (e *appConnectorTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_connector_token"
+}
+
+func (e *appConnectorTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_app_connector_token` ephemeral resource generates the registration token of an app connector, " +
+			"used to provision a virtual connector (e.g. in the user data of a cloud instance). The token is never stored in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"connector_id": schema.StringAttribute{
+				Description: "ID of the app connector (`cato_app_connector`)",
+				Required:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Registration token of the connector",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiration time of the token",
+				Computed:    true,
+			},
+			"account_id": schema.StringAttribute{
+				Description: accountIDOverrideDescription,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (e *appConnectorTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	e.client = req.ProviderData.(*catoClientData)
+}
+
+// Open generates the registration token of the app connector
+func (e *appConnectorTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config AppConnectorTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	e = scopedToAccount(ctx, e, config.AccountID)
+
+	input := cato_models.ZtnaAppConnectorRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: config.ConnectorID.ValueString(),
+	}
+
+	// Call Cato API to generate the token, the response holds the token and is not logged
+	tflog.Debug(ctx, "AppConnectorGenerateToken", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := e.client.catov2.AppConnectorGenerateToken(ctx, e.client.AccountId, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API AppConnectorGenerateToken error", err.Error())
+		return
+	}
+
+	token := result.GetZtnaAppConnector().GetGenerateZtnaAppConnectorToken()
+	config.Token = types.StringValue(token.GetToken())
+	config.ExpiresAt = types.StringPointerValue(parse.NormalizeDateTimePtr(token.GetExpirationDate()))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
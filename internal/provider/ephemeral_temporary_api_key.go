@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &temporaryAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &temporaryAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &temporaryAPIKeyEphemeralResource{}
+)
+
+const (
+	temporaryAPIKeyPrivateKey        = "api_key_id"
+	temporaryAPIKeyDefaultTTLMinutes = 60
+)
+
+func NewTemporaryAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &temporaryAPIKeyEphemeralResource{}
+}
+
+type temporaryAPIKeyEphemeralResource struct {
+	client *catoClientData
+}
+
+func (e *temporaryAPIKeyEphemeralResource) clientData() **catoClientData { return &e.client }
+
+type temporaryAPIKeyPrivate struct {
+	AccountID string `json:"account_id,omitempty"`
+	ID        string `json:"id"`
+}
+
+func (e *temporaryAPIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_temporary_api_key"
+}
+
+func (e *temporaryAPIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_temporary_api_key` ephemeral resource creates a short-lived API key for the duration " +
+			"of a Terraform run and deletes it when the run ends. The key and its secret are never stored in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"allowed_ips": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "IP addresses or subnets (CIDR) allowed to use the key; any source IP is allowed when not set",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(apiKeyAllowedIPRE, "must be an IPv4 address or subnet")),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiration time of the key (RFC3339)",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the API key",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the API key",
+				Required:    true,
+			},
+			"role": schema.SingleNestedAttribute{
+				Description: "Admin role granted to the key (predefined or `cato_admin_role`)",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Role ID",
+						Optional:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Role name",
+						Optional:    true,
+					},
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The API key secret",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"ttl_minutes": schema.Int64Attribute{
+				Description: "Lifetime of the key in minutes, the key expires even if it is not deleted at the end of the run (default 60)",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(5, 24*60)},
+			},
+			"account_id": schema.StringAttribute{
+				Description: accountIDOverrideDescription,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (e *temporaryAPIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	e.client = req.ProviderData.(*catoClientData)
+}
+
+// Open creates the temporary API key
+func (e *temporaryAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config TemporaryAPIKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	e = scopedToAccount(ctx, e, config.AccountID)
+
+	ttl := int64(temporaryAPIKeyDefaultTTLMinutes)
+	if utils.HasValue(config.TTLMinutes) {
+		ttl = config.TTLMinutes.ValueInt64()
+	}
+	expiresAt := time.Now().UTC().Add(time.Duration(ttl) * time.Minute).Format(time.RFC3339)
+
+	input := cato_models.CreateAPIKeyInput{
+		AllowedIps:     parse.PrepareStrings[string](ctx, config.AllowedIPs, &resp.Diagnostics),
+		ExpirationDate: &expiresAt,
+		Name:           config.Name.ValueString(),
+		Role:           parse.PrepareIDRef[cato_models.AccountRoleRefInput](ctx, config.Role, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create the API key, the response holds the secret and is not logged
+	tflog.Debug(ctx, "APIKeyCreateAPIKey", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := e.client.catov2.APIKeyCreateAPIKey(ctx, e.client.AccountId, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyCreateAPIKey error", err.Error())
+		return
+	}
+
+	apiKey := result.GetAPIKey().GetCreateAPIKey().GetAPIKey()
+	config.ID = types.StringValue(apiKey.GetID())
+	config.Secret = types.StringValue(apiKey.GetSecret())
+	config.ExpiresAt = types.StringValue(expiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+
+	// keep the key ID to delete the key on close
+	private, err := json.Marshal(temporaryAPIKeyPrivate{AccountID: config.AccountID.ValueString(), ID: config.ID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error storing temporary API key ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, temporaryAPIKeyPrivateKey, private)...)
+}
+
+// Close deletes the temporary API key
+func (e *temporaryAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, temporaryAPIKeyPrivateKey)
+	if utils.CheckErr(&resp.Diagnostics, diags) || data == nil {
+		return
+	}
+
+	var private temporaryAPIKeyPrivate
+	if err := json.Unmarshal(data, &private); err != nil {
+		resp.Diagnostics.AddError("Error reading temporary API key ID", err.Error())
+		return
+	}
+	client := e.client.forAccount(ctx, types.StringValue(private.AccountID))
+	if err := deleteAPIKey(ctx, client, private.ID); err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyDeleteAPIKey error", err.Error())
+		return
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"context"
+
+	clientv2 "github.com/Yamashou/gqlgenc/clientv2"
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+)
+
+type EventsClient interface {
+	Events(
+		ctx context.Context,
+		accountID string,
+		timeFrame string,
+		measures []*cato_models.EventsMeasure,
+		dimensions []*cato_models.EventsDimension,
+		filters []*cato_models.EventsFilter,
+		sort []*cato_models.EventsSort,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.Events, error)
+}
@@ -1,6 +1,10 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
 )
@@ -71,6 +75,21 @@ func ifwScopeRule(body *cato_go_sdk.Policy, subID string) *cato_go_sdk.Policy_Po
 	return nil
 }
 
+// ifwSubPolicyScopeRuleIDs returns the set of SUB_POLICY_SCOPE rule ids in the
+// snapshot, one per Internet Firewall sub-policy.
+func ifwSubPolicyScopeRuleIDs(body *cato_go_sdk.Policy) map[string]struct{} {
+	ids := map[string]struct{}{}
+	if body == nil {
+		return ids
+	}
+	for _, rp := range body.GetPolicy().GetInternetFirewall().GetPolicy().GetRules() {
+		if rt := rp.GetRuleType(); rt != nil && *rt == subPolicyScopeRuleType {
+			ids[rp.GetRule().GetID()] = struct{}{}
+		}
+	}
+	return ids
+}
+
 // wanSubPolicyInfo returns the sub-policy info block for the given sub-policy id.
 func wanSubPolicyInfo(body *cato_go_sdk.Policy, subID string) *cato_go_sdk.Policy_Policy_WanFirewall_Policy_SubPolicies_Policy {
 	if body == nil || subID == "" {
@@ -130,6 +149,21 @@ func wanScopeRule(body *cato_go_sdk.Policy, subID string) *cato_go_sdk.Policy_Po
 	return nil
 }
 
+// wanSubPolicyScopeRuleIDs returns the set of SUB_POLICY_SCOPE rule ids in the
+// snapshot, one per WAN Firewall sub-policy.
+func wanSubPolicyScopeRuleIDs(body *cato_go_sdk.Policy) map[string]struct{} {
+	ids := map[string]struct{}{}
+	if body == nil {
+		return ids
+	}
+	for _, rp := range body.GetPolicy().GetWanFirewall().GetPolicy().GetRules() {
+		if rt := rp.GetRuleType(); rt != nil && *rt == subPolicyScopeRuleType {
+			ids[rp.GetRule().GetID()] = struct{}{}
+		}
+	}
+	return ids
+}
+
 // ifwSubPolicyCleanupRuleID returns the id of the auto-created cleanup rule for
 // the given sub-policy. The cleanup rule is a POLICY_RULE owned by the
 // sub-policy named "<sub-policy name> - Cleanup Rule"; it always exists and is
@@ -182,6 +216,254 @@ func wanSubPolicyCleanupRuleID(body *cato_go_sdk.Policy, subID string) string {
 	return fallback
 }
 
+// subPolicyChildRule identifies a rule owned by a sub-policy. content is a
+// fingerprint of the rule as returned by the API, see subPolicyRuleContent.
+type subPolicyChildRule struct {
+	id      string
+	name    string
+	content string
+}
+
+// subPolicyRuleContent fingerprints a policy rule returned by the API. The id,
+// index and section are left out, they change when rules elsewhere in the
+// policy move and do not reflect an edit of the rule itself.
+func subPolicyRuleContent(rule any) string {
+	encoded, err := json.Marshal(rule)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return ""
+	}
+	delete(fields, "id")
+	delete(fields, "index")
+	delete(fields, "section")
+	encoded, err = json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// ifwSubPolicyChildRules returns the POLICY_RULE entries owned by the given
+// sub-policy in policy order, excluding the cleanup rule.
+func ifwSubPolicyChildRules(body *cato_go_sdk.Policy, subID string) []subPolicyChildRule {
+	cleanupID := ifwSubPolicyCleanupRuleID(body, subID)
+	var out []subPolicyChildRule
+	for _, rp := range body.GetPolicy().GetInternetFirewall().GetPolicy().GetRules() {
+		sp := rp.GetSubPolicy()
+		rt := rp.GetRuleType()
+		if sp == nil || sp.GetID() != subID || rt == nil || *rt != cato_models.PolicyRuleTypeEnumPolicyRule {
+			continue
+		}
+		if rp.GetRule().GetID() == cleanupID {
+			continue
+		}
+		out = append(out, subPolicyChildRule{
+			id:      rp.GetRule().GetID(),
+			name:    rp.GetRule().GetName(),
+			content: subPolicyRuleContent(rp.GetRule()),
+		})
+	}
+	return out
+}
+
+// wanSubPolicyChildRules mirrors ifwSubPolicyChildRules for WAN.
+func wanSubPolicyChildRules(body *cato_go_sdk.Policy, subID string) []subPolicyChildRule {
+	cleanupID := wanSubPolicyCleanupRuleID(body, subID)
+	var out []subPolicyChildRule
+	for _, rp := range body.GetPolicy().GetWanFirewall().GetPolicy().GetRules() {
+		sp := rp.GetSubPolicy()
+		rt := rp.GetRuleType()
+		if sp == nil || sp.GetID() != subID || rt == nil || *rt != cato_models.PolicyRuleTypeEnumPolicyRule {
+			continue
+		}
+		if rp.GetRule().GetID() == cleanupID {
+			continue
+		}
+		out = append(out, subPolicyChildRule{
+			id:      rp.GetRule().GetID(),
+			name:    rp.GetRule().GetName(),
+			content: subPolicyRuleContent(rp.GetRule()),
+		})
+	}
+	return out
+}
+
+// tlsSubPolicyInfo returns the sub-policy info block for the given TLS
+// Inspection sub-policy id.
+func tlsSubPolicyInfo(body *cato_go_sdk.Tlsinspectpolicy, subID string) *cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_SubPolicies_Policy {
+	if body == nil || subID == "" {
+		return nil
+	}
+	for _, sp := range body.GetPolicy().GetTLSInspect().GetPolicy().GetSubPolicies() {
+		if sp.GetPolicy().GetID() == subID {
+			return sp.GetPolicy()
+		}
+	}
+	return nil
+}
+
+// tlsSubPolicyIDByName returns the id of the TLS Inspection sub-policy with the
+// given name that is not present in the exclude set.
+func tlsSubPolicyIDByName(body *cato_go_sdk.Tlsinspectpolicy, name string, exclude map[string]struct{}) string {
+	if body == nil {
+		return ""
+	}
+	for _, sp := range body.GetPolicy().GetTLSInspect().GetPolicy().GetSubPolicies() {
+		info := sp.GetPolicy()
+		if info.GetName() != name {
+			continue
+		}
+		if _, skip := exclude[info.GetID()]; skip {
+			continue
+		}
+		return info.GetID()
+	}
+	return ""
+}
+
+// tlsSubPolicyIDs returns the set of TLS Inspection sub-policy ids currently in
+// the snapshot.
+func tlsSubPolicyIDs(body *cato_go_sdk.Tlsinspectpolicy) map[string]struct{} {
+	ids := map[string]struct{}{}
+	if body == nil {
+		return ids
+	}
+	for _, sp := range body.GetPolicy().GetTLSInspect().GetPolicy().GetSubPolicies() {
+		ids[sp.GetPolicy().GetID()] = struct{}{}
+	}
+	return ids
+}
+
+// tlsScopeRule returns the SUB_POLICY_SCOPE rule owned by the given TLS
+// Inspection sub-policy.
+func tlsScopeRule(body *cato_go_sdk.Tlsinspectpolicy, subID string) *cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_Rules_Rule {
+	if body == nil || subID == "" {
+		return nil
+	}
+	for _, rp := range body.GetPolicy().GetTLSInspect().GetPolicy().GetRules() {
+		rt := rp.GetRuleType()
+		sp := rp.GetSubPolicy()
+		if rt != nil && *rt == subPolicyScopeRuleType && sp != nil && sp.GetID() == subID {
+			return rp.GetRule()
+		}
+	}
+	return nil
+}
+
+// tlsSubPolicyCleanupRuleID mirrors ifwSubPolicyCleanupRuleID for TLS Inspection.
+func tlsSubPolicyCleanupRuleID(body *cato_go_sdk.Tlsinspectpolicy, subID string) string {
+	info := tlsSubPolicyInfo(body, subID)
+	if info == nil {
+		return ""
+	}
+	cleanupName := info.GetName() + " - Cleanup Rule"
+	fallback := ""
+	for _, rp := range body.GetPolicy().GetTLSInspect().GetPolicy().GetRules() {
+		sp := rp.GetSubPolicy()
+		rt := rp.GetRuleType()
+		if sp == nil || sp.GetID() != subID || rt == nil || *rt != cato_models.PolicyRuleTypeEnumPolicyRule {
+			continue
+		}
+		if rp.GetRule().GetName() == cleanupName {
+			return rp.GetRule().GetID()
+		}
+		if fallback == "" {
+			fallback = rp.GetRule().GetID()
+		}
+	}
+	return fallback
+}
+
+// wnwSubPolicyInfo returns the sub-policy info block for the given WAN Network
+// sub-policy id.
+func wnwSubPolicyInfo(body *cato_go_sdk.WanNetworkPolicy, subID string) *cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_SubPolicies_Policy {
+	if body == nil || subID == "" {
+		return nil
+	}
+	for _, sp := range body.GetPolicy().GetWanNetwork().GetPolicy().GetSubPolicies() {
+		if sp.GetPolicy().GetID() == subID {
+			return sp.GetPolicy()
+		}
+	}
+	return nil
+}
+
+// wnwSubPolicyIDByName returns the id of the WAN Network sub-policy with the
+// given name that is not present in the exclude set.
+func wnwSubPolicyIDByName(body *cato_go_sdk.WanNetworkPolicy, name string, exclude map[string]struct{}) string {
+	if body == nil {
+		return ""
+	}
+	for _, sp := range body.GetPolicy().GetWanNetwork().GetPolicy().GetSubPolicies() {
+		info := sp.GetPolicy()
+		if info.GetName() != name {
+			continue
+		}
+		if _, skip := exclude[info.GetID()]; skip {
+			continue
+		}
+		return info.GetID()
+	}
+	return ""
+}
+
+// wnwSubPolicyIDs returns the set of WAN Network sub-policy ids currently in the
+// snapshot.
+func wnwSubPolicyIDs(body *cato_go_sdk.WanNetworkPolicy) map[string]struct{} {
+	ids := map[string]struct{}{}
+	if body == nil {
+		return ids
+	}
+	for _, sp := range body.GetPolicy().GetWanNetwork().GetPolicy().GetSubPolicies() {
+		ids[sp.GetPolicy().GetID()] = struct{}{}
+	}
+	return ids
+}
+
+// wnwScopeRule returns the SUB_POLICY_SCOPE rule owned by the given WAN Network
+// sub-policy.
+func wnwScopeRule(body *cato_go_sdk.WanNetworkPolicy, subID string) *cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_Rules_Rule {
+	if body == nil || subID == "" {
+		return nil
+	}
+	for _, rp := range body.GetPolicy().GetWanNetwork().GetPolicy().GetRules() {
+		rt := rp.GetRuleType()
+		sp := rp.GetSubPolicy()
+		if rt != nil && *rt == subPolicyScopeRuleType && sp != nil && sp.GetID() == subID {
+			return rp.GetRule()
+		}
+	}
+	return nil
+}
+
+// wnwSubPolicyCleanupRuleID mirrors ifwSubPolicyCleanupRuleID for WAN Network.
+func wnwSubPolicyCleanupRuleID(body *cato_go_sdk.WanNetworkPolicy, subID string) string {
+	info := wnwSubPolicyInfo(body, subID)
+	if info == nil {
+		return ""
+	}
+	cleanupName := info.GetName() + " - Cleanup Rule"
+	fallback := ""
+	for _, rp := range body.GetPolicy().GetWanNetwork().GetPolicy().GetRules() {
+		sp := rp.GetSubPolicy()
+		rt := rp.GetRuleType()
+		if sp == nil || sp.GetID() != subID || rt == nil || *rt != cato_models.PolicyRuleTypeEnumPolicyRule {
+			continue
+		}
+		if rp.GetRule().GetName() == cleanupName {
+			return rp.GetRule().GetID()
+		}
+		if fallback == "" {
+			fallback = rp.GetRule().GetID()
+		}
+	}
+	return fallback
+}
+
 // objectRefByID builds an ObjectRefBy=ID reference input.
 func objectRefByID(id string) *cato_models.InternetFirewallPolicyRefInput {
 	return &cato_models.InternetFirewallPolicyRefInput{By: cato_models.ObjectRefByID, Input: id}
@@ -191,3 +473,13 @@ func objectRefByID(id string) *cato_models.InternetFirewallPolicyRefInput {
 func wanObjectRefByID(id string) *cato_models.WanFirewallPolicyRefInput {
 	return &cato_models.WanFirewallPolicyRefInput{By: cato_models.ObjectRefByID, Input: id}
 }
+
+// tlsObjectRefByID builds an ObjectRefBy=ID reference input for TLS Inspection.
+func tlsObjectRefByID(id string) *cato_models.TLSInspectPolicyRefInput {
+	return &cato_models.TLSInspectPolicyRefInput{By: cato_models.ObjectRefByID, Input: id}
+}
+
+// wnwObjectRefByID builds an ObjectRefBy=ID reference input for WAN Network.
+func wnwObjectRefByID(id string) *cato_models.WanNetworkPolicyRefInput {
+	return &cato_models.WanNetworkPolicyRefInput{By: cato_models.ObjectRefByID, Input: id}
+}
@@ -90,6 +90,10 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 					diags = append(diags, itemTunnels.LastMileBw.As(ctx, &itemTunnelLastMileBw, basetypes.ObjectAsOptions{})...)
 				}
 
+				// Process routing type and tunnel monitoring
+				routingType, monitoring, optionDiags := hydrateIpsecTunnelOptions(ctx, itemTunnels)
+				diags = append(diags, optionDiags...)
+
 				// Append to Add input
 				result.add.Primary.Tunnels = append(result.add.Primary.Tunnels, &cato_models.AddIpsecIkeV2TunnelInput{
 					LastMileBw: &cato_models.LastMileBwInput{
@@ -100,6 +104,8 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 					PrivateSiteIP: itemTunnels.PrivateSiteIP.ValueStringPointer(),
 					Psk:           itemTunnels.Psk.ValueString(),
 					PublicSiteIP:  itemTunnels.PublicSiteIP.ValueStringPointer(),
+					RoutingType:   routingType,
+					Monitoring:    monitoring,
 				})
 
 				// Append to Update input
@@ -113,6 +119,8 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 					Psk:           itemTunnels.Psk.ValueStringPointer(),
 					PublicSiteIP:  itemTunnels.PublicSiteIP.ValueStringPointer(),
 					TunnelID:      cato_models.IPSecV2InterfaceID(itemTunnels.TunnelID.ValueString()),
+					RoutingType:   routingType,
+					Monitoring:    monitoring,
 				})
 			}
 		}
@@ -150,6 +158,10 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 					diags = append(diags, itemTunnels.LastMileBw.As(ctx, &itemTunnelLastMileBw, basetypes.ObjectAsOptions{})...)
 				}
 
+				// Process routing type and tunnel monitoring
+				routingType, monitoring, optionDiags := hydrateIpsecTunnelOptions(ctx, itemTunnels)
+				diags = append(diags, optionDiags...)
+
 				// Append to Add input
 				result.add.Secondary.Tunnels = append(result.add.Secondary.Tunnels, &cato_models.AddIpsecIkeV2TunnelInput{
 					LastMileBw: &cato_models.LastMileBwInput{
@@ -160,6 +172,8 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 					PrivateSiteIP: itemTunnels.PrivateSiteIP.ValueStringPointer(),
 					Psk:           itemTunnels.Psk.ValueString(),
 					PublicSiteIP:  itemTunnels.PublicSiteIP.ValueStringPointer(),
+					RoutingType:   routingType,
+					Monitoring:    monitoring,
 				})
 
 				// Append to Update input
@@ -173,6 +187,8 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 					Psk:           itemTunnels.Psk.ValueStringPointer(),
 					PublicSiteIP:  itemTunnels.PublicSiteIP.ValueStringPointer(),
 					TunnelID:      cato_models.IPSecV2InterfaceID(itemTunnels.TunnelID.ValueString()),
+					RoutingType:   routingType,
+					Monitoring:    monitoring,
 				})
 			}
 		}
@@ -181,6 +197,37 @@ func hydrateAddIpsecIkeV2SiteTunnels(ctx context.Context, plan SiteIpsecIkeV2) (
 	return result, diags
 }
 
+// hydrateIpsecTunnelOptions returns the routing type and the tunnel network monitoring
+// settings of a single tunnel, nil when they are not configured
+func hydrateIpsecTunnelOptions(
+	ctx context.Context,
+	tunnel AddIpsecIkeV2TunnelInput,
+) (*cato_models.IPSecRoutingType, *cato_models.IpsecTunnelMonitoringInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var routingType *cato_models.IPSecRoutingType
+	var monitoring *cato_models.IpsecTunnelMonitoringInput
+
+	if !tunnel.RoutingType.IsNull() && !tunnel.RoutingType.IsUnknown() {
+		value := cato_models.IPSecRoutingType(tunnel.RoutingType.ValueString())
+		routingType = &value
+	}
+
+	if !tunnel.Monitoring.IsNull() && !tunnel.Monitoring.IsUnknown() {
+		planMonitoring := IpsecTunnelMonitoringInput{}
+		diags = append(diags, tunnel.Monitoring.As(ctx, &planMonitoring, basetypes.ObjectAsOptions{})...)
+
+		monitoring = &cato_models.IpsecTunnelMonitoringInput{
+			Type:             cato_models.IPSecTunnelMonitoringType(planMonitoring.Type.ValueString()),
+			DestinationIP:    planMonitoring.DestinationIP.ValueStringPointer(),
+			URL:              planMonitoring.URL.ValueStringPointer(),
+			Interval:         planMonitoring.Interval.ValueInt64Pointer(),
+			FailureThreshold: planMonitoring.FailureThreshold.ValueInt64Pointer(),
+		}
+	}
+
+	return routingType, monitoring, diags
+}
+
 // hydrateUpdateIpsecIkeV2SiteTunnels builds the update input and restores computed
 // tunnel IDs from prior state when Terraform marks nested computed values unknown.
 func hydrateUpdateIpsecIkeV2SiteTunnels(
@@ -313,6 +360,24 @@ func hydrateUpdateIpsecIkeV2SiteGeneralDetails(
 	planIPSec := AddIpsecIkeV2SiteTunnelsInput{}
 	diags = append(diags, plan.IPSec.As(ctx, &planIPSec, basetypes.ObjectAsOptions{})...)
 
+	// Set IKE version
+	if !planIPSec.IkeVersion.IsNull() && !planIPSec.IkeVersion.IsUnknown() {
+		ikeVersion := cato_models.IPSecIkeVersion(planIPSec.IkeVersion.ValueString())
+		input.IkeVersion = &ikeVersion
+	}
+
+	// Set dead peer detection
+	if !planIPSec.Dpd.IsNull() && !planIPSec.Dpd.IsUnknown() {
+		planDpd := IpsecDpdInput{}
+		diags = append(diags, planIPSec.Dpd.As(ctx, &planDpd, basetypes.ObjectAsOptions{})...)
+
+		input.Dpd = &cato_models.IpsecDpdInput{
+			Enabled:  planDpd.Enabled.ValueBoolPointer(),
+			Interval: planDpd.Interval.ValueInt64Pointer(),
+			Timeout:  planDpd.Timeout.ValueInt64Pointer(),
+		}
+	}
+
 	// Set connection mode
 	var connectionModeValue string
 	if !planIPSec.ConnectionMode.IsNull() {
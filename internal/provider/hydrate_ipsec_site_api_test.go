@@ -68,9 +68,82 @@ func TestHydrateUpdateIpsecIkeV2SiteTunnelsDerivesMissingTunnelID(t *testing.T)
 	}
 }
 
+func TestHydrateIpsecTunnelOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tunnel := AddIpsecIkeV2TunnelInput{
+		RoutingType: types.StringValue("ROUTE_BASED"),
+		Monitoring: types.ObjectValueMust(IpsecTunnelMonitoringResourceAttrTypes, map[string]attr.Value{
+			"type":              types.StringValue("ICMP"),
+			"destination_ip":    types.StringValue("10.0.0.1"),
+			"url":               types.StringNull(),
+			"interval":          types.Int64Value(5),
+			"failure_threshold": types.Int64Value(3),
+		}),
+	}
+
+	routingType, monitoring, diags := hydrateIpsecTunnelOptions(ctx, tunnel)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if routingType == nil || *routingType != cato_models.IPSecRoutingType("ROUTE_BASED") {
+		t.Fatalf("expected ROUTE_BASED routing type, got %v", routingType)
+	}
+	if monitoring == nil || monitoring.Type != cato_models.IPSecTunnelMonitoringType("ICMP") {
+		t.Fatalf("expected ICMP monitoring, got %v", monitoring)
+	}
+	if monitoring.DestinationIP == nil || *monitoring.DestinationIP != "10.0.0.1" || monitoring.URL != nil {
+		t.Fatalf("unexpected monitoring destination: %v", monitoring)
+	}
+	if monitoring.Interval == nil || *monitoring.Interval != 5 || monitoring.FailureThreshold == nil || *monitoring.FailureThreshold != 3 {
+		t.Fatalf("unexpected monitoring timers: %v", monitoring)
+	}
+
+	routingType, monitoring, diags = hydrateIpsecTunnelOptions(ctx, AddIpsecIkeV2TunnelInput{
+		RoutingType: types.StringNull(),
+		Monitoring:  types.ObjectNull(IpsecTunnelMonitoringResourceAttrTypes),
+	})
+	if diags.HasError() || routingType != nil || monitoring != nil {
+		t.Fatalf("expected no tunnel options, got %v %v %v", routingType, monitoring, diags)
+	}
+}
+
+func TestHydrateUpdateIpsecIkeV2SiteGeneralDetailsIkeVersionAndDpd(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ipsec := testIPSecObject(
+		testIPSecTunnelGroup(types.StringValue("PRIMARY1"), "psk"),
+		types.ObjectNull(IpsecTunnelsResourceAttrTypes),
+	)
+	attrs := ipsec.Attributes()
+	attrs["ike_version"] = types.StringValue("IKEV1")
+	attrs["dpd"] = types.ObjectValueMust(IpsecDpdResourceAttrTypes, map[string]attr.Value{
+		"enabled":  types.BoolValue(true),
+		"interval": types.Int64Value(10),
+		"timeout":  types.Int64Value(30),
+	})
+
+	input, diags := hydrateUpdateIpsecIkeV2SiteGeneralDetails(ctx, SiteIpsecIkeV2{
+		IPSec: types.ObjectValueMust(IpsecResourceAttrTypes, attrs),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if input.IkeVersion == nil || *input.IkeVersion != cato_models.IPSecIkeVersion("IKEV1") {
+		t.Fatalf("expected IKEV1, got %v", input.IkeVersion)
+	}
+	if input.Dpd == nil || !*input.Dpd.Enabled || *input.Dpd.Interval != 10 || *input.Dpd.Timeout != 30 {
+		t.Fatalf("unexpected dpd input: %v", input.Dpd)
+	}
+}
+
 func testIPSecObject(primary, secondary types.Object) types.Object {
 	return types.ObjectValueMust(IpsecResourceAttrTypes, map[string]attr.Value{
 		"site_id":             types.StringValue("site-123"),
+		"ike_version":         types.StringValue("IKEV2"),
+		"dpd":                 types.ObjectNull(IpsecDpdResourceAttrTypes),
 		"primary":             primary,
 		"secondary":           secondary,
 		"connection_mode":     types.StringValue("RESPONDER_ONLY"),
@@ -89,6 +162,8 @@ func testIPSecTunnelGroup(tunnelID types.String, psk string) types.Object {
 		"private_site_ip": types.StringNull(),
 		"psk":             types.StringValue(psk),
 		"last_mile_bw":    types.ObjectNull(LastMileBwResourceAttrTypes),
+		"routing_type":    types.StringNull(),
+		"monitoring":      types.ObjectNull(IpsecTunnelMonitoringResourceAttrTypes),
 	})
 
 	return types.ObjectValueMust(IpsecTunnelsResourceAttrTypes, map[string]attr.Value{
@@ -101,3 +176,55 @@ func testIPSecTunnelGroup(tunnelID types.String, psk string) types.Object {
 		),
 	})
 }
+
+func TestIpsecIkeVersionState(t *testing.T) {
+	t.Parallel()
+
+	if got := ipsecIkeVersionState(nil); got.ValueString() != "IKEV2" {
+		t.Fatalf("expected IKEV2 default, got %v", got)
+	}
+	ikeV1 := cato_models.IPSecIkeVersion("IKEV1")
+	if got := ipsecIkeVersionState(&ikeV1); got.ValueString() != "IKEV1" {
+		t.Fatalf("expected IKEV1, got %v", got)
+	}
+}
+
+func TestIpsecDpdState(t *testing.T) {
+	t.Parallel()
+
+	enabled, disabled := true, false
+	interval, timeout := int64(10), int64(30)
+	null := types.ObjectNull(IpsecDpdResourceAttrTypes)
+
+	// API defaults keep an unset dpd null, e.g. after import
+	got, diags := ipsecDpdState(null, &enabled, nil, nil)
+	if diags.HasError() || !got.IsNull() {
+		t.Fatalf("expected null dpd for API defaults, got %v %v", got, diags)
+	}
+
+	// Custom settings are read from the API even when dpd was not in state
+	got, diags = ipsecDpdState(null, &disabled, &interval, &timeout)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.ObjectValueMust(IpsecDpdResourceAttrTypes, map[string]attr.Value{
+		"enabled":  types.BoolValue(false),
+		"interval": types.Int64Value(10),
+		"timeout":  types.Int64Value(30),
+	})
+	if !got.Equal(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	// Drift from a configured dpd is reported
+	got, diags = ipsecDpdState(want, &enabled, nil, nil)
+	if diags.HasError() || got.Equal(want) || !got.Attributes()["enabled"].Equal(types.BoolValue(true)) {
+		t.Fatalf("expected dpd refreshed from API, got %v %v", got, diags)
+	}
+
+	// No DPD data in the response keeps the prior value
+	got, _ = ipsecDpdState(want, nil, nil, nil)
+	if !got.Equal(want) {
+		t.Fatalf("expected prior dpd, got %v", got)
+	}
+}
@@ -7,12 +7,14 @@ import (
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/spf13/cast"
 
 	tf "github.com/catonetworks/terraform-provider-cato/internal/provider/tfmodel"
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
 	"github.com/catonetworks/terraform-provider-cato/internal/utils"
 )
 
@@ -218,6 +220,8 @@ func (r *siteIpsecResource) hydrateIpsecSiteState(ctx context.Context, state Sit
 	// since the API does not return these values in AccountSnapshot
 	ipsecAttrs := map[string]attr.Value{
 		"site_id":             types.StringValue(siteID),
+		"ike_version":         types.StringNull(),
+		"dpd":                 types.ObjectNull(IpsecDpdResourceAttrTypes),
 		"primary":             types.ObjectNull(IpsecTunnelsResourceAttrTypes),
 		"secondary":           types.ObjectNull(IpsecTunnelsResourceAttrTypes),
 		"connection_mode":     types.StringNull(),
@@ -232,6 +236,15 @@ func (r *siteIpsecResource) hydrateIpsecSiteState(ctx context.Context, state Sit
 		var currentIpsec AddIpsecIkeV2SiteTunnelsInput
 		state.IPSec.As(ctx, &currentIpsec, basetypes.ObjectAsOptions{})
 
+		// Start from the prior IKE version and dead peer detection; both are
+		// refreshed from the site general details below
+		if !currentIpsec.IkeVersion.IsNull() {
+			ipsecAttrs["ike_version"] = currentIpsec.IkeVersion
+		}
+		if !currentIpsec.Dpd.IsNull() {
+			ipsecAttrs["dpd"] = currentIpsec.Dpd
+		}
+
 		// Preserve primary tunnels
 		if !currentIpsec.Primary.IsNull() {
 			ipsecAttrs["primary"] = currentIpsec.Primary
@@ -268,6 +281,26 @@ func (r *siteIpsecResource) hydrateIpsecSiteState(ctx context.Context, state Sit
 		}
 	}
 
+	// IKE version and dead peer detection are returned by the site general
+	// details, so they are read from the API rather than preserved from state
+	siteDetails, err := r.client.catov2.SiteGeneralDetails(ctx,
+		cato_models.SiteRefInput{By: cato_models.ObjectRefByID, Input: siteID}, r.client.AccountId)
+	tflog.Debug(ctx, "hydrateIpsecSiteState.SiteGeneralDetails.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(siteDetails),
+	})
+	if err != nil {
+		return state, false, err
+	}
+	if ipsecDetails := siteDetails.GetSite().GetSiteGeneralDetails().GetIpsecGeneralDetails(); ipsecDetails != nil {
+		ipsecAttrs["ike_version"] = ipsecIkeVersionState(ipsecDetails.GetIkeVersion())
+		dpd := ipsecDetails.GetDpd()
+		dpdState, diags := ipsecDpdState(ipsecAttrs["dpd"].(types.Object), dpd.GetEnabled(), dpd.GetInterval(), dpd.GetTimeout())
+		if diags.HasError() {
+			return state, false, nil
+		}
+		ipsecAttrs["dpd"] = dpdState
+	}
+
 	// Create IPSec object
 	ipsecObj, diags := types.ObjectValue(IpsecResourceAttrTypes, ipsecAttrs)
 	if diags.HasError() {
@@ -277,3 +310,34 @@ func (r *siteIpsecResource) hydrateIpsecSiteState(ctx context.Context, state Sit
 
 	return state, true, nil
 }
+
+// ipsecIkeVersionState maps the IKE version returned by the API, which omits
+// it for sites created before IKEv1 support, to the ike_version attribute.
+func ipsecIkeVersionState(ikeVersion *cato_models.IPSecIkeVersion) types.String {
+	if ikeVersion == nil || *ikeVersion == "" {
+		return types.StringValue(validators.IpsecIkeV2)
+	}
+	return types.StringValue(string(*ikeVersion))
+}
+
+// ipsecDpdState maps the dead peer detection settings returned by the API to
+// the dpd attribute. dpd is optional, so the API defaults (enabled, no custom
+// timers) are kept null when dpd is not in the prior state.
+func ipsecDpdState(prior types.Object, enabled *bool, interval, timeout *int64) (types.Object, diag.Diagnostics) {
+	if enabled == nil && interval == nil && timeout == nil {
+		return prior, nil
+	}
+	isDefault := (enabled == nil || *enabled) && interval == nil && timeout == nil
+	if prior.IsNull() && isDefault {
+		return prior, nil
+	}
+	enabledValue := true
+	if enabled != nil {
+		enabledValue = *enabled
+	}
+	return types.ObjectValue(IpsecDpdResourceAttrTypes, map[string]attr.Value{
+		"enabled":  types.BoolValue(enabledValue),
+		"interval": types.Int64PointerValue(interval),
+		"timeout":  types.Int64PointerValue(timeout),
+	})
+}
@@ -40,6 +40,95 @@ func (_m *InternetFirewallBulkPolicyClient) EXPECT() *InternetFirewallBulkPolicy
 	return &InternetFirewallBulkPolicyClient_Expecter{mock: &_m.Mock}
 }
 
+// PolicyInternetFirewall provides a mock function for the type InternetFirewallBulkPolicyClient
+func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewall(ctx context.Context, internetFirewallPolicyInput *cato_models.InternetFirewallPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.Policy, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewall")
+	}
+
+	var r0 *cato_go_sdk.Policy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.Policy, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.Policy); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.Policy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewall'
+type InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewall is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyInput *cato_models.InternetFirewallPolicyInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallBulkPolicyClient_Expecter) PolicyInternetFirewall(ctx interface{}, internetFirewallPolicyInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call {
+	return &InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call{Call: _e.mock.On("PolicyInternetFirewall",
+		append([]interface{}{ctx, internetFirewallPolicyInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call) Run(run func(ctx context.Context, internetFirewallPolicyInput *cato_models.InternetFirewallPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call) Return(policy *cato_go_sdk.Policy, err error) *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call {
+	_c.Call.Return(policy, err)
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyInput *cato_models.InternetFirewallPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.Policy, error)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyInternetFirewallAddSection provides a mock function for the type InternetFirewallBulkPolicyClient
 func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewallAddSection(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyAddSectionInput cato_models.PolicyAddSectionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallAddSection, error) {
 	var tmpRet mock.Arguments
@@ -135,6 +224,101 @@ func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallAddSection_Call
 	return _c
 }
 
+// PolicyInternetFirewallDiscardPolicyRevision provides a mock function for the type InternetFirewallBulkPolicyClient
+func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewallDiscardPolicyRevision(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallDiscardPolicyRevision")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallDiscardPolicyRevision'
+type InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallDiscardPolicyRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput
+//   - policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallBulkPolicyClient_Expecter) PolicyInternetFirewallDiscardPolicyRevision(ctx interface{}, internetFirewallPolicyMutationInput interface{}, policyDiscardRevisionInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	return &InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call{Call: _e.mock.On("PolicyInternetFirewallDiscardPolicyRevision",
+		append([]interface{}{ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call) Run(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyMutationInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyMutationInput)
+		}
+		var arg2 *cato_models.PolicyDiscardRevisionInput
+		if args[2] != nil {
+			arg2 = args[2].(*cato_models.PolicyDiscardRevisionInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 4 {
+			variadicArgs = args[4].([]clientv2.RequestInterceptor)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call) Return(policyInternetFirewallDiscardPolicyRevision *cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, err error) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(policyInternetFirewallDiscardPolicyRevision, err)
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyInternetFirewallMoveSection provides a mock function for the type InternetFirewallBulkPolicyClient
 func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewallMoveSection(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyMoveSectionInput cato_models.PolicyMoveSectionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallMoveSection, error) {
 	var tmpRet mock.Arguments
@@ -325,6 +509,101 @@ func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallPublishPolicyRe
 	return _c
 }
 
+// PolicyInternetFirewallRemoveRule provides a mock function for the type InternetFirewallBulkPolicyClient
+func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewallRemoveRule(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallRemoveRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallRemoveRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallRemoveRule); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallRemoveRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallRemoveRule'
+type InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallRemoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput
+//   - internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallBulkPolicyClient_Expecter) PolicyInternetFirewallRemoveRule(ctx interface{}, internetFirewallPolicyMutationInput interface{}, internetFirewallRemoveRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	return &InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call{Call: _e.mock.On("PolicyInternetFirewallRemoveRule",
+		append([]interface{}{ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call) Run(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyMutationInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyMutationInput)
+		}
+		var arg2 cato_models.InternetFirewallRemoveRuleInput
+		if args[2] != nil {
+			arg2 = args[2].(cato_models.InternetFirewallRemoveRuleInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 4 {
+			variadicArgs = args[4].([]clientv2.RequestInterceptor)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call) Return(policyInternetFirewallRemoveRule *cato_go_sdk.PolicyInternetFirewallRemoveRule, err error) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	_c.Call.Return(policyInternetFirewallRemoveRule, err)
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyInternetFirewallReorderPolicy provides a mock function for the type InternetFirewallBulkPolicyClient
 func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewallReorderPolicy(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyReorderInput cato_models.PolicyReorderInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallReorderPolicy, error) {
 	var tmpRet mock.Arguments
@@ -585,3 +864,98 @@ func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallSectionsIndex_C
 	_c.Call.Return(run)
 	return _c
 }
+
+// PolicyInternetFirewallUpdateRule provides a mock function for the type InternetFirewallBulkPolicyClient
+func (_mock *InternetFirewallBulkPolicyClient) PolicyInternetFirewallUpdateRule(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput cato_models.InternetFirewallUpdateRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallUpdateRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallUpdateRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallUpdateRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallUpdateRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallUpdateRule, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallUpdateRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallUpdateRule); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallUpdateRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallUpdateRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallUpdateRule'
+type InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallUpdateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput
+//   - internetFirewallUpdateRuleInput cato_models.InternetFirewallUpdateRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallBulkPolicyClient_Expecter) PolicyInternetFirewallUpdateRule(ctx interface{}, internetFirewallPolicyMutationInput interface{}, internetFirewallUpdateRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call {
+	return &InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call{Call: _e.mock.On("PolicyInternetFirewallUpdateRule",
+		append([]interface{}{ctx, internetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call) Run(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput cato_models.InternetFirewallUpdateRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyMutationInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyMutationInput)
+		}
+		var arg2 cato_models.InternetFirewallUpdateRuleInput
+		if args[2] != nil {
+			arg2 = args[2].(cato_models.InternetFirewallUpdateRuleInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 4 {
+			variadicArgs = args[4].([]clientv2.RequestInterceptor)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call) Return(policyInternetFirewallUpdateRule *cato_go_sdk.PolicyInternetFirewallUpdateRule, err error) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call {
+	_c.Call.Return(policyInternetFirewallUpdateRule, err)
+	return _c
+}
+
+func (_c *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallUpdateRuleInput cato_models.InternetFirewallUpdateRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallUpdateRule, error)) *InternetFirewallBulkPolicyClient_PolicyInternetFirewallUpdateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -129,6 +129,95 @@ func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewall_Call) RunAndRet
 	return _c
 }
 
+// PolicyInternetFirewallAddRule provides a mock function for the type InternetFirewallSubPolicyClient
+func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallAddRule(ctx context.Context, internetFirewallAddRuleInput cato_models.InternetFirewallAddRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallAddRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallAddRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallAddRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallAddRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallAddRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.InternetFirewallAddRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallAddRule, error)); ok {
+		return returnFunc(ctx, internetFirewallAddRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.InternetFirewallAddRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallAddRule); ok {
+		r0 = returnFunc(ctx, internetFirewallAddRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallAddRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, cato_models.InternetFirewallAddRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallAddRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallAddRule'
+type InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallAddRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallAddRuleInput cato_models.InternetFirewallAddRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallSubPolicyClient_Expecter) PolicyInternetFirewallAddRule(ctx interface{}, internetFirewallAddRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call {
+	return &InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call{Call: _e.mock.On("PolicyInternetFirewallAddRule",
+		append([]interface{}{ctx, internetFirewallAddRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call) Run(run func(ctx context.Context, internetFirewallAddRuleInput cato_models.InternetFirewallAddRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 cato_models.InternetFirewallAddRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(cato_models.InternetFirewallAddRuleInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call) Return(policyInternetFirewallAddRule *cato_go_sdk.PolicyInternetFirewallAddRule, err error) *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call {
+	_c.Call.Return(policyInternetFirewallAddRule, err)
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call) RunAndReturn(run func(ctx context.Context, internetFirewallAddRuleInput cato_models.InternetFirewallAddRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallAddRule, error)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyInternetFirewallAddSubPolicy provides a mock function for the type InternetFirewallSubPolicyClient
 func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallAddSubPolicy(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallAddSubPolicyInput cato_models.InternetFirewallAddSubPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallAddSubPolicy, error) {
 	var tmpRet mock.Arguments
@@ -224,6 +313,196 @@ func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallAddSubPolicy_Cal
 	return _c
 }
 
+// PolicyInternetFirewallDiscardPolicyRevision provides a mock function for the type InternetFirewallSubPolicyClient
+func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallDiscardPolicyRevision(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallDiscardPolicyRevision")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallDiscardPolicyRevision'
+type InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallDiscardPolicyRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput
+//   - policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallSubPolicyClient_Expecter) PolicyInternetFirewallDiscardPolicyRevision(ctx interface{}, internetFirewallPolicyMutationInput interface{}, policyDiscardRevisionInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	return &InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call{Call: _e.mock.On("PolicyInternetFirewallDiscardPolicyRevision",
+		append([]interface{}{ctx, internetFirewallPolicyMutationInput, policyDiscardRevisionInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call) Run(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyMutationInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyMutationInput)
+		}
+		var arg2 *cato_models.PolicyDiscardRevisionInput
+		if args[2] != nil {
+			arg2 = args[2].(*cato_models.PolicyDiscardRevisionInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 4 {
+			variadicArgs = args[4].([]clientv2.RequestInterceptor)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call) Return(policyInternetFirewallDiscardPolicyRevision *cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, err error) *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(policyInternetFirewallDiscardPolicyRevision, err)
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PolicyInternetFirewallMoveRule provides a mock function for the type InternetFirewallSubPolicyClient
+func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallMoveRule(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyMoveRuleInput cato_models.PolicyMoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallMoveRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, policyMoveRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, policyMoveRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallMoveRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallMoveRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.PolicyMoveRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallMoveRule, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyMutationInput, policyMoveRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.PolicyMoveRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallMoveRule); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyMutationInput, policyMoveRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallMoveRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.PolicyMoveRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyMutationInput, policyMoveRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallMoveRule'
+type InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallMoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput
+//   - policyMoveRuleInput cato_models.PolicyMoveRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallSubPolicyClient_Expecter) PolicyInternetFirewallMoveRule(ctx interface{}, internetFirewallPolicyMutationInput interface{}, policyMoveRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call {
+	return &InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call{Call: _e.mock.On("PolicyInternetFirewallMoveRule",
+		append([]interface{}{ctx, internetFirewallPolicyMutationInput, policyMoveRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call) Run(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyMoveRuleInput cato_models.PolicyMoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyMutationInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyMutationInput)
+		}
+		var arg2 cato_models.PolicyMoveRuleInput
+		if args[2] != nil {
+			arg2 = args[2].(cato_models.PolicyMoveRuleInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 4 {
+			variadicArgs = args[4].([]clientv2.RequestInterceptor)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call) Return(policyInternetFirewallMoveRule *cato_go_sdk.PolicyInternetFirewallMoveRule, err error) *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call {
+	_c.Call.Return(policyInternetFirewallMoveRule, err)
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyMoveRuleInput cato_models.PolicyMoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallMoveRule, error)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallMoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyInternetFirewallPublishPolicyRevision provides a mock function for the type InternetFirewallSubPolicyClient
 func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallPublishPolicyRevision(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, policyPublishRevisionInput *cato_models.PolicyPublishRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallPublishPolicyRevision, error) {
 	var tmpRet mock.Arguments
@@ -319,6 +598,101 @@ func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallPublishPolicyRev
 	return _c
 }
 
+// PolicyInternetFirewallRemoveRule provides a mock function for the type InternetFirewallSubPolicyClient
+func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallRemoveRule(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyInternetFirewallRemoveRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyInternetFirewallRemoveRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error)); ok {
+		return returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyInternetFirewallRemoveRule); ok {
+		r0 = returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyInternetFirewallRemoveRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.InternetFirewallPolicyMutationInput, cato_models.InternetFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyInternetFirewallRemoveRule'
+type InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call struct {
+	*mock.Call
+}
+
+// PolicyInternetFirewallRemoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput
+//   - internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *InternetFirewallSubPolicyClient_Expecter) PolicyInternetFirewallRemoveRule(ctx interface{}, internetFirewallPolicyMutationInput interface{}, internetFirewallRemoveRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	return &InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call{Call: _e.mock.On("PolicyInternetFirewallRemoveRule",
+		append([]interface{}{ctx, internetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call) Run(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.InternetFirewallPolicyMutationInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.InternetFirewallPolicyMutationInput)
+		}
+		var arg2 cato_models.InternetFirewallRemoveRuleInput
+		if args[2] != nil {
+			arg2 = args[2].(cato_models.InternetFirewallRemoveRuleInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 4 {
+			variadicArgs = args[4].([]clientv2.RequestInterceptor)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call) Return(policyInternetFirewallRemoveRule *cato_go_sdk.PolicyInternetFirewallRemoveRule, err error) *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	_c.Call.Return(policyInternetFirewallRemoveRule, err)
+	return _c
+}
+
+func (_c *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call) RunAndReturn(run func(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error)) *InternetFirewallSubPolicyClient_PolicyInternetFirewallRemoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyInternetFirewallRemoveSubPolicy provides a mock function for the type InternetFirewallSubPolicyClient
 func (_mock *InternetFirewallSubPolicyClient) PolicyInternetFirewallRemoveSubPolicy(ctx context.Context, internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput, internetFirewallRemoveSubPolicyInput cato_models.InternetFirewallRemoveSubPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallRemoveSubPolicy, error) {
 	var tmpRet mock.Arguments
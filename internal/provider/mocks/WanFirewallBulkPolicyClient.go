@@ -40,6 +40,184 @@ func (_m *WanFirewallBulkPolicyClient) EXPECT() *WanFirewallBulkPolicyClient_Exp
 	return &WanFirewallBulkPolicyClient_Expecter{mock: &_m.Mock}
 }
 
+// PolicyWanFirewall provides a mock function for the type WanFirewallBulkPolicyClient
+func (_mock *WanFirewallBulkPolicyClient) PolicyWanFirewall(ctx context.Context, wanFirewallPolicyInput *cato_models.WanFirewallPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.Policy, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, wanFirewallPolicyInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, wanFirewallPolicyInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewall")
+	}
+
+	var r0 *cato_go_sdk.Policy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.WanFirewallPolicyInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.Policy, error)); ok {
+		return returnFunc(ctx, wanFirewallPolicyInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.WanFirewallPolicyInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.Policy); ok {
+		r0 = returnFunc(ctx, wanFirewallPolicyInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.Policy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.WanFirewallPolicyInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, wanFirewallPolicyInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallBulkPolicyClient_PolicyWanFirewall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewall'
+type WanFirewallBulkPolicyClient_PolicyWanFirewall_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewall is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wanFirewallPolicyInput *cato_models.WanFirewallPolicyInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallBulkPolicyClient_Expecter) PolicyWanFirewall(ctx interface{}, wanFirewallPolicyInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call {
+	return &WanFirewallBulkPolicyClient_PolicyWanFirewall_Call{Call: _e.mock.On("PolicyWanFirewall",
+		append([]interface{}{ctx, wanFirewallPolicyInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call) Run(run func(ctx context.Context, wanFirewallPolicyInput *cato_models.WanFirewallPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.WanFirewallPolicyInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.WanFirewallPolicyInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call) Return(policy *cato_go_sdk.Policy, err error) *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call {
+	_c.Call.Return(policy, err)
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call) RunAndReturn(run func(ctx context.Context, wanFirewallPolicyInput *cato_models.WanFirewallPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.Policy, error)) *WanFirewallBulkPolicyClient_PolicyWanFirewall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PolicyWanFirewallDiscardPolicyRevision provides a mock function for the type WanFirewallBulkPolicyClient
+func (_mock *WanFirewallBulkPolicyClient) PolicyWanFirewallDiscardPolicyRevision(ctx context.Context, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, policyDiscardRevisionInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, policyDiscardRevisionInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallDiscardPolicyRevision")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error)); ok {
+		return returnFunc(ctx, policyDiscardRevisionInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision); ok {
+		r0 = returnFunc(ctx, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallDiscardPolicyRevision'
+type WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallDiscardPolicyRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallBulkPolicyClient_Expecter) PolicyWanFirewallDiscardPolicyRevision(ctx interface{}, policyDiscardRevisionInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	return &WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call{Call: _e.mock.On("PolicyWanFirewallDiscardPolicyRevision",
+		append([]interface{}{ctx, policyDiscardRevisionInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call) Run(run func(ctx context.Context, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.PolicyDiscardRevisionInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.PolicyDiscardRevisionInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call) Return(policyWanFirewallDiscardPolicyRevision *cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, err error) *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(policyWanFirewallDiscardPolicyRevision, err)
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call) RunAndReturn(run func(ctx context.Context, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error)) *WanFirewallBulkPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyWanFirewallMoveSection provides a mock function for the type WanFirewallBulkPolicyClient
 func (_mock *WanFirewallBulkPolicyClient) PolicyWanFirewallMoveSection(ctx context.Context, policyMoveSectionInput cato_models.PolicyMoveSectionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallMoveSection, error) {
 	var tmpRet mock.Arguments
@@ -218,6 +396,95 @@ func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallPublishPolicyRevision_Cal
 	return _c
 }
 
+// PolicyWanFirewallRemoveRule provides a mock function for the type WanFirewallBulkPolicyClient
+func (_mock *WanFirewallBulkPolicyClient) PolicyWanFirewallRemoveRule(ctx context.Context, wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, wanFirewallRemoveRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, wanFirewallRemoveRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallRemoveRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallRemoveRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error)); ok {
+		return returnFunc(ctx, wanFirewallRemoveRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallRemoveRule); ok {
+		r0 = returnFunc(ctx, wanFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallRemoveRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, cato_models.WanFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, wanFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallRemoveRule'
+type WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallRemoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallBulkPolicyClient_Expecter) PolicyWanFirewallRemoveRule(ctx interface{}, wanFirewallRemoveRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	return &WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call{Call: _e.mock.On("PolicyWanFirewallRemoveRule",
+		append([]interface{}{ctx, wanFirewallRemoveRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call) Run(run func(ctx context.Context, wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 cato_models.WanFirewallRemoveRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(cato_models.WanFirewallRemoveRuleInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call) Return(policyWanFirewallRemoveRule *cato_go_sdk.PolicyWanFirewallRemoveRule, err error) *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	_c.Call.Return(policyWanFirewallRemoveRule, err)
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call) RunAndReturn(run func(ctx context.Context, wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error)) *WanFirewallBulkPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyWanFirewallReorderPolicy provides a mock function for the type WanFirewallBulkPolicyClient
 func (_mock *WanFirewallBulkPolicyClient) PolicyWanFirewallReorderPolicy(ctx context.Context, wanFirewallPolicyMutationInput *cato_models.WanFirewallPolicyMutationInput, policyReorderInput cato_models.PolicyReorderInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallReorderPolicy, error) {
 	var tmpRet mock.Arguments
@@ -478,3 +745,92 @@ func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallSectionsIndex_Call) RunAn
 	_c.Call.Return(run)
 	return _c
 }
+
+// PolicyWanFirewallUpdateRule provides a mock function for the type WanFirewallBulkPolicyClient
+func (_mock *WanFirewallBulkPolicyClient) PolicyWanFirewallUpdateRule(ctx context.Context, wanFirewallUpdateRuleInput cato_models.WanFirewallUpdateRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallUpdateRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, wanFirewallUpdateRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, wanFirewallUpdateRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallUpdateRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallUpdateRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallUpdateRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallUpdateRule, error)); ok {
+		return returnFunc(ctx, wanFirewallUpdateRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallUpdateRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallUpdateRule); ok {
+		r0 = returnFunc(ctx, wanFirewallUpdateRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallUpdateRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, cato_models.WanFirewallUpdateRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, wanFirewallUpdateRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallUpdateRule'
+type WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallUpdateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wanFirewallUpdateRuleInput cato_models.WanFirewallUpdateRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallBulkPolicyClient_Expecter) PolicyWanFirewallUpdateRule(ctx interface{}, wanFirewallUpdateRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call {
+	return &WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call{Call: _e.mock.On("PolicyWanFirewallUpdateRule",
+		append([]interface{}{ctx, wanFirewallUpdateRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call) Run(run func(ctx context.Context, wanFirewallUpdateRuleInput cato_models.WanFirewallUpdateRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 cato_models.WanFirewallUpdateRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(cato_models.WanFirewallUpdateRuleInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call) Return(policyWanFirewallUpdateRule *cato_go_sdk.PolicyWanFirewallUpdateRule, err error) *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call {
+	_c.Call.Return(policyWanFirewallUpdateRule, err)
+	return _c
+}
+
+func (_c *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call) RunAndReturn(run func(ctx context.Context, wanFirewallUpdateRuleInput cato_models.WanFirewallUpdateRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallUpdateRule, error)) *WanFirewallBulkPolicyClient_PolicyWanFirewallUpdateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -129,6 +129,95 @@ func (_c *WanFirewallSubPolicyClient_PolicyWanFirewall_Call) RunAndReturn(run fu
 	return _c
 }
 
+// PolicyWanFirewallAddRule provides a mock function for the type WanFirewallSubPolicyClient
+func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallAddRule(ctx context.Context, wanFirewallAddRuleInput cato_models.WanFirewallAddRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallAddRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, wanFirewallAddRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, wanFirewallAddRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallAddRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallAddRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallAddRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallAddRule, error)); ok {
+		return returnFunc(ctx, wanFirewallAddRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallAddRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallAddRule); ok {
+		r0 = returnFunc(ctx, wanFirewallAddRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallAddRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, cato_models.WanFirewallAddRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, wanFirewallAddRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallAddRule'
+type WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallAddRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wanFirewallAddRuleInput cato_models.WanFirewallAddRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallSubPolicyClient_Expecter) PolicyWanFirewallAddRule(ctx interface{}, wanFirewallAddRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call {
+	return &WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call{Call: _e.mock.On("PolicyWanFirewallAddRule",
+		append([]interface{}{ctx, wanFirewallAddRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call) Run(run func(ctx context.Context, wanFirewallAddRuleInput cato_models.WanFirewallAddRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 cato_models.WanFirewallAddRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(cato_models.WanFirewallAddRuleInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call) Return(policyWanFirewallAddRule *cato_go_sdk.PolicyWanFirewallAddRule, err error) *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call {
+	_c.Call.Return(policyWanFirewallAddRule, err)
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call) RunAndReturn(run func(ctx context.Context, wanFirewallAddRuleInput cato_models.WanFirewallAddRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallAddRule, error)) *WanFirewallSubPolicyClient_PolicyWanFirewallAddRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyWanFirewallAddSubPolicy provides a mock function for the type WanFirewallSubPolicyClient
 func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallAddSubPolicy(ctx context.Context, wanFirewallAddSubPolicyInput cato_models.WanFirewallAddSubPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallAddSubPolicy, error) {
 	var tmpRet mock.Arguments
@@ -218,6 +307,184 @@ func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallAddSubPolicy_Call) RunAndR
 	return _c
 }
 
+// PolicyWanFirewallDiscardPolicyRevision provides a mock function for the type WanFirewallSubPolicyClient
+func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallDiscardPolicyRevision(ctx context.Context, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, policyDiscardRevisionInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, policyDiscardRevisionInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallDiscardPolicyRevision")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error)); ok {
+		return returnFunc(ctx, policyDiscardRevisionInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision); ok {
+		r0 = returnFunc(ctx, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cato_models.PolicyDiscardRevisionInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, policyDiscardRevisionInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallDiscardPolicyRevision'
+type WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallDiscardPolicyRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallSubPolicyClient_Expecter) PolicyWanFirewallDiscardPolicyRevision(ctx interface{}, policyDiscardRevisionInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	return &WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call{Call: _e.mock.On("PolicyWanFirewallDiscardPolicyRevision",
+		append([]interface{}{ctx, policyDiscardRevisionInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call) Run(run func(ctx context.Context, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cato_models.PolicyDiscardRevisionInput
+		if args[1] != nil {
+			arg1 = args[1].(*cato_models.PolicyDiscardRevisionInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call) Return(policyWanFirewallDiscardPolicyRevision *cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, err error) *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(policyWanFirewallDiscardPolicyRevision, err)
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call) RunAndReturn(run func(ctx context.Context, policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error)) *WanFirewallSubPolicyClient_PolicyWanFirewallDiscardPolicyRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PolicyWanFirewallMoveRule provides a mock function for the type WanFirewallSubPolicyClient
+func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallMoveRule(ctx context.Context, policyMoveRuleInput cato_models.PolicyMoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallMoveRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, policyMoveRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, policyMoveRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallMoveRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallMoveRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.PolicyMoveRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallMoveRule, error)); ok {
+		return returnFunc(ctx, policyMoveRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.PolicyMoveRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallMoveRule); ok {
+		r0 = returnFunc(ctx, policyMoveRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallMoveRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, cato_models.PolicyMoveRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, policyMoveRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallMoveRule'
+type WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallMoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyMoveRuleInput cato_models.PolicyMoveRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallSubPolicyClient_Expecter) PolicyWanFirewallMoveRule(ctx interface{}, policyMoveRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call {
+	return &WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call{Call: _e.mock.On("PolicyWanFirewallMoveRule",
+		append([]interface{}{ctx, policyMoveRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call) Run(run func(ctx context.Context, policyMoveRuleInput cato_models.PolicyMoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 cato_models.PolicyMoveRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(cato_models.PolicyMoveRuleInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call) Return(policyWanFirewallMoveRule *cato_go_sdk.PolicyWanFirewallMoveRule, err error) *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call {
+	_c.Call.Return(policyWanFirewallMoveRule, err)
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call) RunAndReturn(run func(ctx context.Context, policyMoveRuleInput cato_models.PolicyMoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallMoveRule, error)) *WanFirewallSubPolicyClient_PolicyWanFirewallMoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyWanFirewallPublishPolicyRevision provides a mock function for the type WanFirewallSubPolicyClient
 func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallPublishPolicyRevision(ctx context.Context, policyPublishRevisionInput *cato_models.PolicyPublishRevisionInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallPublishPolicyRevision, error) {
 	var tmpRet mock.Arguments
@@ -307,6 +574,95 @@ func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallPublishPolicyRevision_Call
 	return _c
 }
 
+// PolicyWanFirewallRemoveRule provides a mock function for the type WanFirewallSubPolicyClient
+func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallRemoveRule(ctx context.Context, wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error) {
+	var tmpRet mock.Arguments
+	if len(interceptors) > 0 {
+		tmpRet = _mock.Called(ctx, wanFirewallRemoveRuleInput, accountID, interceptors)
+	} else {
+		tmpRet = _mock.Called(ctx, wanFirewallRemoveRuleInput, accountID)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PolicyWanFirewallRemoveRule")
+	}
+
+	var r0 *cato_go_sdk.PolicyWanFirewallRemoveRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error)); ok {
+		return returnFunc(ctx, wanFirewallRemoveRuleInput, accountID, interceptors...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, cato_models.WanFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) *cato_go_sdk.PolicyWanFirewallRemoveRule); ok {
+		r0 = returnFunc(ctx, wanFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cato_go_sdk.PolicyWanFirewallRemoveRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, cato_models.WanFirewallRemoveRuleInput, string, ...clientv2.RequestInterceptor) error); ok {
+		r1 = returnFunc(ctx, wanFirewallRemoveRuleInput, accountID, interceptors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PolicyWanFirewallRemoveRule'
+type WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call struct {
+	*mock.Call
+}
+
+// PolicyWanFirewallRemoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput
+//   - accountID string
+//   - interceptors ...clientv2.RequestInterceptor
+func (_e *WanFirewallSubPolicyClient_Expecter) PolicyWanFirewallRemoveRule(ctx interface{}, wanFirewallRemoveRuleInput interface{}, accountID interface{}, interceptors ...interface{}) *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	return &WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call{Call: _e.mock.On("PolicyWanFirewallRemoveRule",
+		append([]interface{}{ctx, wanFirewallRemoveRuleInput, accountID}, interceptors...)...)}
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call) Run(run func(ctx context.Context, wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor)) *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 cato_models.WanFirewallRemoveRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(cato_models.WanFirewallRemoveRuleInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []clientv2.RequestInterceptor
+		var variadicArgs []clientv2.RequestInterceptor
+		if len(args) > 3 {
+			variadicArgs = args[3].([]clientv2.RequestInterceptor)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call) Return(policyWanFirewallRemoveRule *cato_go_sdk.PolicyWanFirewallRemoveRule, err error) *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	_c.Call.Return(policyWanFirewallRemoveRule, err)
+	return _c
+}
+
+func (_c *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call) RunAndReturn(run func(ctx context.Context, wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error)) *WanFirewallSubPolicyClient_PolicyWanFirewallRemoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PolicyWanFirewallRemoveSubPolicy provides a mock function for the type WanFirewallSubPolicyClient
 func (_mock *WanFirewallSubPolicyClient) PolicyWanFirewallRemoveSubPolicy(ctx context.Context, wanFirewallRemoveSubPolicyInput cato_models.WanFirewallRemoveSubPolicyInput, accountID string, interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyWanFirewallRemoveSubPolicy, error) {
 	var tmpRet mock.Arguments
@@ -0,0 +1,48 @@
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// followRootStringModifier is a plan modifier for API-managed attributes that
+// the API keeps in sync with a top-level attribute, like the scope rule name of
+// a sub-policy that follows the sub-policy name.
+type followRootStringModifier struct {
+	root string
+}
+
+// FollowRootString returns a plan modifier that marks the value as Unknown
+// during Update when the top-level attribute root changes, so the synchronized
+// value is read back after apply. Use it after UseStateForUnknown.
+func FollowRootString(root string) planmodifier.String {
+	return followRootStringModifier{root: root}
+}
+
+func (m followRootStringModifier) Description(_ context.Context) string {
+	return "Marks the value as unknown during Update when " + m.root + " changes"
+}
+
+func (m followRootStringModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m followRootStringModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// On Create (no prior state) or Destroy (no plan) there is nothing to follow
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(m.root), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(m.root), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planned.Equal(prior) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	policyFindingShadowed    = "SHADOWED"
+	policyFindingRedundant   = "REDUNDANT"
+	policyFindingAnyAnyAllow = "ANY_ANY_ALLOW"
+	policyFindingDisabled    = "DISABLED"
+)
+
+// policyAnalysisRule is one rule of a policy in evaluation order, as hydrated
+// into its Terraform rule object by the rule resource's state hydrator.
+type policyAnalysisRule struct {
+	index int64
+	// group scopes the rules a rule can shadow: rules of a sub-policy (or of a
+	// LAN network rule) only shadow rules of the same group, while rules of the
+	// main policy (empty group) shadow every later rule.
+	group string
+	attrs map[string]attr.Value
+}
+
+func (r policyAnalysisRule) str(name string) string {
+	if v, ok := r.attrs[name].(types.String); ok {
+		return v.ValueString()
+	}
+	return ""
+}
+
+func (r policyAnalysisRule) enabled() bool {
+	v, ok := r.attrs["enabled"].(types.Bool)
+	return !ok || v.ValueBool()
+}
+
+// policyAnalysisShape tells the analyzer how to read the rule object of a
+// policy. Attributes that are neither actions, ignored nor disqualifying are
+// matching criteria.
+type policyAnalysisShape struct {
+	// action attributes decide what happens to matched traffic; two rules with
+	// equal actions are redundant rather than conflicting.
+	action []string
+	// ignore attributes do not affect matching.
+	ignore []string
+	// disqualify attributes prevent a rule from shadowing others when set,
+	// e.g. exceptions or a limited active period.
+	disqualify []string
+}
+
+var (
+	ifwPolicyAnalysisShape = policyAnalysisShape{
+		action:     []string{"action"},
+		ignore:     []string{"id", "name", "description", "enabled", "tracking"},
+		disqualify: []string{"exceptions", "active_period"},
+	}
+	wanPolicyAnalysisShape = ifwPolicyAnalysisShape
+	wnwPolicyAnalysisShape = policyAnalysisShape{
+		action:     []string{"route_type", "configuration", "bandwidth_priority"},
+		ignore:     []string{"id", "name", "description", "enabled"},
+		disqualify: []string{"exceptions"},
+	}
+	lanPolicyAnalysisShape = policyAnalysisShape{
+		action: []string{"action"},
+		ignore: []string{"id", "name", "description", "enabled", "tracking"},
+	}
+)
+
+// policyFinding is one result of analyzePolicy.
+type policyFinding struct {
+	kind    string
+	rule    policyAnalysisRule
+	related *policyAnalysisRule
+	detail  string
+}
+
+// analyzePolicy reports disabled rules, enabled ALLOW rules that match all
+// traffic (ANY_ANY_ALLOW) and rules that can never match because an earlier
+// enabled rule matches all of their traffic: SHADOWED when the earlier rule acts differently, REDUNDANT when it
+// acts the same. The comparison is conservative: a rule only covers another
+// when every matching criterion of the later rule is contained in the earlier
+// one, so a broader rule written with different objects is not reported.
+func analyzePolicy(rules []policyAnalysisRule, shape policyAnalysisShape) []policyFinding {
+	skip := make(map[string]struct{}, len(shape.action)+len(shape.ignore)+len(shape.disqualify))
+	for _, names := range [][]string{shape.action, shape.ignore, shape.disqualify} {
+		for _, n := range names {
+			skip[n] = struct{}{}
+		}
+	}
+
+	var findings []policyFinding
+	for i, rule := range rules {
+		if !rule.enabled() {
+			findings = append(findings, policyFinding{kind: policyFindingDisabled, rule: rule, detail: "rule is disabled"})
+			continue
+		}
+		if rule.str("action") == "ALLOW" && policyAnalysisMatchesAll(rule, shape) {
+			findings = append(findings, policyFinding{kind: policyFindingAnyAnyAllow, rule: rule, detail: "rule allows all traffic"})
+		}
+
+		for j := 0; j < i; j++ {
+			earlier := rules[j]
+			if !earlier.enabled() || (earlier.group != "" && earlier.group != rule.group) {
+				continue
+			}
+			if !policyAnalysisCanShadow(earlier, shape) || !policyAnalysisCovers(earlier, rule, skip) {
+				continue
+			}
+			kind, detail := policyFindingRedundant, "every match criterion is covered by an earlier rule with the same action"
+			if diff := policyAnalysisActionDiff(earlier, rule, shape); diff != "" {
+				kind, detail = policyFindingShadowed, fmt.Sprintf("every match criterion is covered by an earlier rule with a different %s", diff)
+			}
+			related := earlier
+			findings = append(findings, policyFinding{kind: kind, rule: rule, related: &related, detail: detail})
+			break
+		}
+	}
+	return findings
+}
+
+// policyAnalysisMatchesAll reports whether every attribute of rule other than
+// its actions and ignored attributes is any, so the rule matches all traffic.
+// Unlike policyAnalysisCovers, disqualifying attributes such as exceptions
+// limit the rule too.
+func policyAnalysisMatchesAll(rule policyAnalysisRule, shape policyAnalysisShape) bool {
+	skip := make(map[string]struct{}, len(shape.action)+len(shape.ignore))
+	for _, names := range [][]string{shape.action, shape.ignore} {
+		for _, n := range names {
+			skip[n] = struct{}{}
+		}
+	}
+	for name, v := range rule.attrs {
+		if _, ok := skip[name]; ok {
+			continue
+		}
+		if !policyAnalysisAny(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func policyAnalysisCanShadow(rule policyAnalysisRule, shape policyAnalysisShape) bool {
+	for _, name := range shape.disqualify {
+		if !policyAnalysisAny(rule.attrs[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// policyAnalysisCovers reports whether every matching criterion of later is
+// contained in the same criterion of earlier.
+func policyAnalysisCovers(earlier, later policyAnalysisRule, skip map[string]struct{}) bool {
+	for name, lv := range later.attrs {
+		if _, ok := skip[name]; ok {
+			continue
+		}
+		ev := earlier.attrs[name]
+		if policyAnalysisAny(ev) {
+			continue
+		}
+		if policyAnalysisAny(lv) || !policyAnalysisContains(ev, lv) {
+			return false
+		}
+	}
+	return true
+}
+
+// policyAnalysisActionDiff names the first action attribute that differs
+// between the two rules, or returns "" when they act the same.
+func policyAnalysisActionDiff(a, b policyAnalysisRule, shape policyAnalysisShape) string {
+	for _, name := range shape.action {
+		av, bv := a.attrs[name], b.attrs[name]
+		if av == nil && bv == nil {
+			continue
+		}
+		if av == nil || bv == nil || !av.Equal(bv) {
+			return name
+		}
+	}
+	return ""
+}
+
+// policyAnalysisContains reports whether outer matches everything inner
+// matches. Lists and sets match any of their elements, objects match any of
+// their set attributes, so an attribute of inner that is set must be contained
+// in the same attribute of outer.
+func policyAnalysisContains(outer, inner attr.Value) bool {
+	switch iv := inner.(type) {
+	case types.Object:
+		ov, ok := outer.(types.Object)
+		if !ok {
+			return false
+		}
+		for name, v := range iv.Attributes() {
+			if policyAnalysisAny(v) {
+				continue
+			}
+			o := ov.Attributes()[name]
+			if policyAnalysisAny(o) || !policyAnalysisContains(o, v) {
+				return false
+			}
+		}
+		return true
+	case types.List:
+		ov, ok := outer.(types.List)
+		return ok && policyAnalysisSubset(ov.Elements(), iv.Elements())
+	case types.Set:
+		ov, ok := outer.(types.Set)
+		return ok && policyAnalysisSubset(ov.Elements(), iv.Elements())
+	default:
+		return outer != nil && outer.Equal(inner)
+	}
+}
+
+func policyAnalysisSubset(outer, inner []attr.Value) bool {
+	for _, v := range inner {
+		found := false
+		for _, o := range outer {
+			if o.Equal(v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// policyAnalysisAny reports whether v places no restriction on traffic: unset,
+// empty, false, or one of the catch-all enum values ANY, ALWAYS and BOTH.
+func policyAnalysisAny(v attr.Value) bool {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return true
+	}
+	switch tv := v.(type) {
+	case types.String:
+		switch strings.ToUpper(tv.ValueString()) {
+		case "", "ANY", "ALWAYS", "BOTH":
+			return true
+		}
+		return false
+	case types.Bool:
+		return !tv.ValueBool()
+	case types.List:
+		return len(tv.Elements()) == 0
+	case types.Set:
+		return len(tv.Elements()) == 0
+	case types.Object:
+		for _, a := range tv.Attributes() {
+			if !policyAnalysisAny(a) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
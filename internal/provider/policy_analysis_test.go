@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+var policyAnalysisTestRefAttrTypes = map[string]attr.Type{
+	"host": types.ListType{ElemType: types.StringType},
+	"ip":   types.ListType{ElemType: types.StringType},
+}
+
+func policyAnalysisTestRef(host, ip []string) types.Object {
+	list := func(in []string) types.List {
+		values := make([]attr.Value, 0, len(in))
+		for _, v := range in {
+			values = append(values, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+	return types.ObjectValueMust(policyAnalysisTestRefAttrTypes, map[string]attr.Value{
+		"host": list(host),
+		"ip":   list(ip),
+	})
+}
+
+func policyAnalysisTestRule(index int64, group, id, action string, enabled bool, source, destination types.Object, exceptions int) policyAnalysisRule {
+	excs := make([]attr.Value, 0, exceptions)
+	for i := 0; i < exceptions; i++ {
+		excs = append(excs, types.StringValue("exception"))
+	}
+	return policyAnalysisRule{
+		index: index,
+		group: group,
+		attrs: map[string]attr.Value{
+			"id":          types.StringValue(id),
+			"name":        types.StringValue(id),
+			"enabled":     types.BoolValue(enabled),
+			"action":      types.StringValue(action),
+			"direction":   types.StringValue("BOTH"),
+			"source":      source,
+			"destination": destination,
+			"exceptions":  types.ListValueMust(types.StringType, excs),
+		},
+	}
+}
+
+func policyAnalysisTestKinds(findings []policyFinding) map[string]string {
+	out := map[string]string{}
+	for _, f := range findings {
+		related := ""
+		if f.related != nil {
+			related = ":" + f.related.str("id")
+		}
+		out[f.rule.str("id")+"/"+f.kind] = f.kind + related
+	}
+	return out
+}
+
+func TestAnalyzePolicy(t *testing.T) {
+	t.Parallel()
+
+	anyRef := policyAnalysisTestRef(nil, nil)
+	hosts := policyAnalysisTestRef([]string{"h1", "h2"}, nil)
+	h1 := policyAnalysisTestRef([]string{"h1"}, nil)
+	h3 := policyAnalysisTestRef([]string{"h3"}, nil)
+	ip := policyAnalysisTestRef(nil, []string{"10.0.0.1"})
+
+	rules := []policyAnalysisRule{
+		policyAnalysisTestRule(1, "", "block-hosts", "BLOCK", true, hosts, anyRef, 0),
+		policyAnalysisTestRule(2, "", "allow-h1", "ALLOW", true, h1, ip, 0),
+		policyAnalysisTestRule(3, "", "block-h1", "BLOCK", true, h1, ip, 0),
+		policyAnalysisTestRule(4, "", "allow-h3", "ALLOW", true, h3, ip, 0),
+		policyAnalysisTestRule(5, "", "off", "BLOCK", false, anyRef, anyRef, 0),
+		policyAnalysisTestRule(6, "", "allow-all", "ALLOW", true, anyRef, anyRef, 0),
+	}
+	got := policyAnalysisTestKinds(analyzePolicy(rules, ifwPolicyAnalysisShape))
+	require.Equal(t, map[string]string{
+		"allow-h1/SHADOWED":       "SHADOWED:block-hosts",
+		"block-h1/REDUNDANT":      "REDUNDANT:block-hosts",
+		"off/DISABLED":            "DISABLED",
+		"allow-all/ANY_ANY_ALLOW": "ANY_ANY_ALLOW",
+	}, got)
+}
+
+func TestAnalyzePolicyAnyAnyAllowRequiresEveryCriterion(t *testing.T) {
+	t.Parallel()
+
+	anyRef := policyAnalysisTestRef(nil, nil)
+	app := policyAnalysisTestRule(1, "", "allow-app", "ALLOW", true, anyRef, anyRef, 0)
+	app.attrs["application"] = policyAnalysisTestRef([]string{"app"}, nil)
+	excepted := policyAnalysisTestRule(2, "", "allow-excepted", "ALLOW", true, anyRef, anyRef, 1)
+	all := policyAnalysisTestRule(3, "", "allow-all", "ALLOW", true, anyRef, anyRef, 0)
+	all.attrs["application"] = anyRef
+
+	got := analyzePolicy([]policyAnalysisRule{app, excepted, all}, ifwPolicyAnalysisShape)
+	require.Len(t, got, 1)
+	require.Equal(t, "allow-all", got[0].rule.str("id"))
+	require.Equal(t, policyFindingAnyAnyAllow, got[0].kind)
+}
+
+func TestAnalyzePolicyIgnoresRulesWithExceptions(t *testing.T) {
+	t.Parallel()
+
+	anyRef := policyAnalysisTestRef(nil, nil)
+	h1 := policyAnalysisTestRef([]string{"h1"}, nil)
+
+	rules := []policyAnalysisRule{
+		policyAnalysisTestRule(1, "", "block-all", "BLOCK", true, anyRef, anyRef, 1),
+		policyAnalysisTestRule(2, "", "allow-h1", "ALLOW", true, h1, anyRef, 0),
+	}
+	require.Empty(t, analyzePolicy(rules, ifwPolicyAnalysisShape))
+}
+
+func TestAnalyzePolicyScopesSubPolicies(t *testing.T) {
+	t.Parallel()
+
+	h1 := policyAnalysisTestRef([]string{"h1"}, nil)
+	ip := policyAnalysisTestRef(nil, []string{"10.0.0.1"})
+
+	rules := []policyAnalysisRule{
+		policyAnalysisTestRule(1, "sp-1", "sp1-block", "BLOCK", true, h1, ip, 0),
+		policyAnalysisTestRule(2, "sp-2", "sp2-allow", "ALLOW", true, h1, ip, 0),
+		policyAnalysisTestRule(3, "sp-1", "sp1-allow", "ALLOW", true, h1, ip, 0),
+		policyAnalysisTestRule(4, "", "main-block", "BLOCK", true, h1, ip, 0),
+		policyAnalysisTestRule(5, "sp-3", "sp3-allow", "ALLOW", true, h1, ip, 0),
+	}
+	got := policyAnalysisTestKinds(analyzePolicy(rules, ifwPolicyAnalysisShape))
+	require.Equal(t, map[string]string{
+		"sp1-allow/SHADOWED": "SHADOWED:sp1-block",
+		"sp3-allow/SHADOWED": "SHADOWED:main-block",
+	}, got)
+}
+
+func TestPolicyAnalysisAny(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, policyAnalysisAny(nil))
+	require.True(t, policyAnalysisAny(types.StringNull()))
+	require.True(t, policyAnalysisAny(types.StringValue("ANY")))
+	require.True(t, policyAnalysisAny(types.StringValue("Always")))
+	require.True(t, policyAnalysisAny(types.BoolValue(false)))
+	require.True(t, policyAnalysisAny(policyAnalysisTestRef(nil, nil)))
+	require.False(t, policyAnalysisAny(types.StringValue("REMOTE")))
+	require.False(t, policyAnalysisAny(types.BoolValue(true)))
+	require.False(t, policyAnalysisAny(policyAnalysisTestRef([]string{"h1"}, nil)))
+}
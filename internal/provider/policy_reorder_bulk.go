@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 
@@ -30,6 +32,9 @@ type BulkPolicyRuleRow struct {
 	RuleName    string
 	Index       int64
 	IsSystem    bool
+	Enabled     bool
+	// IsSubPolicyScope is set on the SUB_POLICY_SCOPE rule placing a sub-policy in a section
+	IsSubPolicyScope bool
 }
 
 // BulkPlannedRuleIndex is one rule placement from Terraform rule_data.
@@ -286,6 +291,361 @@ func buildPolicyReorderInput(
 	return cato_models.PolicyReorderInput{Sections: outSections}, nil
 }
 
+// policyReorderUnchanged reports whether applying the reorder input would leave
+// every rule at its current position, so the reorder call can be skipped.
+func policyReorderUnchanged(rules []BulkPolicyRuleRow, in cato_models.PolicyReorderInput) bool {
+	sectionIDs := make(map[string]struct{}, len(in.Sections))
+	for _, sec := range in.Sections {
+		sectionIDs[sec.Ref.Input] = struct{}{}
+	}
+	rulesBySectionID, err := indexRulesBySectionID(sectionIDs, rules)
+	if err != nil {
+		return false
+	}
+	for _, sec := range in.Sections {
+		current := rulesBySectionID[sec.Ref.Input]
+		if len(current) != len(sec.Rules) {
+			return false
+		}
+		for i, rule := range sec.Rules {
+			if current[i].RuleID != rule.Ref.Input {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// BulkPlannedRuleMove is one relative rule move, as issued by the Policy*MoveRule mutations.
+type BulkPlannedRuleMove struct {
+	RuleID      string
+	RuleName    string
+	SectionID   string
+	SectionName string
+	Position    string // ifwRulePositionFirstInSection (Ref is the section) or ifwRulePositionAfterRule
+	Ref         string
+	RefName     string
+}
+
+func (m BulkPlannedRuleMove) String() string {
+	return fmt.Sprintf("move rule %q %s in section %q", m.RuleName, m.anchor(), m.SectionName)
+}
+
+// anchor describes where the rule is moved to within its section
+func (m BulkPlannedRuleMove) anchor() string {
+	if m.Position == ifwRulePositionFirstInSection {
+		return "first"
+	}
+	return fmt.Sprintf("after rule %q", m.RefName)
+}
+
+// input returns the PolicyMoveRuleInput of the move
+func (m BulkPlannedRuleMove) input() cato_models.PolicyMoveRuleInput {
+	position := cato_models.PolicyRulePositionEnum(m.Position)
+	ref := m.Ref
+	return cato_models.PolicyMoveRuleInput{
+		ID: m.RuleID,
+		To: &cato_models.PolicyRulePositionInput{Position: &position, Ref: &ref},
+	}
+}
+
+// formatBulkRuleMoves lists the moves one per line, for logs and dry-run output
+func formatBulkRuleMoves(moves []BulkPlannedRuleMove) string {
+	if len(moves) == 0 {
+		return "no rule moves"
+	}
+	lines := make([]string, 0, len(moves))
+	for _, m := range moves {
+		lines = append(lines, m.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// planBulkRuleMoves computes the rule moves placing the planned rules, see buildPolicyReorderInput
+// for the target order and planMinimalRuleMoves for the moves.
+func planBulkRuleMoves(
+	sections []BulkPolicySectionRef,
+	rules []BulkPolicyRuleRow,
+	planned []BulkPlannedRuleIndex,
+) ([]BulkPlannedRuleMove, error) {
+	in, err := buildPolicyReorderInput(sections, rules, planned)
+	if err != nil {
+		return nil, err
+	}
+	return planMinimalRuleMoves(sections, rules, in)
+}
+
+// currentBulkRuleIndexes returns the planned rules at their place in the policy while rule moves are
+// pending: the current section of each rule and its 1-based index among the non-system rules of that
+// section. The planned rules are returned as is when no move is pending.
+func currentBulkRuleIndexes(
+	sections []BulkPolicySectionRef,
+	rules []BulkPolicyRuleRow,
+	planned []BulkPlannedRuleIndex,
+) ([]BulkPlannedRuleIndex, error) {
+	moves, err := planBulkRuleMoves(sections, rules, planned)
+	if err != nil || len(moves) == 0 {
+		return planned, err
+	}
+	rulesBySectionID, err := indexRulesBySectionID(sectionIDSet(sections), rules)
+	if err != nil {
+		return planned, err
+	}
+
+	current := make(map[string]BulkPlannedRuleIndex, len(rules))
+	for _, sec := range sections {
+		index := int64(0)
+		for _, rw := range rulesBySectionID[sec.ID] {
+			if rw.IsSystem {
+				continue
+			}
+			index++
+			current[rw.RuleName] = BulkPlannedRuleIndex{SectionName: sec.Name, RuleName: rw.RuleName, IndexInSection: index}
+		}
+	}
+	out := make([]BulkPlannedRuleIndex, 0, len(planned))
+	for _, p := range planned {
+		if c, ok := current[p.RuleName]; ok {
+			p = c
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// currentBulkSectionIndexes returns the 1-based index of each planned section among the planned
+// sections in policy order, or the planned indexes when the policy already is in the planned order.
+// Sections not in the policy keep their planned index.
+func currentBulkSectionIndexes(sections []BulkPolicySectionRef, planned map[string]int64) map[string]int64 {
+	current := make(map[string]int64, len(planned))
+	for _, sec := range sections {
+		if _, ok := planned[sec.Name]; ok {
+			current[sec.Name] = int64(len(current) + 1)
+		}
+	}
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	byCurrent := slices.Clone(names)
+	sort.Slice(byCurrent, func(i, j int) bool { return current[byCurrent[i]] < current[byCurrent[j]] })
+	byPlanned := slices.Clone(names)
+	sort.Slice(byPlanned, func(i, j int) bool { return planned[byPlanned[i]] < planned[byPlanned[j]] })
+	if slices.Equal(byCurrent, byPlanned) {
+		return planned
+	}
+
+	out := maps.Clone(planned)
+	maps.Copy(out, current)
+	return out
+}
+
+// BulkRuleMovePreview is one planned rule placement with the current and target place of the rule,
+// for the planned_moves attribute of the bulk move resources. Indexes are 1-based within the section.
+type BulkRuleMovePreview struct {
+	RuleName    string
+	FromSection string
+	FromIndex   int64
+	ToSection   string
+	ToIndex     int64
+	Anchor      string
+}
+
+// previewBulkPolicyReorder lists the rules the reorder input of buildPolicyReorderInput places at
+// another section or index, in the order of the reordered policy, with the current and target place
+// of each rule. The anchor is the rule preceding it in the reordered section.
+func previewBulkPolicyReorder(
+	sections []BulkPolicySectionRef,
+	rules []BulkPolicyRuleRow,
+	planned []BulkPlannedRuleIndex,
+) ([]BulkRuleMovePreview, error) {
+	in, err := buildPolicyReorderInput(sections, rules, planned)
+	if err != nil {
+		return nil, err
+	}
+	rulesBySectionID, err := indexRulesBySectionID(sectionIDSet(sections), rules)
+	if err != nil {
+		return nil, err
+	}
+
+	from := make(map[string]BulkPolicyRuleRow, len(rules))
+	fromIndex := make(map[string]int64, len(rules))
+	for _, sectionRules := range rulesBySectionID {
+		for i, rw := range sectionRules {
+			from[rw.RuleID] = rw
+			fromIndex[rw.RuleID] = int64(i + 1)
+		}
+	}
+	sectionNames := make(map[string]string, len(sections))
+	for _, sec := range sections {
+		sectionNames[sec.ID] = sec.Name
+	}
+
+	var previews []BulkRuleMovePreview
+	for _, sec := range in.Sections {
+		for i, rule := range sec.Rules {
+			rw := from[rule.Ref.Input]
+			if rw.SectionID == sec.Ref.Input && fromIndex[rw.RuleID] == int64(i+1) {
+				continue
+			}
+			anchor := "first"
+			if i > 0 {
+				anchor = fmt.Sprintf("after rule %q", from[sec.Rules[i-1].Ref.Input].RuleName)
+			}
+			previews = append(previews, BulkRuleMovePreview{
+				RuleName:    rw.RuleName,
+				FromSection: rw.SectionName,
+				FromIndex:   fromIndex[rw.RuleID],
+				ToSection:   sectionNames[sec.Ref.Input],
+				ToIndex:     int64(i + 1),
+				Anchor:      anchor,
+			})
+		}
+	}
+	return previews, nil
+}
+
+// planMinimalRuleMoves computes the fewest relative rule moves that turn the current policy
+// into the order of the reorder input. In each section the rules that keep their place are the
+// longest subsequence of the target order already in current order (a longest increasing
+// subsequence of their current indexes); every other rule is moved after its predecessor in the
+// target order, or first in its section. Applying the moves in order yields the target order,
+// as each moved rule lands after a rule already at its final place. System rules are never moved.
+func planMinimalRuleMoves(
+	sections []BulkPolicySectionRef,
+	rules []BulkPolicyRuleRow,
+	in cato_models.PolicyReorderInput,
+) ([]BulkPlannedRuleMove, error) {
+	sectionNames := make(map[string]string, len(sections))
+	for _, sec := range sections {
+		sectionNames[sec.ID] = sec.Name
+	}
+	rulesByID := make(map[string]BulkPolicyRuleRow, len(rules))
+	for _, rw := range rules {
+		rulesByID[rw.RuleID] = rw
+	}
+
+	var moves []BulkPlannedRuleMove
+	for _, sec := range in.Sections {
+		sectionID := sec.Ref.Input
+		target := make([]BulkPolicyRuleRow, 0, len(sec.Rules))
+		for _, rule := range sec.Rules {
+			rw, ok := rulesByID[rule.Ref.Input]
+			if !ok {
+				return nil, fmt.Errorf("rule id %q of section %q not found in current policy", rule.Ref.Input, sectionNames[sectionID])
+			}
+			target = append(target, rw)
+		}
+
+		// only rules already in the section can keep their place; system rules must keep it
+		keys := make([]int64, len(target))
+		weights := make([]int, len(target))
+		for i, rw := range target {
+			keys[i] = -1
+			if rw.SectionID == sectionID {
+				keys[i] = rw.Index
+			}
+			weights[i] = 1
+			if rw.IsSystem {
+				weights[i] = len(target) + 1
+			}
+		}
+		keep := heaviestIncreasingSubsequence(keys, weights)
+
+		for i, rw := range target {
+			if keep[i] {
+				continue
+			}
+			if rw.IsSystem {
+				return nil, fmt.Errorf("system rule %q in section %q cannot be moved", rw.RuleName, sectionNames[sectionID])
+			}
+			move := BulkPlannedRuleMove{
+				RuleID:      rw.RuleID,
+				RuleName:    rw.RuleName,
+				SectionID:   sectionID,
+				SectionName: sectionNames[sectionID],
+				Position:    ifwRulePositionFirstInSection,
+				Ref:         sectionID,
+				RefName:     sectionNames[sectionID],
+			}
+			if i > 0 {
+				move.Position = ifwRulePositionAfterRule
+				move.Ref = target[i-1].RuleID
+				move.RefName = target[i-1].RuleName
+			}
+			moves = append(moves, move)
+		}
+	}
+	return moves, nil
+}
+
+// heaviestIncreasingSubsequence returns the elements of the heaviest subsequence with strictly
+// increasing keys, elements with a negative key are never part of it. With unit weights this is
+// the longest increasing subsequence. Runs in O(n log n) using a Fenwick tree of prefix maxima
+// over the ranks of the keys.
+func heaviestIncreasingSubsequence(keys []int64, weights []int) []bool {
+	n := len(keys)
+	keep := make([]bool, n)
+
+	ranked := make([]int64, 0, n)
+	for _, k := range keys {
+		if k >= 0 {
+			ranked = append(ranked, k)
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool { return ranked[i] < ranked[j] })
+	rank := func(k int64) int {
+		return sort.Search(len(ranked), func(i int) bool { return ranked[i] >= k }) + 1
+	}
+
+	// tree[r] holds the best (weight, element) of a subsequence ending at a key of rank <= r
+	type best struct {
+		weight  int
+		element int
+	}
+	tree := make([]best, len(ranked)+1)
+	for i := range tree {
+		tree[i] = best{element: -1}
+	}
+	query := func(r int) best {
+		b := best{element: -1}
+		for ; r > 0; r -= r & -r {
+			if tree[r].weight > b.weight {
+				b = tree[r]
+			}
+		}
+		return b
+	}
+	update := func(r int, b best) {
+		for ; r < len(tree); r += r & -r {
+			if b.weight > tree[r].weight {
+				tree[r] = b
+			}
+		}
+	}
+
+	prev := make([]int, n)
+	overall := best{element: -1}
+	for i, k := range keys {
+		prev[i] = -1
+		if k < 0 {
+			continue
+		}
+		r := rank(k)
+		b := query(r - 1)
+		prev[i] = b.element
+		cur := best{weight: b.weight + weights[i], element: i}
+		update(r, cur)
+		if cur.weight > overall.weight {
+			overall = cur
+		}
+	}
+	for i := overall.element; i >= 0; i = prev[i] {
+		keep[i] = true
+	}
+	return keep
+}
+
 func internetFirewallReorderError(resp *cato_go_sdk.PolicyInternetFirewallReorderPolicy, callErr error) error {
 	if callErr != nil {
 		return callErr
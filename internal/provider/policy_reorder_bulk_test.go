@@ -3,9 +3,16 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
 	"testing"
 
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -278,3 +285,465 @@ func TestWanFirewallBulkPolicyClient_ReorderInvoked(t *testing.T) {
 	_, err := m.PolicyWanFirewallReorderPolicy(ctx, nil, reorderIn, accountID)
 	require.ErrorContains(t, err, "injected")
 }
+
+// syntheticPolicy builds a policy of one section per entry of sizes, with rules named
+// "s<section>-r<index>" in index order.
+func syntheticPolicy(sizes ...int) ([]BulkPolicySectionRef, []BulkPolicyRuleRow) {
+	var sections []BulkPolicySectionRef
+	var rules []BulkPolicyRuleRow
+	index := int64(0)
+	for s, size := range sizes {
+		sec := BulkPolicySectionRef{ID: fmt.Sprintf("sid%d", s), Name: fmt.Sprintf("s%d", s)}
+		sections = append(sections, sec)
+		for i := 0; i < size; i++ {
+			index++
+			name := fmt.Sprintf("s%d-r%d", s, i)
+			rules = append(rules, BulkPolicyRuleRow{
+				SectionID: sec.ID, SectionName: sec.Name, RuleID: "id-" + name, RuleName: name, Index: index,
+			})
+		}
+	}
+	return sections, rules
+}
+
+// applyRuleMoves replays the moves on the policy and returns the rule IDs of each section in order
+func applyRuleMoves(t *testing.T, sections []BulkPolicySectionRef, rules []BulkPolicyRuleRow, moves []BulkPlannedRuleMove) map[string][]string {
+	t.Helper()
+	order := map[string][]string{}
+	for _, sec := range sections {
+		order[sec.ID] = nil
+	}
+	sorted := append([]BulkPolicyRuleRow{}, rules...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+	for _, rw := range sorted {
+		order[rw.SectionID] = append(order[rw.SectionID], rw.RuleID)
+	}
+
+	for _, m := range moves {
+		for sid, ids := range order {
+			order[sid] = slices.DeleteFunc(ids, func(id string) bool { return id == m.RuleID })
+		}
+		switch m.Position {
+		case ifwRulePositionFirstInSection:
+			order[m.Ref] = append([]string{m.RuleID}, order[m.Ref]...)
+		case ifwRulePositionAfterRule:
+			placed := false
+			for sid, ids := range order {
+				if pos := slices.Index(ids, m.Ref); pos >= 0 {
+					order[sid] = slices.Insert(ids, pos+1, m.RuleID)
+					placed = true
+					break
+				}
+			}
+			require.True(t, placed, "anchor %s of %s not found", m.Ref, m.RuleID)
+		default:
+			t.Fatalf("unexpected position %s", m.Position)
+		}
+	}
+	return order
+}
+
+func reorderTargetIDs(in cato_models.PolicyReorderInput) map[string][]string {
+	target := map[string][]string{}
+	for _, sec := range in.Sections {
+		ids := []string{}
+		for _, rule := range sec.Rules {
+			ids = append(ids, rule.Ref.Input)
+		}
+		target[sec.Ref.Input] = ids
+	}
+	return target
+}
+
+func TestPlanMinimalRuleMoves_singleRuleMovedInLargeSection(t *testing.T) {
+	t.Parallel()
+	sections, rules := syntheticPolicy(400)
+
+	// move the last rule to the top of the section
+	planned := []BulkPlannedRuleIndex{{SectionName: "s0", RuleName: "s0-r399", IndexInSection: 1}}
+	moves, err := planBulkRuleMoves(sections, rules, planned)
+	require.NoError(t, err)
+	require.Equal(t, []BulkPlannedRuleMove{{
+		RuleID: "id-s0-r399", RuleName: "s0-r399", SectionID: "sid0", SectionName: "s0",
+		Position: ifwRulePositionFirstInSection, Ref: "sid0", RefName: "s0",
+	}}, moves)
+}
+
+func TestPlanMinimalRuleMoves_noMovesWhenOrdered(t *testing.T) {
+	t.Parallel()
+	sections, rules := syntheticPolicy(50, 50)
+
+	planned := make([]BulkPlannedRuleIndex, 0, len(rules))
+	for _, rw := range rules {
+		planned = append(planned, BulkPlannedRuleIndex{SectionName: rw.SectionName, RuleName: rw.RuleName, IndexInSection: rw.Index})
+	}
+	moves, err := planBulkRuleMoves(sections, rules, planned)
+	require.NoError(t, err)
+	require.Empty(t, moves)
+	require.Equal(t, "no rule moves", formatBulkRuleMoves(moves))
+}
+
+func TestPlanMinimalRuleMoves_reversedSection(t *testing.T) {
+	t.Parallel()
+	sections, rules := syntheticPolicy(400)
+
+	planned := make([]BulkPlannedRuleIndex, 0, len(rules))
+	for i, rw := range rules {
+		planned = append(planned, BulkPlannedRuleIndex{SectionName: "s0", RuleName: rw.RuleName, IndexInSection: int64(len(rules) - i)})
+	}
+	in, err := buildPolicyReorderInput(sections, rules, planned)
+	require.NoError(t, err)
+	moves, err := planMinimalRuleMoves(sections, rules, in)
+	require.NoError(t, err)
+	require.Len(t, moves, 399)
+	require.Equal(t, reorderTargetIDs(in), applyRuleMoves(t, sections, rules, moves))
+}
+
+func TestPlanMinimalRuleMoves_randomPoliciesReachTargetWithMinimalMoves(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(42))
+
+	for iteration := 0; iteration < 20; iteration++ {
+		sections, rules := syntheticPolicy(rng.Intn(150)+1, rng.Intn(150)+1, rng.Intn(150)+1)
+
+		// shuffle every rule into a random section and position
+		perm := rng.Perm(len(rules))
+		counts := map[string]int64{}
+		planned := make([]BulkPlannedRuleIndex, 0, len(rules))
+		for _, p := range perm {
+			sec := sections[rng.Intn(len(sections))].Name
+			counts[sec]++
+			planned = append(planned, BulkPlannedRuleIndex{SectionName: sec, RuleName: rules[p].RuleName, IndexInSection: counts[sec]})
+		}
+
+		in, err := buildPolicyReorderInput(sections, rules, planned)
+		require.NoError(t, err)
+		moves, err := planMinimalRuleMoves(sections, rules, in)
+		require.NoError(t, err)
+
+		target := reorderTargetIDs(in)
+		require.Equal(t, target, applyRuleMoves(t, sections, rules, moves))
+
+		// the rules kept in place are a longest increasing subsequence of each section
+		rulesByID := map[string]BulkPolicyRuleRow{}
+		for _, rw := range rules {
+			rulesByID[rw.RuleID] = rw
+		}
+		kept := 0
+		for sid, ids := range target {
+			var keys []int64
+			for _, id := range ids {
+				if rulesByID[id].SectionID == sid {
+					keys = append(keys, rulesByID[id].Index)
+				}
+			}
+			kept += quadraticLIS(keys)
+		}
+		require.Len(t, moves, len(rules)-kept)
+	}
+}
+
+func quadraticLIS(keys []int64) int {
+	best := 0
+	length := make([]int, len(keys))
+	for i := range keys {
+		length[i] = 1
+		for j := 0; j < i; j++ {
+			if keys[j] < keys[i] && length[j]+1 > length[i] {
+				length[i] = length[j] + 1
+			}
+		}
+		best = max(best, length[i])
+	}
+	return best
+}
+
+func TestPlanMinimalRuleMoves_crossSectionMove(t *testing.T) {
+	t.Parallel()
+	sections, rules := syntheticPolicy(3, 3)
+
+	planned := []BulkPlannedRuleIndex{
+		{SectionName: "s1", RuleName: "s1-r0", IndexInSection: 1},
+		{SectionName: "s1", RuleName: "s0-r1", IndexInSection: 2},
+	}
+	moves, err := planBulkRuleMoves(sections, rules, planned)
+	require.NoError(t, err)
+	require.Len(t, moves, 1)
+	require.Equal(t, `move rule "s0-r1" after rule "s1-r0" in section "s1"`, moves[0].String())
+	require.Equal(t, "id-s1-r0", *moves[0].input().To.Ref)
+}
+
+func TestPlanMinimalRuleMoves_neverMovesSystemRules(t *testing.T) {
+	t.Parallel()
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "Sec"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r1", RuleName: "A", Index: 1},
+		{SectionID: "s1", SectionName: "Sec", RuleID: "system", RuleName: "System", Index: 2, IsSystem: true},
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r2", RuleName: "B", Index: 3},
+	}
+	planned := []BulkPlannedRuleIndex{
+		{SectionName: "Sec", RuleName: "B", IndexInSection: 1},
+		{SectionName: "Sec", RuleName: "A", IndexInSection: 2},
+	}
+
+	in, err := buildPolicyReorderInput(sections, rules, planned)
+	require.NoError(t, err)
+	moves, err := planMinimalRuleMoves(sections, rules, in)
+	require.NoError(t, err)
+	for _, m := range moves {
+		require.NotEqual(t, "system", m.RuleID)
+	}
+	require.Len(t, moves, 2)
+	require.Equal(t, reorderTargetIDs(in), applyRuleMoves(t, sections, rules, moves))
+}
+
+func TestHeaviestIncreasingSubsequence(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []bool{true, false, true, true}, heaviestIncreasingSubsequence([]int64{1, 0, 2, 3}, []int{1, 1, 1, 1}))
+	// a heavy element wins over a longer subsequence
+	require.Equal(t, []bool{false, false, false, true}, heaviestIncreasingSubsequence([]int64{1, 2, 3, 0}, []int{1, 1, 1, 5}))
+	// negative keys are never kept
+	require.Equal(t, []bool{false, true}, heaviestIncreasingSubsequence([]int64{-1, 4}, []int{1, 1}))
+	require.Empty(t, heaviestIncreasingSubsequence(nil, nil))
+}
+
+func TestPreviewBulkPolicyReorder(t *testing.T) {
+	t.Parallel()
+
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "Sec A"}, {ID: "s2", Name: "Sec B"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r1", RuleName: "a1", Index: 1},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r2", RuleName: "a2", Index: 2},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r3", RuleName: "a3", Index: 3},
+		{SectionID: "s2", SectionName: "Sec B", RuleID: "r4", RuleName: "b1", Index: 4},
+	}
+
+	previews, err := previewBulkPolicyReorder(sections, rules, []BulkPlannedRuleIndex{
+		{SectionName: "Sec A", RuleName: "a3", IndexInSection: 1},
+		{SectionName: "Sec A", RuleName: "a1", IndexInSection: 2},
+		{SectionName: "Sec A", RuleName: "a2", IndexInSection: 3},
+		{SectionName: "Sec B", RuleName: "b1", IndexInSection: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []BulkRuleMovePreview{
+		{RuleName: "a3", FromSection: "Sec A", FromIndex: 3, ToSection: "Sec A", ToIndex: 1, Anchor: "first"},
+		{RuleName: "a1", FromSection: "Sec A", FromIndex: 1, ToSection: "Sec A", ToIndex: 2, Anchor: `after rule "a3"`},
+		{RuleName: "a2", FromSection: "Sec A", FromIndex: 2, ToSection: "Sec A", ToIndex: 3, Anchor: `after rule "a1"`},
+	}, previews)
+
+	previews, err = previewBulkPolicyReorder(sections, rules, []BulkPlannedRuleIndex{
+		{SectionName: "Sec B", RuleName: "b1", IndexInSection: 1},
+		{SectionName: "Sec B", RuleName: "a2", IndexInSection: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []BulkRuleMovePreview{
+		{RuleName: "a3", FromSection: "Sec A", FromIndex: 3, ToSection: "Sec A", ToIndex: 2, Anchor: `after rule "a1"`},
+		{RuleName: "a2", FromSection: "Sec A", FromIndex: 2, ToSection: "Sec B", ToIndex: 2, Anchor: `after rule "b1"`},
+	}, previews)
+
+	previews, err = previewBulkPolicyReorder(sections, rules, []BulkPlannedRuleIndex{
+		{SectionName: "Sec A", RuleName: "a1", IndexInSection: 1},
+		{SectionName: "Sec A", RuleName: "a2", IndexInSection: 2},
+	})
+	require.NoError(t, err)
+	require.Empty(t, previews)
+}
+
+func TestUnmanagedPolicyRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r2", RuleName: "a2", Index: 2, Enabled: true},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r1", RuleName: "a1", Index: 1},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r3", RuleName: "sys", Index: 3, IsSystem: true},
+		{SectionID: "", RuleID: "r4", RuleName: "sub", Index: 4},
+		{SectionID: "s2", SectionName: "Sec B", RuleID: "r5", RuleName: "b1", Index: 5, Enabled: true},
+		{SectionID: "s2", SectionName: "Sec B", RuleID: "r6", RuleName: "sub-policy", Index: 6, Enabled: true, IsSubPolicyScope: true},
+	}
+
+	unmanaged := unmanagedPolicyRules(rules, []string{"b1"})
+	require.Equal(t, []string{"a1", "a2"}, bulkRuleRowNames(unmanaged))
+	require.Equal(t, []string{"a1", "a2"}, bulkRuleRowNames(pendingUnmanagedRules(unmanagedRulesDelete, unmanaged)))
+	require.Equal(t, []string{"a2"}, bulkRuleRowNames(pendingUnmanagedRules(unmanagedRulesDisable, unmanaged)))
+	require.Empty(t, unmanagedPolicyRules(rules, []string{"a1", "a2", "b1"}))
+
+	require.True(t, unmanagedRuleNamesValue(types.StringNull(), unmanaged).IsNull())
+	names := unmanagedRuleNamesValue(types.StringValue(unmanagedRulesWarn), nil)
+	require.False(t, names.IsNull())
+	require.Empty(t, names.Elements())
+}
+
+func TestFlagSubPolicyScopeRules(t *testing.T) {
+	t.Parallel()
+
+	rules := flagSubPolicyScopeRules([]BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r1", RuleName: "a1", Index: 1},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r2", RuleName: "sub-policy", Index: 2},
+	}, map[string]struct{}{"r2": {}})
+	require.False(t, rules[0].IsSubPolicyScope)
+	require.True(t, rules[1].IsSubPolicyScope)
+	require.Equal(t, []string{"a1"}, bulkRuleRowNames(unmanagedPolicyRules(rules, nil)))
+}
+
+func TestApplyUnmanagedRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unmanaged := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r1", RuleName: "a1", Index: 1, Enabled: true},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r2", RuleName: "a2", Index: 2},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r3", RuleName: "a3", Index: 3, Enabled: true},
+	}
+	var disabled, removed []string
+	disable := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		if rule.RuleID == "r3" {
+			diags.AddError("API Error Disabling Rule", "boom")
+			return
+		}
+		disabled = append(disabled, rule.RuleID)
+	}
+	remove := func(rule BulkPolicyRuleRow, _ *diag.Diagnostics) { removed = append(removed, rule.RuleID) }
+	discards := 0
+	discard := func(_ *diag.Diagnostics) { discards++ }
+	listNames := func(value types.List) []string {
+		var names []string
+		require.False(t, value.ElementsAs(ctx, &names, false).HasError())
+		return names
+	}
+
+	var diags diag.Diagnostics
+	names := applyUnmanagedRules(ctx, types.StringValue(unmanagedRulesIgnore), "internet firewall", unmanaged, disable, remove, discard, &diags)
+	require.Empty(t, diags)
+	require.Equal(t, []string{"a1", "a2", "a3"}, listNames(names))
+
+	names = applyUnmanagedRules(ctx, types.StringValue(unmanagedRulesWarn), "internet firewall", unmanaged, disable, remove, discard, &diags)
+	require.Equal(t, 1, diags.WarningsCount())
+	require.Contains(t, diags[0].Detail(), `rule "a2" in "Sec A"`)
+	require.Equal(t, []string{"a1", "a2", "a3"}, listNames(names))
+
+	diags = nil
+	names = applyUnmanagedRules(ctx, types.StringValue(unmanagedRulesDisable), "internet firewall", unmanaged, disable, remove, discard, &diags)
+	require.True(t, diags.HasError())
+	require.Equal(t, []string{"r1"}, disabled)
+	require.Equal(t, 1, discards, "the rules disabled before the failing one are discarded")
+	require.Equal(t, []string{"a1", "a3"}, listNames(names), "the discarded rules are left pending")
+
+	diags = nil
+	names = applyUnmanagedRules(ctx, types.StringValue(unmanagedRulesDelete), "internet firewall", unmanaged, disable, remove, discard, &diags)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"r1", "r2", "r3"}, removed)
+	require.Empty(t, listNames(names))
+	require.Equal(t, 1, discards)
+
+	require.True(t, applyUnmanagedRules(ctx, types.StringNull(), "internet firewall", unmanaged, disable, remove, discard, &diags).IsNull())
+}
+
+func bulkRuleRowNames(rules []BulkPolicyRuleRow) []string {
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.RuleName)
+	}
+	return names
+}
+
+func TestCurrentBulkRuleIndexes(t *testing.T) {
+	t.Parallel()
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "A"}, {ID: "s2", Name: "B"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "A", RuleID: "sys", RuleName: "System", Index: 1, IsSystem: true},
+		{SectionID: "s1", SectionName: "A", RuleID: "r1", RuleName: "one", Index: 2},
+		{SectionID: "s1", SectionName: "A", RuleID: "r2", RuleName: "two", Index: 3},
+		{SectionID: "s2", SectionName: "B", RuleID: "r3", RuleName: "three", Index: 4},
+	}
+
+	inOrder := []BulkPlannedRuleIndex{
+		{SectionName: "A", RuleName: "one", IndexInSection: 1},
+		{SectionName: "A", RuleName: "two", IndexInSection: 2},
+		{SectionName: "B", RuleName: "three", IndexInSection: 1},
+	}
+	current, err := currentBulkRuleIndexes(sections, rules, inOrder)
+	require.NoError(t, err)
+	require.Equal(t, inOrder, current)
+
+	pending := []BulkPlannedRuleIndex{
+		{SectionName: "A", RuleName: "two", IndexInSection: 1},
+		{SectionName: "B", RuleName: "one", IndexInSection: 1},
+		{SectionName: "B", RuleName: "three", IndexInSection: 2},
+	}
+	current, err = currentBulkRuleIndexes(sections, rules, pending)
+	require.NoError(t, err)
+	require.Equal(t, []BulkPlannedRuleIndex{
+		{SectionName: "A", RuleName: "two", IndexInSection: 2},
+		{SectionName: "A", RuleName: "one", IndexInSection: 1},
+		{SectionName: "B", RuleName: "three", IndexInSection: 1},
+	}, current)
+}
+
+func TestCurrentBulkSectionIndexes(t *testing.T) {
+	t.Parallel()
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "A"}, {ID: "s2", Name: "Other"}, {ID: "s3", Name: "B"}}
+
+	planned := map[string]int64{"A": 10, "B": 20, "New": 30}
+	require.Equal(t, planned, currentBulkSectionIndexes(sections, planned))
+
+	planned = map[string]int64{"B": 1, "A": 2, "New": 3}
+	require.Equal(t, map[string]int64{"A": 1, "B": 2, "New": 3}, currentBulkSectionIndexes(sections, planned))
+}
+
+func TestDryRunBulkRuleOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "A"}, {ID: "s2", Name: "B"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "A", RuleID: "r1", RuleName: "one", Index: 1},
+		{SectionID: "s1", SectionName: "A", RuleID: "r2", RuleName: "two", Index: 2},
+	}
+	rule := func(name string, index int64) attr.Value {
+		return types.ObjectValueMust(TLSRuleIndexResourceAttrTypes, map[string]attr.Value{
+			"id":               types.StringValue("id-" + name),
+			"index_in_section": types.Int64Value(index),
+			"section_name":     types.StringValue("A"),
+			"rule_name":        types.StringValue(name),
+			"description":      types.StringValue(""),
+			"enabled":          types.BoolValue(true),
+		})
+	}
+	section := func(name string, index int64) attr.Value {
+		return types.ObjectValueMust(TLSSectionIndexResourceAttrTypes, map[string]attr.Value{
+			"id":            types.StringValue(name),
+			"section_name":  types.StringValue(name),
+			"section_index": types.Int64Value(index),
+		})
+	}
+	sectionData := types.MapValueMust(TLSSectionIndexResourceObjectTypes, map[string]attr.Value{
+		"B": section("B", 1),
+		"A": section("A", 2),
+	})
+	var diags diag.Diagnostics
+
+	// the dry run planned "two" first, the policy still has "one" first
+	ruleData := types.MapValueMust(TLSRuleIndexResourceObjectTypes, map[string]attr.Value{
+		"two": rule("two", 1),
+		"one": rule("one", 2),
+	})
+	gotRules, gotSections := dryRunBulkRuleOrder(ctx, ruleData, sectionData, sections, rules, &diags)
+	require.False(t, diags.HasError())
+	require.True(t, gotRules.Equal(types.MapValueMust(TLSRuleIndexResourceObjectTypes, map[string]attr.Value{
+		"two": rule("two", 2),
+		"one": rule("one", 1),
+	})))
+	require.True(t, gotSections.Equal(types.MapValueMust(TLSSectionIndexResourceObjectTypes, map[string]attr.Value{
+		"B": section("B", 2),
+		"A": section("A", 1),
+	})))
+
+	// nothing left to move, the state is kept
+	ruleData = types.MapValueMust(TLSRuleIndexResourceObjectTypes, map[string]attr.Value{
+		"one": rule("one", 1),
+		"two": rule("two", 2),
+	})
+	gotRules, _ = dryRunBulkRuleOrder(ctx, ruleData, sectionData, sections, rules, &diags)
+	require.False(t, diags.HasError())
+	require.True(t, gotRules.Equal(ruleData))
+}
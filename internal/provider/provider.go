@@ -6,12 +6,13 @@ import (
 	"net/http"
 	"os"
 	"strconv"
-	"sync/atomic"
+	"sync"
 	"time"
 
 	cato "github.com/catonetworks/cato-go-sdk"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,7 +22,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &catoProvider{}
+	_ provider.Provider                       = &catoProvider{}
+	_ provider.ProviderWithEphemeralResources = &catoProvider{}
 )
 
 const (
@@ -40,8 +42,9 @@ func New(version string) func() provider.Provider {
 }
 
 type catoProvider struct {
-	version            string
-	hasBeenInitialized atomic.Bool
+	version       string
+	draftsCleaned sync.Map
+	policyLocks   sync.Map
 }
 
 type catoProviderModel struct {
@@ -60,6 +63,8 @@ type catoClientData struct {
 	AccountId            string //nolint:revive // Shared client field used across provider resources.
 	catov2               *cato.Client
 	accountSnapshotCache *accountSnapshotCache
+	draftsCleaned        *sync.Map // accounts whose stale policy drafts were already discarded
+	policyLocks          *sync.Map // per account and policy, see lockPolicy
 }
 
 func (p *catoClientData) V2() *cato.Client  { return p.catov2 }
@@ -316,13 +321,16 @@ func (p *catoProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		AccountId:            accountID,
 		catov2:               catoClient,
 		accountSnapshotCache: newAccountSnapshotCache(),
+		draftsCleaned:        &p.draftsCleaned,
+		policyLocks:          &p.policyLocks,
 	}
 
 	resp.DataSourceData = dataSourceData
 	resp.ResourceData = dataSourceData
+	resp.EphemeralResourceData = dataSourceData
 
 	// cleanup stale rules
-	p.cleanupDrafts(ctx, dataSourceData)
+	cleanupDrafts(ctx, dataSourceData)
 }
 
 func int64FromEnv(key string) (*int64, error) {
@@ -378,14 +386,30 @@ func buildRetryHTTPClient(retryConfig *retryClientConfig) *http.Client {
 	return retryClient.StandardClient()
 }
 
-func (p *catoProvider) cleanupDrafts(ctx context.Context, d *catoClientData) {
-	if os.Getenv("DISABLE_POLICY_RULE_CLEANUP") == "true" || p.hasBeenInitialized.Load() {
+// lockPolicy serializes the draft and publish sequences on one policy of the account within this
+// provider process, so that a resource does not publish the half-applied draft of another. It
+// returns the unlock function.
+func (d *catoClientData) lockPolicy(policy string) func() {
+	if d == nil || d.policyLocks == nil {
+		return func() {}
+	}
+	lock, _ := d.policyLocks.LoadOrStore(d.AccountId+"/"+policy, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// cleanupDrafts discards a stale private-access policy draft, once per account per provider process
+func cleanupDrafts(ctx context.Context, d *catoClientData) {
+	if os.Getenv("DISABLE_POLICY_RULE_CLEANUP") == "true" || d.draftsCleaned == nil {
+		return
+	}
+	if _, cleaned := d.draftsCleaned.LoadOrStore(d.AccountId, true); cleaned {
 		return
 	}
-	p.hasBeenInitialized.Store(true)
 	resp, err := d.catov2.PolicyPrivateAccessDiscardRevision(ctx, d.AccountId)
 	if err != nil {
-		tflog.Error(ctx, "failed to discard draft private-access policy", map[string]any{"err": err})
+		tflog.Error(ctx, "failed to discard draft private-access policy", map[string]any{"err": err, "account_id": d.AccountId})
 		return
 	}
 	errors := resp.GetPolicy().GetPrivateAccess().DiscardPolicyRevision.Errors
@@ -393,7 +417,7 @@ func (p *catoProvider) cleanupDrafts(ctx context.Context, d *catoClientData) {
 		if errors[0].ErrorCode != nil && *errors[0].ErrorCode == policyRevisionNotFound {
 			return // no policy draft to discard; OK
 		}
-		tflog.Error(ctx, "failed to discard draft private-access policy", map[string]any{"errors": errors})
+		tflog.Error(ctx, "failed to discard draft private-access policy", map[string]any{"errors": errors, "account_id": d.AccountId})
 	}
 }
 
@@ -414,6 +438,19 @@ func (p *catoProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		NetworkRangesDataSource,
 		HostDataSource,
 		AppConnectorGroupDataSource,
+		BgpPeerStatusDataSource,
+		ExpiringRulesDataSource,
+		PolicyAnalysisDataSource,
+		UsersDataSource,
+		DlpDataTypesDataSource,
+		AdminRolesDataSource,
+	}
+}
+
+func (p *catoProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTemporaryAPIKeyEphemeralResource,
+		NewAppConnectorTokenEphemeralResource,
 	}
 }
 
@@ -434,7 +471,9 @@ func (p *catoProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewSiteIpsecResource,
 		NewSocketSiteResource,
 		NewStaticHostResource,
+		NewSiteStaticRouteResource,
 		NewTLSInspectionRuleResource,
+		NewTLSSubPolicyResource,
 		NewTLSInspectionSectionResource,
 		NewWanFwRuleResource,
 		NewWanFwSectionResource,
@@ -442,6 +481,8 @@ func (p *catoProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewWanInterfaceResource,
 		NewWanNetworkRuleResource,
 		NewWanNetworkSectionResource,
+		NewWnwSubPolicyResource,
+		NewSubPolicyTemplateResource,
 		NewIfwRulesIndexResource,
 		NewWanRulesIndexResource,
 		NewWanNetworkRulesIndexResource,
@@ -462,5 +503,25 @@ func (p *catoProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewGlobalIPRangesResource,
 		NewLfSubPolicyResource,
 		NewLanRulesIndexResource,
+		NewSdpUserResource,
+		NewCustomAppResource,
+		NewCustomCategoryResource,
+		NewDevicePostureCheckResource,
+		NewDevicePostureProfileResource,
+		NewDlpContentProfileResource,
+		NewDlpEdmProfileResource,
+		NewMailingListResource,
+		NewWebhookResource,
+		NewSubscriptionGroupResource,
+		NewAdminRoleResource,
+		NewAPIKeyResource,
+		NewLicensePoolAllocationResource,
+		NewPrivateAppsBulkResource,
+		NewIfPolicyResource,
+		NewWfPolicyResource,
+		NewTLSPolicyResource,
+		NewWnwPolicyResource,
+		NewLfPolicyResource,
+		NewIfPolicyDocumentResource,
 	}
 }
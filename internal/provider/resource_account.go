@@ -33,6 +33,8 @@ type accountResource struct {
 	client *catoClientData
 }
 
+func (r *accountResource) clientData() **catoClientData { return &r.client }
+
 func (r *accountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_account"
 }
@@ -94,6 +96,7 @@ func (r *accountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -107,12 +110,17 @@ func (r *accountResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 //nolint:gocyclo
 func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan Account
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -197,6 +205,10 @@ func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state Account
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -237,6 +249,10 @@ func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 //nolint:funlen
 func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan Account
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -352,6 +368,9 @@ func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state Account
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
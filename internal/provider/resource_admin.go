@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
 	"github.com/catonetworks/terraform-provider-cato/internal/utils"
 )
 
@@ -108,7 +109,7 @@ func (r *adminResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
-							Description: "Role ID",
+							Description: "Role ID, of a predefined role or of a `cato_admin_role`",
 							Required:    true,
 						},
 						"name": schema.StringAttribute{
@@ -129,7 +130,7 @@ func (r *adminResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
-							Description: "Role ID",
+							Description: "Role ID, of a predefined role or of a `cato_admin_role`",
 							Required:    true,
 						},
 						"name": schema.StringAttribute{
@@ -219,7 +220,7 @@ func (r *adminResource) Create(ctx context.Context, req resource.CreateRequest,
 				managedRolesInput = append(managedRolesInput, &cato_models.UpdateAdminRoleInput{
 					Role: &cato_models.UpdateAccountRoleInput{
 						ID:   role.ID.ValueString(),
-						Name: role.Name.ValueStringPointer(),
+						Name: parse.KnownStringPointer(role.Name), // unknown for roles created in the same apply
 					},
 				})
 			}
@@ -244,7 +245,7 @@ func (r *adminResource) Create(ctx context.Context, req resource.CreateRequest,
 				roleInput := &cato_models.UpdateAdminRoleInput{
 					Role: &cato_models.UpdateAccountRoleInput{
 						ID:   role.ID.ValueString(),
-						Name: role.Name.ValueStringPointer(),
+						Name: parse.KnownStringPointer(role.Name), // unknown for roles created in the same apply
 					},
 				}
 
@@ -713,7 +714,7 @@ func (r *adminResource) Update(ctx context.Context, req resource.UpdateRequest,
 				managedRolesInput = append(managedRolesInput, &cato_models.UpdateAdminRoleInput{
 					Role: &cato_models.UpdateAccountRoleInput{
 						ID:   role.ID.ValueString(),
-						Name: role.Name.ValueStringPointer(),
+						Name: parse.KnownStringPointer(role.Name), // unknown for roles created in the same apply
 					},
 				})
 			}
@@ -738,7 +739,7 @@ func (r *adminResource) Update(ctx context.Context, req resource.UpdateRequest,
 				roleInput := &cato_models.UpdateAdminRoleInput{
 					Role: &cato_models.UpdateAccountRoleInput{
 						ID:   role.ID.ValueString(),
-						Name: role.Name.ValueStringPointer(),
+						Name: parse.KnownStringPointer(role.Name), // unknown for roles created in the same apply
 					},
 				}
 
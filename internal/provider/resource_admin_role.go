@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &adminRoleResource{}
+	_ resource.ResourceWithConfigure   = &adminRoleResource{}
+	_ resource.ResourceWithImportState = &adminRoleResource{}
+)
+
+var ErrAdminRoleNotFound = errors.New("admin role not found")
+
+type (
+	accountRole           = cato_go_sdk.AccountRoles_AccountRoles_Items
+	accountRolePermission = cato_go_sdk.AccountRoles_AccountRoles_Items_Permissions
+)
+
+func NewAdminRoleResource() resource.Resource {
+	return &adminRoleResource{}
+}
+
+type adminRoleResource struct {
+	client *catoClientData
+}
+
+func (r *adminRoleResource) clientData() **catoClientData { return &r.client }
+
+func (r *adminRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_role"
+}
+
+func (r *adminRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_admin_role` resource contains the configuration parameters necessary to manage " +
+			"a custom admin role. The role grants view or edit access per area (policies, sites, users, ...); " +
+			"areas without a permission are not accessible. Roles are assigned to admins by `id` in the " +
+			"`managed_roles` attribute of `cato_admin`.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "Optional description of the admin role",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the admin role",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the admin role",
+				Required:    true,
+			},
+			"permissions": schema.SetNestedAttribute{
+				Description: "Permissions of the role, one per area",
+				Required:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"access": schema.StringAttribute{
+							Description: "Access level to the area (VIEW or EDIT)",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(cato_models.AccountRoleAccessView), string(cato_models.AccountRoleAccessEdit)),
+							},
+						},
+						"area": schema.StringAttribute{
+							Description: "Area of the permission (e.g. INTERNET_FIREWALL, WAN_FIREWALL, SITES, USERS, ADMINS)",
+							Required:    true,
+							Validators: []validator.String{
+								validators.AdminRoleAreaValidator{},
+							},
+						},
+					},
+				},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *adminRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *adminRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new admin role
+func (r *adminRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan AdminRoleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := r.prepareRoleInput(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new admin role
+	tflog.Debug(ctx, "AdminAddRole", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.AdminAddRole(ctx, input, r.client.AccountId)
+	tflog.Debug(ctx, "AdminAddRole", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API AdminAddRole error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetAdmin().GetAddRole().GetRole().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateAdminRoleState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating admin role state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the admin role
+func (r *adminRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state AdminRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateAdminRoleState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the admin role was found
+		if errors.Is(hydrateErr, ErrAdminRoleNotFound) {
+			tflog.Warn(ctx, "admin role not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating admin role state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the admin role, the permissions are replaced as a whole
+func (r *adminRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state AdminRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("AdminUpdateRole: ID is unknown", "Admin role ID is not set in TF state")
+		return
+	}
+
+	input := r.prepareRoleInput(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "AdminUpdateRole", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.AdminUpdateRole(ctx, id, input, r.client.AccountId)
+	tflog.Debug(ctx, "AdminUpdateRole", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API AdminUpdateRole error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateAdminRoleState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating admin role state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the admin role
+func (r *adminRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state AdminRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to delete the admin role
+	tflog.Debug(ctx, "AdminRemoveRole", map[string]interface{}{"id": state.ID.ValueString()})
+	result, err := r.client.catov2.AdminRemoveRole(ctx, state.ID.ValueString(), r.client.AccountId)
+	tflog.Debug(ctx, "AdminRemoveRole", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API AdminRemoveRole error", err.Error())
+		return
+	}
+}
+
+// hydrateAdminRoleState fetches the current state of an admin role from the API
+func (r *adminRoleResource) hydrateAdminRoleState(ctx context.Context, roleID string) (*AdminRoleModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	// Call Cato API to get the admin role
+	tflog.Debug(ctx, "AccountRoles", map[string]any{"id": roleID})
+	result, err := r.client.catov2.AccountRoles(ctx, r.client.AccountId, []string{roleID})
+	tflog.Debug(ctx, "AccountRoles", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to AdminRoleModel
+	var role *accountRole
+	for _, item := range result.GetAccountRoles().GetItems() {
+		if item != nil && item.ID == roleID {
+			role = item
+			break
+		}
+	}
+	if role == nil {
+		return nil, diags, ErrAdminRoleNotFound
+	}
+
+	state := &AdminRoleModel{
+		Description: types.StringPointerValue(role.Description),
+		ID:          types.StringValue(role.ID),
+		Name:        types.StringValue(role.Name),
+		Permissions: adminRolePermissions(ctx, role.Permissions, &diags),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+func (r *adminRoleResource) prepareRoleInput(ctx context.Context, plan AdminRoleModel, diags *diag.Diagnostics,
+) cato_models.AccountRoleSettingsInput {
+	input := cato_models.AccountRoleSettingsInput{
+		Description: parse.KnownStringPointer(plan.Description),
+		Name:        plan.Name.ValueString(),
+	}
+
+	var tfPermissions []AdminRolePermission
+	if utils.CheckErr(diags, plan.Permissions.ElementsAs(ctx, &tfPermissions, false)) {
+		return input
+	}
+
+	seen := make(map[string]struct{}, len(tfPermissions))
+	for _, p := range tfPermissions {
+		area := p.Area.ValueString()
+		if _, ok := seen[area]; ok {
+			diags.AddAttributeError(path.Root("permissions"), "Invalid admin role permissions",
+				"area "+area+" is set more than once")
+			return input
+		}
+		seen[area] = struct{}{}
+		input.Permissions = append(input.Permissions, &cato_models.AccountRolePermissionInput{
+			Access: cato_models.AccountRoleAccess(p.Access.ValueString()),
+			Area:   cato_models.AccountRolePermissionArea(area),
+		})
+	}
+	return input
+}
+
+// adminRolePermissions converts the API permissions to a set, shared with the cato_admin_roles data source
+func adminRolePermissions(ctx context.Context, permissions []*accountRolePermission, diags *diag.Diagnostics) types.Set {
+	elemType := types.ObjectType{AttrTypes: AdminRolePermissionTypes}
+	values := make([]attr.Value, 0, len(permissions))
+	for _, p := range permissions {
+		if p == nil {
+			continue
+		}
+		obj, objDiags := types.ObjectValue(AdminRolePermissionTypes, map[string]attr.Value{
+			"access": types.StringValue(p.Access.String()),
+			"area":   types.StringValue(p.Area.String()),
+		})
+		if utils.CheckErr(diags, objDiags) {
+			return types.SetNull(elemType)
+		}
+		values = append(values, obj)
+	}
+	set, setDiags := types.SetValue(elemType, values)
+	diags.Append(setDiags...)
+	return set
+}
@@ -0,0 +1,527 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &apiKeyResource{}
+	_ resource.ResourceWithConfigure   = &apiKeyResource{}
+	_ resource.ResourceWithImportState = &apiKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &apiKeyResource{}
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("API key not found")
+
+	// apiKeyNow is the clock deciding key rotations; tests replace it
+	apiKeyNow = time.Now
+
+	apiKeyAllowedIPRE = regexp.MustCompile(`^(\d{1,3}\.){3}\d{1,3}(/\d{1,2})?$`)
+)
+
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+type apiKeyResource struct {
+	client *catoClientData
+}
+
+func (r *apiKeyResource) clientData() **catoClientData { return &r.client }
+
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_api_key` resource contains the configuration parameters necessary to manage an API key. " +
+			"The key secret is returned by the API only when the key is created. It is not stored in the state unless " +
+			"`store_secret` is set, in which case it is available in the sensitive `secret` attribute and is never refreshed. " +
+			"With `rotation_days` the key is rotated: a new key is created, the old one is deleted and the new key takes its name, " +
+			"on the first apply after the rotation time. Use the `cato_temporary_api_key` ephemeral resource " +
+			"for short-lived keys that are never stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"allowed_ips": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "IP addresses or subnets (CIDR) allowed to use the key; any source IP is allowed when not set",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(apiKeyAllowedIPRE, "must be an IPv4 address or subnet")),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time of the key (RFC3339)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the API key",
+				Optional:    true,
+			},
+			"expiration_days": schema.Int64Attribute{
+				Description: "Number of days after creation the key expires; the key does not expire when not set",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiration time of the key (RFC3339)",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the API key",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the API key",
+				Required:    true,
+			},
+			"role": schema.SingleNestedAttribute{
+				Description:   "Admin role granted to the key (predefined or `cato_admin_role`)",
+				Required:      true,
+				Attributes:    parse.SchemaNameID("Role"),
+				PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+			},
+			"rotate_at": schema.StringAttribute{
+				Description: "Time (RFC3339) after which the next apply rotates the key, set when `rotation_days` is configured",
+				Computed:    true,
+			},
+			"rotation_days": schema.Int64Attribute{
+				Description: "Rotate the key this number of days after its creation; must be lower than `expiration_days`",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The API key secret, set when the key is created and `store_secret` is set; null otherwise",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"store_secret": schema.BoolAttribute{
+				Description: "Store the key secret in the state, in the sensitive `secret` attribute (default false). " +
+					"The API returns the secret only for a new key, so setting it on an existing key rotates the key.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan computes the rotation and expiration times and plans the rotation of the key when it is due.
+// A rotated key gets a new ID, secret and times, which Update sets.
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() { // resource destruction
+		return
+	}
+
+	var plan APIKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if utils.HasValue(plan.RotationDays) && utils.HasValue(plan.ExpirationDays) &&
+		plan.RotationDays.ValueInt64() >= plan.ExpirationDays.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("rotation_days"), "Invalid API key rotation",
+			"rotation_days must be lower than expiration_days, so that the key is rotated before it expires")
+		return
+	}
+
+	if !plan.StoreSecret.ValueBool() {
+		plan.Secret = types.StringNull()
+	}
+	if req.State.Raw.IsNull() { // new key, the times are known after apply
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+	var state APIKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	plan.RotateAt = apiKeyTimeAfterDays(createdAt, plan.RotationDays)
+	// the expiration set on create may differ slightly from created_at, it is recomputed only when changed
+	plan.ExpiresAt = state.ExpiresAt
+	if !plan.ExpirationDays.Equal(state.ExpirationDays) {
+		plan.ExpiresAt = apiKeyTimeAfterDays(createdAt, plan.ExpirationDays)
+	}
+
+	rotate := apiKeyRotationDue(createdAt, plan.RotationDays, apiKeyNow())
+	if plan.StoreSecret.ValueBool() && state.Secret.IsNull() {
+		tflog.Info(ctx, "API key secret is not known, the key is rotated", map[string]any{"id": state.ID.ValueString()})
+		rotate = true
+	}
+	if rotate {
+		tflog.Info(ctx, "API key rotation is due", map[string]any{"id": state.ID.ValueString()})
+		plan.ID = types.StringUnknown()
+		plan.CreatedAt = types.StringUnknown()
+		plan.ExpiresAt = types.StringUnknown()
+		plan.RotateAt = types.StringUnknown()
+		if plan.StoreSecret.ValueBool() {
+			plan.Secret = types.StringUnknown()
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create a new API key
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan APIKeyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := apiKeyCreateInput(ctx, plan, plan.Name.ValueString(), apiKeyNow(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new API key, the response holds the secret and is not logged
+	tflog.Debug(ctx, "APIKeyCreateAPIKey", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.APIKeyCreateAPIKey(ctx, r.client.AccountId, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyCreateAPIKey error", err.Error())
+		return
+	}
+
+	// Set the ID and the write-once secret from the response
+	apiKey := result.GetAPIKey().GetCreateAPIKey().GetAPIKey()
+	plan.ID = types.StringValue(apiKey.GetID())
+	if plan.StoreSecret.ValueBool() {
+		plan.Secret = types.StringValue(apiKey.GetSecret())
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateAPIKeyState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating API key state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the API key
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state APIKeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateAPIKeyState(ctx, state)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the API key was found
+		if errors.Is(hydrateErr, ErrAPIKeyNotFound) {
+			tflog.Warn(ctx, "API key not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating API key state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the API key, the secret is kept
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state APIKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("APIKeyUpdateAPIKey: ID is unknown", "API key ID is not set in TF state")
+		return
+	}
+	if plan.ID.IsUnknown() { // rotation planned by ModifyPlan
+		r.rotateAPIKey(ctx, plan, id, resp)
+		return
+	}
+
+	input := cato_models.UpdateAPIKeyInput{
+		AllowedIps:     parse.PrepareStrings[string](ctx, plan.AllowedIPs, &resp.Diagnostics),
+		Description:    parse.KnownStringPointer(plan.Description),
+		ExpirationDate: parse.KnownStringPointer(plan.ExpiresAt),
+		ID:             id,
+		Name:           parse.KnownStringPointer(plan.Name),
+		Role:           parse.PrepareIDRef[cato_models.AccountRoleRefInput](ctx, plan.Role, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "APIKeyUpdateAPIKey", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.APIKeyUpdateAPIKey(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "APIKeyUpdateAPIKey", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyUpdateAPIKey error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	if plan.StoreSecret.ValueBool() {
+		plan.Secret = state.Secret
+	}
+	hydratedState, diags, hydrateErr := r.hydrateAPIKeyState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating API key state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// rotateAPIKey replaces the key in place, so that a valid key exists at any time: the new key is created
+// under a derived name, as key names are unique, then the old key is deleted and the new key is renamed.
+func (r *apiKeyResource) rotateAPIKey(ctx context.Context, plan APIKeyModel, oldID string, resp *resource.UpdateResponse) {
+	now := apiKeyNow()
+	input := apiKeyCreateInput(ctx, plan, apiKeyRotationName(plan.Name.ValueString(), now), now, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "APIKeyCreateAPIKey", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.APIKeyCreateAPIKey(ctx, r.client.AccountId, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyCreateAPIKey error", err.Error())
+		return
+	}
+	apiKey := result.GetAPIKey().GetCreateAPIKey().GetAPIKey()
+	plan.ID = types.StringValue(apiKey.GetID())
+	plan.Secret = types.StringNull()
+	if plan.StoreSecret.ValueBool() {
+		plan.Secret = types.StringValue(apiKey.GetSecret())
+	}
+
+	if err := deleteAPIKey(ctx, r.client, oldID); err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyDeleteAPIKey error",
+			fmt.Sprintf("The key was rotated but the old key %s was not deleted, the new key keeps the name %q: %s", oldID, input.Name, err.Error()))
+	} else {
+		rename := cato_models.UpdateAPIKeyInput{
+			AllowedIps:     input.AllowedIps,
+			Description:    input.Description,
+			ExpirationDate: input.ExpirationDate,
+			ID:             plan.ID.ValueString(),
+			Name:           plan.Name.ValueStringPointer(),
+			Role:           input.Role,
+		}
+		tflog.Debug(ctx, "APIKeyUpdateAPIKey", map[string]interface{}{"request": utils.InterfaceToJSONString(rename)})
+		result, err := r.client.catov2.APIKeyUpdateAPIKey(ctx, r.client.AccountId, rename)
+		tflog.Debug(ctx, "APIKeyUpdateAPIKey", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		if err != nil {
+			resp.Diagnostics.AddError("Cato API APIKeyUpdateAPIKey error",
+				fmt.Sprintf("The key was rotated but keeps the name %q until the next apply: %s", input.Name, err.Error()))
+		}
+	}
+
+	// the new key is kept in state whatever failed after its creation
+	hydratedState, diags, hydrateErr := r.hydrateAPIKeyState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating API key state", hydrateErr.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &hydratedState)...)
+}
+
+// Delete the API key
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state APIKeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := deleteAPIKey(ctx, r.client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Cato API APIKeyDeleteAPIKey error", err.Error())
+		return
+	}
+}
+
+// hydrateAPIKeyState fetches the current state of an API key from the API;
+// the secret and the rotation settings are not known to the API and are kept from the prior state or plan
+func (r *apiKeyResource) hydrateAPIKeyState(ctx context.Context, prior APIKeyModel) (*APIKeyModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.APIKeyRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: prior.ID.ValueString(),
+	}
+
+	// Call Cato API to get the API key
+	tflog.Debug(ctx, "APIKeyReadAPIKey", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.APIKeyReadAPIKey(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "APIKeyReadAPIKey", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to APIKeyModel
+	apiKey := result.GetAPIKey().GetAPIKey()
+	if apiKey == nil {
+		return nil, diags, ErrAPIKeyNotFound
+	}
+
+	state := &APIKeyModel{
+		AllowedIPs:     parse.StringSetFunc(ctx, emptyToNil(apiKey.AllowedIps), func(s string) string { return s }, &diags),
+		CreatedAt:      types.StringValue(apiKey.CreatedAt),
+		Description:    types.StringPointerValue(apiKey.Description),
+		ExpirationDays: prior.ExpirationDays,
+		ExpiresAt:      types.StringPointerValue(apiKey.ExpirationDate),
+		ID:             types.StringValue(apiKey.ID),
+		Name:           types.StringValue(apiKey.Name),
+		Role:           parse.IDRef(ctx, apiKey.Role, &diags),
+		RotateAt:       types.StringNull(),
+		RotationDays:   prior.RotationDays,
+		Secret:         prior.Secret,
+		StoreSecret:    types.BoolValue(prior.StoreSecret.ValueBool()),
+	}
+	if createdAt, err := time.Parse(time.RFC3339, apiKey.CreatedAt); err == nil {
+		state.RotateAt = apiKeyTimeAfterDays(createdAt, prior.RotationDays)
+	}
+	if state.Secret.IsUnknown() || !state.StoreSecret.ValueBool() { // imported key, or the secret is not kept
+		state.Secret = types.StringNull()
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+// apiKeyCreateInput returns the input creating the key of the plan under the given name at time now
+func apiKeyCreateInput(ctx context.Context, plan APIKeyModel, name string, now time.Time, diags *diag.Diagnostics) cato_models.CreateAPIKeyInput {
+	return cato_models.CreateAPIKeyInput{
+		AllowedIps:     parse.PrepareStrings[string](ctx, plan.AllowedIPs, diags),
+		Description:    parse.KnownStringPointer(plan.Description),
+		ExpirationDate: apiKeyTimeAfterDays(now.UTC().Truncate(time.Second), plan.ExpirationDays).ValueStringPointer(),
+		Name:           name,
+		Role:           parse.PrepareIDRef[cato_models.AccountRoleRefInput](ctx, plan.Role, diags),
+	}
+}
+
+// apiKeyRotationName is the name of the new key of a rotation until the old key is deleted
+func apiKeyRotationName(name string, now time.Time) string {
+	return fmt.Sprintf("%s (rotated %s)", name, now.UTC().Format("20060102T150405Z"))
+}
+
+// deleteAPIKey deletes an API key, shared with the cato_temporary_api_key ephemeral resource
+func deleteAPIKey(ctx context.Context, client *catoClientData, id string) error {
+	input := cato_models.DeleteAPIKeyInput{
+		APIKey: &cato_models.APIKeyRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: id,
+		},
+	}
+
+	tflog.Debug(ctx, "APIKeyDeleteAPIKey", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := client.catov2.APIKeyDeleteAPIKey(ctx, client.AccountId, input)
+	tflog.Debug(ctx, "APIKeyDeleteAPIKey", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	return err
+}
+
+// apiKeyTimeAfterDays returns the RFC3339 time the given number of days after t, null when days is not set
+func apiKeyTimeAfterDays(t time.Time, days types.Int64) types.String {
+	if !utils.HasValue(days) {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().AddDate(0, 0, int(days.ValueInt64())).Format(time.RFC3339))
+}
+
+// apiKeyRotationDue returns true when the key created at createdAt must be rotated at time now
+func apiKeyRotationDue(createdAt time.Time, rotationDays types.Int64, now time.Time) bool {
+	if !utils.HasValue(rotationDays) {
+		return false
+	}
+	return !now.Before(createdAt.AddDate(0, 0, int(rotationDays.ValueInt64())))
+}
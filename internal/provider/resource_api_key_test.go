@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+)
+
+func TestAPIKeyTimeAfterDays(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 1, 30, 10, 20, 30, 0, time.FixedZone("CET", 3600))
+
+	require.True(t, apiKeyTimeAfterDays(createdAt, types.Int64Null()).IsNull())
+	require.Equal(t, "2026-03-01T09:20:30Z", apiKeyTimeAfterDays(createdAt, types.Int64Value(30)).ValueString())
+	require.Equal(t, "2026-01-31T09:20:30Z", apiKeyTimeAfterDays(createdAt, types.Int64Value(1)).ValueString())
+}
+
+func TestAPIKeyRotationDue(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		rotationDays types.Int64
+		now          time.Time
+		want         bool
+	}{
+		"no rotation":           {rotationDays: types.Int64Null(), now: createdAt.AddDate(10, 0, 0), want: false},
+		"before rotation":       {rotationDays: types.Int64Value(30), now: createdAt.AddDate(0, 0, 29), want: false},
+		"one second before":     {rotationDays: types.Int64Value(30), now: createdAt.AddDate(0, 0, 30).Add(-time.Second), want: false},
+		"exactly rotation time": {rotationDays: types.Int64Value(30), now: createdAt.AddDate(0, 0, 30), want: true},
+		"after rotation":        {rotationDays: types.Int64Value(30), now: createdAt.AddDate(0, 2, 0), want: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, apiKeyRotationDue(createdAt, tt.rotationDays, tt.now))
+		})
+	}
+}
+
+func apiKeyModifyPlan(t *testing.T, state, planned APIKeyModel) (*resource.ModifyPlanResponse, APIKeyModel) {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&apiKeyResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	priorState := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, priorState.Set(ctx, &state).HasError())
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.False(t, plan.Set(ctx, &planned).HasError())
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	(&apiKeyResource{}).ModifyPlan(ctx, resource.ModifyPlanRequest{State: priorState, Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var got APIKeyModel
+	require.False(t, resp.Plan.Get(ctx, &got).HasError())
+	return resp, got
+}
+
+func TestAPIKeyModifyPlanRotatesInPlace(t *testing.T) {
+	prev := apiKeyNow
+	apiKeyNow = func() time.Time { return time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { apiKeyNow = prev })
+
+	state := APIKeyModel{
+		AccountID:      types.StringNull(),
+		AllowedIPs:     types.SetNull(types.StringType),
+		CreatedAt:      types.StringValue("2026-01-01T00:00:00Z"),
+		Description:    types.StringNull(),
+		ExpirationDays: types.Int64Value(90),
+		ExpiresAt:      types.StringValue("2026-04-01T00:00:00Z"),
+		ID:             types.StringValue("key-1"),
+		Name:           types.StringValue("CI Pipeline"),
+		Role: types.ObjectValueMust(parse.IDNameRefModelTypes, map[string]attr.Value{
+			"id":   types.StringValue("role-1"),
+			"name": types.StringValue("Editor"),
+		}),
+		RotateAt:     types.StringValue("2026-03-02T00:00:00Z"),
+		RotationDays: types.Int64Value(60),
+		Secret:       types.StringValue("old-secret"),
+		StoreSecret:  types.BoolValue(true),
+	}
+
+	resp, plan := apiKeyModifyPlan(t, state, state)
+	require.Empty(t, resp.RequiresReplace)
+	require.True(t, plan.ID.IsUnknown())
+	require.True(t, plan.Secret.IsUnknown())
+	require.True(t, plan.CreatedAt.IsUnknown())
+	require.True(t, plan.RotateAt.IsUnknown())
+	require.Equal(t, "CI Pipeline", plan.Name.ValueString())
+
+	// not due yet: the key and its secret are kept
+	state.RotationDays = types.Int64Value(80)
+	state.RotateAt = types.StringValue("2026-03-22T00:00:00Z")
+	resp, plan = apiKeyModifyPlan(t, state, state)
+	require.Empty(t, resp.RequiresReplace)
+	require.Equal(t, "key-1", plan.ID.ValueString())
+	require.Equal(t, "old-secret", plan.Secret.ValueString())
+	require.Equal(t, "2026-03-22T00:00:00Z", plan.RotateAt.ValueString())
+}
+
+func TestAPIKeyRotationName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "CI Pipeline (rotated 20260305T101500Z)",
+		apiKeyRotationName("CI Pipeline", time.Date(2026, 3, 5, 11, 15, 0, 0, time.FixedZone("CET", 3600))))
+}
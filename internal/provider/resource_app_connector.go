@@ -43,6 +43,8 @@ type appConnectorResource struct {
 	client *catoClientData
 }
 
+func (r *appConnectorResource) clientData() **catoClientData { return &r.client }
+
 func (r *appConnectorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_app_connector"
 }
@@ -93,6 +95,7 @@ func (r *appConnectorResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:    true,
 				Validators:  []validator.String{validators.AppConTypeValidator{}},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -164,12 +167,17 @@ func (r *appConnectorResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *appConnectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 // Create a new app connector
 func (r *appConnectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan AppConnectorModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -215,6 +223,10 @@ func (r *appConnectorResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read app connector data from Cato API
 func (r *appConnectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state AppConnectorModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -244,6 +256,10 @@ func (r *appConnectorResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update app connector configuration
 func (r *appConnectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan AppConnectorModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -297,6 +313,9 @@ func (r *appConnectorResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete app connector
 func (r *appConnectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state AppConnectorModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &appTenantRestrictionRuleResource{}
 	_ resource.ResourceWithConfigure   = &appTenantRestrictionRuleResource{}
 	_ resource.ResourceWithImportState = &appTenantRestrictionRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &appTenantRestrictionRuleResource{}
 )
 
 func NewAppTenantRestrictionRuleResource() resource.Resource {
@@ -38,6 +39,8 @@ type appTenantRestrictionRuleResource struct {
 	client *catoClientData
 }
 
+func (r *appTenantRestrictionRuleResource) clientData() **catoClientData { return &r.client }
+
 func (r *appTenantRestrictionRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_app_tenant_restriction_rule"
 }
@@ -201,6 +204,8 @@ func (r *appTenantRestrictionRuleResource) Schema(_ context.Context, _ resource.
 					},
 				},
 			},
+			"account_id":       accountIDOverrideAttribute(),
+			"lifecycle_window": lifecycleWindowAttribute(),
 		},
 	}
 }
@@ -213,16 +218,37 @@ func (r *appTenantRestrictionRuleResource) Configure(_ context.Context, req reso
 }
 
 func (r *appTenantRestrictionRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("rule").AtName("id"), req, resp)
 }
 
+func (r *appTenantRestrictionRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLifecycleWindowPlan(ctx, req, resp)
+}
+
 //nolint:gocyclo,funlen
 func (r *appTenantRestrictionRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan AppTenantRestrictionRule
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	input, diags := hydrateAppTenantRestrictionAddRuleInput(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -302,14 +328,23 @@ func (r *appTenantRestrictionRuleResource) Create(ctx context.Context, req resou
 	plan.ID = types.StringValue(newID)
 	plan.Rule = ruleObj
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 func (r *appTenantRestrictionRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state AppTenantRestrictionRule
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
+	enabled := ruleEnabled(state.Rule)
 
 	body, err := r.client.catov2.AppTenantRestrictionPolicy(ctx, r.client.AccountId)
 	if err != nil {
@@ -367,15 +402,32 @@ func (r *appTenantRestrictionRuleResource) Read(ctx context.Context, req resourc
 	state.ID = types.StringValue(cur.GetID())
 	state.Rule = ruleObj
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	keepLifecycleWindowEnabled(ctx, state.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 //nolint:gocyclo,funlen
 func (r *appTenantRestrictionRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan AppTenantRestrictionRule
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		removeLifecycleWindowExpiredRule(ctx, req, resp, r.Delete)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	move := cato_models.PolicyMoveRuleInput{}
 	atAsOpts := basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
@@ -482,19 +534,27 @@ func (r *appTenantRestrictionRuleResource) Update(ctx context.Context, req resou
 	}
 
 	st := AppTenantRestrictionRule{
-		ID:   types.StringValue(rule.ID.ValueString()),
-		At:   atObj,
-		Rule: ruleObj,
+		ID:              types.StringValue(rule.ID.ValueString()),
+		At:              atObj,
+		Rule:            ruleObj,
+		LifecycleWindow: plan.LifecycleWindow,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, st)...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 func (r *appTenantRestrictionRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state AppTenantRestrictionRule
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 	rule := AppTenantRestrictionRuleRulePlan{}
 	resp.Diagnostics.Append(state.Rule.As(ctx, &rule, basetypes.ObjectAsOptions{})...)
 	if resp.Diagnostics.HasError() {
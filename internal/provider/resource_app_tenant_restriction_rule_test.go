@@ -68,8 +68,9 @@ func TestAppTenantRestrictionRuleImportState(t *testing.T) {
 		State: tfsdk.State{Schema: getAppTenantRestrictionRuleSchema(ctx, t)},
 	}
 	diags := resp.State.Set(ctx, AppTenantRestrictionRule{
-		Rule: types.ObjectNull(AppTenantRestrictionRuleRuleAttrTypes),
-		At:   types.ObjectNull(PositionAttrTypes),
+		Rule:            types.ObjectNull(AppTenantRestrictionRuleRuleAttrTypes),
+		At:              types.ObjectNull(PositionAttrTypes),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	})
 	if diags.HasError() {
 		t.Fatalf("unexpected seed state diagnostics: %+v", diags)
@@ -35,6 +35,8 @@ type appTenantRestrictionSectionResource struct {
 	client *catoClientData
 }
 
+func (r *appTenantRestrictionSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *appTenantRestrictionSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_app_tenant_restriction_section"
 }
@@ -89,6 +91,7 @@ func (r *appTenantRestrictionSectionResource) Schema(_ context.Context, _ resour
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -101,10 +104,15 @@ func (r *appTenantRestrictionSectionResource) Configure(_ context.Context, req r
 }
 
 func (r *appTenantRestrictionSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 func (r *appTenantRestrictionSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan AppTenantRestrictionSection
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -163,6 +171,10 @@ func (r *appTenantRestrictionSectionResource) Create(ctx context.Context, req re
 }
 
 func (r *appTenantRestrictionSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state AppTenantRestrictionSection
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -227,6 +239,10 @@ func (r *appTenantRestrictionSectionResource) Read(ctx context.Context, req reso
 
 //nolint:gocyclo
 func (r *appTenantRestrictionSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan AppTenantRestrictionSection
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -294,6 +310,9 @@ func (r *appTenantRestrictionSectionResource) Update(ctx context.Context, req re
 }
 
 func (r *appTenantRestrictionSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state AppTenantRestrictionSection
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -30,6 +30,8 @@ type applicationControlPolicyResource struct {
 	client *catoClientData
 }
 
+func (r *applicationControlPolicyResource) clientData() **catoClientData { return &r.client }
+
 func (r *applicationControlPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_control_policy"
 }
@@ -73,6 +75,7 @@ func (r *applicationControlPolicyResource) Schema(_ context.Context, _ resource.
 				Description: "Active draft revision name when present",
 				Computed:    true,
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -85,8 +88,9 @@ func (r *applicationControlPolicyResource) Configure(_ context.Context, req reso
 }
 
 func (r *applicationControlPolicyResource) ImportState(
-	ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse,
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	importAccountID(ctx, &req, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "application_control")...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), false)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_control_enabled"), string(cato_models.PolicyToggleStateDisabled))...)
@@ -123,6 +127,10 @@ func (r *applicationControlPolicyResource) readState(ctx context.Context) (Appli
 }
 
 func (r *applicationControlPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan ApplicationControlPolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -137,7 +145,11 @@ func (r *applicationControlPolicyResource) Create(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.Set(ctx, st)...)
 }
 
-func (r *applicationControlPolicyResource) Read(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+func (r *applicationControlPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	st, err := r.readState(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("read policy", err.Error())
@@ -147,6 +159,10 @@ func (r *applicationControlPolicyResource) Read(ctx context.Context, _ resource.
 }
 
 func (r *applicationControlPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan ApplicationControlPolicyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -43,6 +43,7 @@ var (
 	_ resource.Resource                = &applicationControlRuleResource{}
 	_ resource.ResourceWithConfigure   = &applicationControlRuleResource{}
 	_ resource.ResourceWithImportState = &applicationControlRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &applicationControlRuleResource{}
 )
 
 func NewApplicationControlRuleResource() resource.Resource {
@@ -53,6 +54,8 @@ type applicationControlRuleResource struct {
 	client *catoClientData
 }
 
+func (r *applicationControlRuleResource) clientData() **catoClientData { return &r.client }
+
 func (r *applicationControlRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_control_rule"
 }
@@ -144,6 +147,8 @@ func (r *applicationControlRuleResource) Schema(_ context.Context, _ resource.Sc
 					},
 				},
 			},
+			"account_id":       accountIDOverrideAttribute(),
+			"lifecycle_window": lifecycleWindowAttribute(),
 		},
 	}
 }
@@ -156,16 +161,37 @@ func (r *applicationControlRuleResource) Configure(_ context.Context, req resour
 }
 
 func (r *applicationControlRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("rule").AtName("id"), req, resp)
 }
 
+func (r *applicationControlRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLifecycleWindowPlan(ctx, req, resp)
+}
+
 //nolint:gocyclo,funlen
 func (r *applicationControlRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan ApplicationControlRule
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	input, diags := hydrateApplicationControlAddRuleInput(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -250,19 +276,29 @@ func (r *applicationControlRuleResource) Create(ctx context.Context, req resourc
 	}
 
 	st := ApplicationControlRule{
-		ID:   types.StringValue(newID),
-		At:   atObj,
-		Rule: ruleObj,
+		ID:              types.StringValue(newID),
+		At:              atObj,
+		Rule:            ruleObj,
+		LifecycleWindow: plan.LifecycleWindow,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, st)...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 func (r *applicationControlRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state ApplicationControlRule
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
+	enabled := ruleEnabled(state.Rule)
 
 	body, err := r.client.catov2.ApplicationControlPolicy(ctx, r.client.AccountId)
 	if err != nil {
@@ -313,15 +349,32 @@ func (r *applicationControlRuleResource) Read(ctx context.Context, req resource.
 	state.ID = types.StringValue(cur.GetID())
 	state.Rule = ruleObj
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	keepLifecycleWindowEnabled(ctx, state.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 //nolint:gocyclo,funlen
 func (r *applicationControlRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan ApplicationControlRule
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		removeLifecycleWindowExpiredRule(ctx, req, resp, r.Delete)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	move := cato_models.PolicyMoveRuleInput{}
 	atAsOpts := basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
@@ -422,19 +475,27 @@ func (r *applicationControlRuleResource) Update(ctx context.Context, req resourc
 	}
 
 	st := ApplicationControlRule{
-		ID:   types.StringValue(rule.ID.ValueString()),
-		At:   atObj,
-		Rule: ruleObj,
+		ID:              types.StringValue(rule.ID.ValueString()),
+		At:              atObj,
+		Rule:            ruleObj,
+		LifecycleWindow: plan.LifecycleWindow,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, st)...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 func (r *applicationControlRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state ApplicationControlRule
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 	rule := ApplicationControlRuleRulePlan{}
 	resp.Diagnostics.Append(state.Rule.As(ctx, &rule, basetypes.ObjectAsOptions{})...)
 	if resp.Diagnostics.HasError() {
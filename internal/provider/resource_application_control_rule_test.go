@@ -70,8 +70,9 @@ func TestApplicationControlRuleImportState(t *testing.T) {
 		State: tfsdk.State{Schema: getApplicationControlRuleSchema(ctx, t)},
 	}
 	diags := resp.State.Set(ctx, ApplicationControlRule{
-		Rule: types.ObjectNull(ApplicationControlRuleRuleAttrTypes),
-		At:   types.ObjectNull(PositionAttrTypes),
+		Rule:            types.ObjectNull(ApplicationControlRuleRuleAttrTypes),
+		At:              types.ObjectNull(PositionAttrTypes),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	})
 	if diags.HasError() {
 		t.Fatalf("unexpected seed state diagnostics: %+v", diags)
@@ -208,5 +209,6 @@ func newMinimalApplicationControlRulePlan(
 			"position": types.StringValue("LAST_IN_POLICY"),
 			"ref":      types.StringNull(),
 		}),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	}
 }
@@ -35,6 +35,8 @@ type applicationControlSectionResource struct {
 	client *catoClientData
 }
 
+func (r *applicationControlSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *applicationControlSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_control_section"
 }
@@ -89,6 +91,7 @@ func (r *applicationControlSectionResource) Schema(_ context.Context, _ resource
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -101,10 +104,15 @@ func (r *applicationControlSectionResource) Configure(_ context.Context, req res
 }
 
 func (r *applicationControlSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 func (r *applicationControlSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan ApplicationControlSection
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -163,6 +171,10 @@ func (r *applicationControlSectionResource) Create(ctx context.Context, req reso
 }
 
 func (r *applicationControlSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state ApplicationControlSection
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -227,6 +239,10 @@ func (r *applicationControlSectionResource) Read(ctx context.Context, req resour
 
 //nolint:gocyclo
 func (r *applicationControlSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan ApplicationControlSection
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -294,6 +310,9 @@ func (r *applicationControlSectionResource) Update(ctx context.Context, req reso
 }
 
 func (r *applicationControlSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state ApplicationControlSection
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -7,8 +7,11 @@ import (
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
 	"github.com/catonetworks/cato-go-sdk/scalars"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
 	"github.com/catonetworks/terraform-provider-cato/internal/utils"
 )
 
@@ -38,6 +42,8 @@ type bgpPeerResource struct {
 	client *catoClientData
 }
 
+func (r *bgpPeerResource) clientData() **catoClientData { return &r.client }
+
 type BgpPeer struct {
 	ID                     types.String `tfsdk:"id"`
 	SiteID                 types.String `tfsdk:"site_id"`
@@ -58,6 +64,10 @@ type BgpPeer struct {
 	BfdEnabled             types.Bool   `tfsdk:"bfd_enabled"`
 	BfdSettings            types.Object `tfsdk:"bfd_settings"`
 	Tracking               types.Object `tfsdk:"tracking"`
+	InboundFilter          types.List   `tfsdk:"inbound_filter"`
+	OutboundFilter         types.List   `tfsdk:"outbound_filter"`
+	AsPathPrepend          types.Int64  `tfsdk:"as_path_prepend"`
+	AccountID              types.String `tfsdk:"account_id"`
 }
 
 type BfdSettingsInput struct {
@@ -76,6 +86,14 @@ type BgpCommunityInput struct {
 	To   types.Int64 `tfsdk:"to"`
 }
 
+type BgpRouteFilterInput struct {
+	Action    types.String `tfsdk:"action"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Ge        types.Int64  `tfsdk:"ge"`
+	Le        types.Int64  `tfsdk:"le"`
+	Community types.List   `tfsdk:"community"`
+}
+
 type BgpTrackingInput struct {
 	Enabled        types.Bool   `tfsdk:"enabled"`
 	AlertFrequency types.String `tfsdk:"alert_frequency"`
@@ -244,6 +262,66 @@ func (r *bgpPeerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
+			"inbound_filter": bgpRouteFilterSchema("Ordered route filter rules applied to routes received from the peer. " +
+				"Routes not matching any rule are handled by default_action."),
+			"outbound_filter": bgpRouteFilterSchema("Ordered route filter rules applied to routes advertised to the peer. " +
+				"Routes not matching any rule are handled by default_action."),
+			"as_path_prepend": schema.Int64Attribute{
+				Description: "Number of times the Cato ASN is prepended to the AS path of routes advertised to the peer.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 10)},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func bgpRouteFilterSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+		NestedObject: schema.NestedAttributeObject{
+			Validators: []validator.Object{validators.GetBgpRouteFilterValidator()},
+			Attributes: map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					Description: "Action for routes matching the rule (ACCEPT or DROP).",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("ACCEPT", "DROP"),
+					},
+				},
+				"prefix": schema.StringAttribute{
+					Description: "Subnet (CIDR) the route must match. Without ge / le only the exact prefix is matched.",
+					Optional:    true,
+				},
+				"ge": schema.Int64Attribute{
+					Description: "Match routes within the prefix with a prefix length greater than or equal to this value.",
+					Optional:    true,
+					Validators:  []validator.Int64{int64validator.Between(0, 32)},
+				},
+				"le": schema.Int64Attribute{
+					Description: "Match routes within the prefix with a prefix length less than or equal to this value.",
+					Optional:    true,
+					Validators:  []validator.Int64{int64validator.Between(0, 32)},
+				},
+				"community": schema.ListNestedAttribute{
+					Description: "Community ranges the route must carry (any of the ranges matches).",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"from": schema.Int64Attribute{
+								Description: "Start of the community range.",
+								Required:    true,
+							},
+							"to": schema.Int64Attribute{
+								Description: "End of the community range.",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -261,11 +339,16 @@ func (r *bgpPeerResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *bgpPeerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 //nolint:gocyclo,funlen
 func (r *bgpPeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan BgpPeer
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -357,6 +440,18 @@ func (r *bgpPeerResource) Create(ctx context.Context, req resource.CreateRequest
 		trackingInput.SubscriptionID = tracking.SubscriptionID.ValueString()
 		input.Tracking = &trackingInput
 	}
+	if !plan.InboundFilter.IsNull() {
+		input.InboundFilter = bgpRouteFiltersInput(ctx, plan.InboundFilter, &resp.Diagnostics)
+	}
+	if !plan.OutboundFilter.IsNull() {
+		input.OutboundFilter = bgpRouteFiltersInput(ctx, plan.OutboundFilter, &resp.Diagnostics)
+	}
+	if !plan.AsPathPrepend.IsNull() {
+		input.AsPathPrepend = plan.AsPathPrepend.ValueInt64Pointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Create.SiteAddBgpPeer.request", map[string]interface{}{
 		"request": utils.InterfaceToJSONString(input),
@@ -385,6 +480,10 @@ func (r *bgpPeerResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *bgpPeerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state BgpPeer
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -430,6 +529,10 @@ func (r *bgpPeerResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 //nolint:gocyclo,funlen
 func (r *bgpPeerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan BgpPeer
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -529,6 +632,14 @@ func (r *bgpPeerResource) Update(ctx context.Context, req resource.UpdateRequest
 		trackingInput.SubscriptionID = tracking.SubscriptionID.ValueString()
 		input.Tracking = &trackingInput
 	}
+	// filters and prepending removed from the config are sent empty so they are cleared on the peer
+	input.InboundFilter = bgpRouteFiltersInput(ctx, plan.InboundFilter, &resp.Diagnostics)
+	input.OutboundFilter = bgpRouteFiltersInput(ctx, plan.OutboundFilter, &resp.Diagnostics)
+	asPathPrepend := plan.AsPathPrepend.ValueInt64()
+	input.AsPathPrepend = &asPathPrepend
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Update.SiteUpdateBgpPeer.request", map[string]interface{}{
 		"request": utils.InterfaceToJSONString(input),
@@ -553,6 +664,9 @@ func (r *bgpPeerResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *bgpPeerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state BgpPeer
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -711,5 +825,142 @@ func ConvertToBgpPeer(input cato_go_sdk.Site_Site_BgpPeer) BgpPeer {
 		BfdEnabled:             types.BoolValue(input.BfdEnabled),
 		BfdSettings:            convertBfdSettings(input.BfdSettingsBgpPeer),
 		Tracking:               convertTracking(input.TrackingBgpPeer),
+		InboundFilter:          convertBgpRouteFilters(inboundBgpRouteFilters(input.InboundFilter)),
+		OutboundFilter:         convertBgpRouteFilters(outboundBgpRouteFilters(input.OutboundFilter)),
+		AsPathPrepend:          convertAsPathPrepend(input.AsPathPrepend),
+	}
+}
+
+// bgpRouteFilter is the common shape of the inbound and outbound route filters returned by the API
+type bgpRouteFilter struct {
+	action      string
+	prefix      *string
+	ge          *int64
+	le          *int64
+	communities [][2]string
+}
+
+var (
+	bgpCommunityAttrTypes = map[string]attr.Type{
+		"from": types.Int64Type,
+		"to":   types.Int64Type,
+	}
+	bgpRouteFilterAttrTypes = map[string]attr.Type{
+		"action":    types.StringType,
+		"prefix":    types.StringType,
+		"ge":        types.Int64Type,
+		"le":        types.Int64Type,
+		"community": types.ListType{ElemType: types.ObjectType{AttrTypes: bgpCommunityAttrTypes}},
+	}
+)
+
+// bgpRouteFiltersInput converts the configured route filters; a null list yields an empty (clearing) input
+func bgpRouteFiltersInput(ctx context.Context, list types.List, diags *diag.Diagnostics) []*cato_models.BgpRouteFilterInput {
+	input := make([]*cato_models.BgpRouteFilterInput, 0)
+	if list.IsNull() || list.IsUnknown() {
+		return input
+	}
+
+	filters := make([]BgpRouteFilterInput, 0, len(list.Elements()))
+	diags.Append(list.ElementsAs(ctx, &filters, false)...)
+
+	for _, filter := range filters {
+		filterInput := cato_models.BgpRouteFilterInput{
+			Action:    cato_models.BgpDefaultAction(filter.Action.ValueString()),
+			Prefix:    filter.Prefix.ValueStringPointer(),
+			Ge:        filter.Ge.ValueInt64Pointer(),
+			Le:        filter.Le.ValueInt64Pointer(),
+			Community: make([]*cato_models.BgpCommunityInput, 0),
+		}
+
+		communities := make([]BgpCommunityInput, 0, len(filter.Community.Elements()))
+		diags.Append(filter.Community.ElementsAs(ctx, &communities, false)...)
+		for _, community := range communities {
+			filterInput.Community = append(filterInput.Community, &cato_models.BgpCommunityInput{
+				From: scalars.Asn16(strconv.FormatInt(community.From.ValueInt64(), 10)),
+				To:   scalars.Asn16(strconv.FormatInt(community.To.ValueInt64(), 10)),
+			})
+		}
+
+		input = append(input, &filterInput)
+	}
+	return input
+}
+
+func inboundBgpRouteFilters(input []*cato_go_sdk.Site_Site_BgpPeer_InboundFilter) []bgpRouteFilter {
+	filters := make([]bgpRouteFilter, 0, len(input))
+	for _, filter := range input {
+		communities := make([][2]string, 0, len(filter.Community))
+		for _, community := range filter.Community {
+			communities = append(communities, [2]string{string(community.From), string(community.To)})
+		}
+		filters = append(filters, bgpRouteFilter{
+			action:      string(filter.Action),
+			prefix:      filter.Prefix,
+			ge:          filter.Ge,
+			le:          filter.Le,
+			communities: communities,
+		})
+	}
+	return filters
+}
+
+func outboundBgpRouteFilters(input []*cato_go_sdk.Site_Site_BgpPeer_OutboundFilter) []bgpRouteFilter {
+	filters := make([]bgpRouteFilter, 0, len(input))
+	for _, filter := range input {
+		communities := make([][2]string, 0, len(filter.Community))
+		for _, community := range filter.Community {
+			communities = append(communities, [2]string{string(community.From), string(community.To)})
+		}
+		filters = append(filters, bgpRouteFilter{
+			action:      string(filter.Action),
+			prefix:      filter.Prefix,
+			ge:          filter.Ge,
+			le:          filter.Le,
+			communities: communities,
+		})
+	}
+	return filters
+}
+
+// convertBgpRouteFilters returns a null list when the peer has no filters, matching an unset attribute
+func convertBgpRouteFilters(filters []bgpRouteFilter) types.List {
+	filterType := types.ObjectType{AttrTypes: bgpRouteFilterAttrTypes}
+	if len(filters) == 0 {
+		return types.ListNull(filterType)
+	}
+
+	values := make([]attr.Value, 0, len(filters))
+	for _, filter := range filters {
+		communityType := types.ObjectType{AttrTypes: bgpCommunityAttrTypes}
+		communityList := types.ListNull(communityType)
+		if len(filter.communities) > 0 {
+			communities := make([]attr.Value, 0, len(filter.communities))
+			for _, community := range filter.communities {
+				from, _ := strconv.ParseInt(community[0], 10, 64)
+				to, _ := strconv.ParseInt(community[1], 10, 64)
+				communities = append(communities, types.ObjectValueMust(bgpCommunityAttrTypes, map[string]attr.Value{
+					"from": types.Int64Value(from),
+					"to":   types.Int64Value(to),
+				}))
+			}
+			communityList = types.ListValueMust(communityType, communities)
+		}
+
+		values = append(values, types.ObjectValueMust(bgpRouteFilterAttrTypes, map[string]attr.Value{
+			"action":    types.StringValue(filter.action),
+			"prefix":    types.StringPointerValue(filter.prefix),
+			"ge":        types.Int64PointerValue(filter.ge),
+			"le":        types.Int64PointerValue(filter.le),
+			"community": communityList,
+		}))
+	}
+	return types.ListValueMust(filterType, values)
+}
+
+func convertAsPathPrepend(input *int64) types.Int64 {
+	if input == nil || *input == 0 {
+		return types.Int64Null()
 	}
+	return types.Int64Value(*input)
 }
@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/catonetworks/cato-go-sdk/scalars"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &customAppResource{}
+	_ resource.ResourceWithConfigure   = &customAppResource{}
+	_ resource.ResourceWithImportState = &customAppResource{}
+)
+
+var ErrCustomAppNotFound = errors.New("custom-app not found")
+
+func NewCustomAppResource() resource.Resource {
+	return &customAppResource{}
+}
+
+type customAppResource struct {
+	client *catoClientData
+}
+
+func (r *customAppResource) clientData() **catoClientData { return &r.client }
+
+type customAppCriteria = cato_go_sdk.CustomAppReadCustomApp_CustomApplication_CustomApplication_Criteria
+
+func (r *customAppResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_app"
+}
+
+func (r *customAppResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_custom_app` resource contains the configuration parameters necessary to manage a custom application. " +
+			"Custom applications can be referenced by `id` or `name` in the `custom_app` attributes of the policy rules.",
+		Attributes: map[string]schema.Attribute{
+			"criteria": r.schemaCriteria(),
+			"description": schema.StringAttribute{
+				Description: "Optional description of the custom application",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the custom application",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the custom application",
+				Required:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *customAppResource) schemaCriteria() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Traffic matching criteria; traffic matching any of the criteria is identified as the application. " +
+			"All the attributes set within a single criterion must match.",
+		Required:   true,
+		Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+		NestedObject: schema.NestedAttributeObject{
+			Validators: []validator.Object{validators.GetCustomAppCriterionValidator()},
+			Attributes: map[string]schema.Attribute{
+				"domains": schema.SetAttribute{
+					ElementType: types.StringType,
+					Description: "Destination domains, including all their subdomains (e.g. example.com)",
+					Optional:    true,
+				},
+				"fqdns": schema.SetAttribute{
+					ElementType: types.StringType,
+					Description: "Destination fully qualified domain names (e.g. app.example.com)",
+					Optional:    true,
+				},
+				"ip_ranges": schema.ListNestedAttribute{
+					Description: "Destination IP address ranges",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"from": schema.StringAttribute{
+								Description: "First IP address of the range",
+								Required:    true,
+							},
+							"to": schema.StringAttribute{
+								Description: "Last IP address of the range",
+								Required:    true,
+							},
+						},
+					},
+				},
+				"ips": schema.SetAttribute{
+					ElementType: types.StringType,
+					Description: "Destination IP addresses",
+					Optional:    true,
+				},
+				"port_range": schema.SingleNestedAttribute{
+					Description: "Destination port range",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"from": schema.Int64Attribute{
+							Description: "From",
+							Required:    true,
+						},
+						"to": schema.Int64Attribute{
+							Description: "To",
+							Required:    true,
+						},
+					},
+				},
+				"ports": schema.SetAttribute{
+					ElementType: types.Int64Type,
+					Description: "Destination TCP or UDP ports",
+					Optional:    true,
+				},
+				"protocol": schema.StringAttribute{
+					Description: "Protocol; e.g.: TCP, UDP, TCP_UDP, ICMP. Required when ports are set",
+					Optional:    true,
+					Validators:  []validator.String{validators.IPProtocolValidator{}},
+				},
+				"subnets": schema.SetAttribute{
+					ElementType: types.StringType,
+					Description: "Destination subnets (CIDR)",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func (r *customAppResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *customAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new custom app
+func (r *customAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan CustomAppModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateCustomApplicationInput{
+		Criteria:    r.prepareCriteria(ctx, plan.Criteria, &resp.Diagnostics),
+		Description: parse.KnownStringPointer(plan.Description),
+		Name:        plan.Name.ValueString(),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new custom app
+	tflog.Debug(ctx, "CustomAppCreateCustomApp", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomAppCreateCustomApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomAppCreateCustomApp", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API CustomAppCreateCustomApp error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetCustomApplication().GetCreateCustomApplication().GetApplication().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateCustomAppState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating custom-app state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the custom app
+func (r *customAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state CustomAppModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateCustomAppState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if custom-app was found
+		if errors.Is(hydrateErr, ErrCustomAppNotFound) {
+			tflog.Warn(ctx, "custom app not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating custom-app state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the custom app
+func (r *customAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state CustomAppModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("CustomAppUpdateCustomApp: ID is unknown", "CustomApp ID is not set in TF state")
+		return
+	}
+
+	input := cato_models.UpdateCustomApplicationInput{
+		Criteria:    r.prepareCriteria(ctx, plan.Criteria, &resp.Diagnostics),
+		Description: parse.KnownStringPointer(plan.Description),
+		ID:          id,
+		Name:        parse.KnownStringPointer(plan.Name),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "CustomAppUpdateCustomApp", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomAppUpdateCustomApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomAppUpdateCustomApp", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API CustomAppUpdateCustomApp error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateCustomAppState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating custom-app state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the custom app
+func (r *customAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state CustomAppModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteCustomApplicationInput{
+		CustomApplication: &cato_models.CustomApplicationRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the custom app
+	tflog.Debug(ctx, "CustomAppDeleteCustomApp", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomAppDeleteCustomApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomAppDeleteCustomApp", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API CustomAppDeleteCustomApp error", err.Error())
+		return
+	}
+}
+
+// hydrateCustomAppState fetches the current state of a custom app from the API
+func (r *customAppResource) hydrateCustomAppState(ctx context.Context, customAppID string) (*CustomAppModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.CustomApplicationRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: customAppID,
+	}
+
+	// Call Cato API to get a custom-app
+	tflog.Debug(ctx, "CustomAppReadCustomApp", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomAppReadCustomApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomAppReadCustomApp", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to CustomAppModel
+	app := result.GetCustomApplication().GetCustomApplication()
+	if app == nil {
+		return nil, diags, ErrCustomAppNotFound
+	}
+
+	state := &CustomAppModel{
+		Criteria:    r.parseCriteria(ctx, app.Criteria, &diags),
+		Description: types.StringPointerValue(app.Description),
+		ID:          types.StringValue(app.ID),
+		Name:        types.StringValue(app.Name),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+func (r *customAppResource) parseCriteria(ctx context.Context, criteria []*customAppCriteria, diags *diag.Diagnostics) types.List {
+	listNull := types.ListNull(types.ObjectType{AttrTypes: CustomAppCriterionTypes})
+
+	criteriaObjects := make([]types.Object, 0, len(criteria))
+	for _, c := range criteria {
+		if c == nil {
+			continue
+		}
+
+		// Port range
+		tfPortRangeObj := types.ObjectNull(PortRangeTypes)
+		if c.PortRange != nil {
+			tfPortRange := PortRange{
+				From: types.Int64Value(c.PortRange.From.GetInt64()),
+				To:   types.Int64Value(c.PortRange.To.GetInt64()),
+			}
+			obj, objDiags := types.ObjectValueFrom(ctx, PortRangeTypes, tfPortRange)
+			if utils.CheckErr(diags, objDiags) {
+				return listNull
+			}
+			tfPortRangeObj = obj
+		}
+
+		// IP ranges
+		tfIPRanges := types.ListNull(types.ObjectType{AttrTypes: CustomAppIPRangeTypes})
+		if len(c.IPRange) > 0 {
+			ipRanges := make([]CustomAppIPRange, 0, len(c.IPRange))
+			for _, ipRange := range c.IPRange {
+				ipRanges = append(ipRanges, CustomAppIPRange{
+					From: types.StringValue(ipRange.From),
+					To:   types.StringValue(ipRange.To),
+				})
+			}
+			list, objDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: CustomAppIPRangeTypes}, ipRanges)
+			if utils.CheckErr(diags, objDiags) {
+				return listNull
+			}
+			tfIPRanges = list
+		}
+
+		// Protocol
+		tfProtocol := types.StringNull()
+		if c.Protocol != nil {
+			tfProtocol = types.StringValue(c.Protocol.String())
+		}
+
+		tfCriterion := CustomAppCriterion{
+			Domains:   parse.StringSetFunc(ctx, emptyToNil(c.Domain), func(s string) string { return s }, diags),
+			Fqdns:     parse.StringSetFunc(ctx, emptyToNil(c.Fqdn), func(s string) string { return s }, diags),
+			IPRanges:  tfIPRanges,
+			IPs:       parse.StringSetFunc(ctx, emptyToNil(c.IP), func(s string) string { return s }, diags),
+			PortRange: tfPortRangeObj,
+			Ports:     parse.Int64SetFunc(ctx, emptyToNil(c.Port), func(p scalars.Port) int64 { return p.GetInt64() }, diags),
+			Protocol:  tfProtocol,
+			Subnets: parse.StringSetFunc(ctx, emptyToNil(c.Subnet),
+				func(s scalars.NetworkSubnet) string { return string(s) }, diags),
+		}
+		obj, objDiags := types.ObjectValueFrom(ctx, CustomAppCriterionTypes, tfCriterion)
+		if utils.CheckErr(diags, objDiags) {
+			return listNull
+		}
+		criteriaObjects = append(criteriaObjects, obj)
+	}
+
+	list, objDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: CustomAppCriterionTypes}, criteriaObjects)
+	if utils.CheckErr(diags, objDiags) {
+		return listNull
+	}
+	return list
+}
+
+func (r *customAppResource) prepareCriteria(ctx context.Context, criteria types.List, diags *diag.Diagnostics,
+) []*cato_models.CustomApplicationCriteriaInput {
+	if !utils.HasValue(criteria) {
+		return nil
+	}
+
+	var tfCriteria []CustomAppCriterion
+	if utils.CheckErr(diags, criteria.ElementsAs(ctx, &tfCriteria, false)) {
+		return nil
+	}
+
+	out := make([]*cato_models.CustomApplicationCriteriaInput, 0, len(tfCriteria))
+	for _, c := range tfCriteria {
+		input := cato_models.CustomApplicationCriteriaInput{
+			Domain: parse.PrepareStrings[string](ctx, c.Domains, diags),
+			Fqdn:   parse.PrepareStrings[string](ctx, c.Fqdns, diags),
+			IP:     parse.PrepareStrings[string](ctx, c.IPs, diags),
+			Subnet: parse.PrepareStrings[scalars.NetworkSubnet](ctx, c.Subnets, diags),
+		}
+
+		// Protocol
+		if utils.HasValue(c.Protocol) {
+			protocol := cato_models.IPProtocol(c.Protocol.ValueString())
+			input.Protocol = &protocol
+		}
+
+		// Port numbers
+		if utils.HasValue(c.Ports) {
+			var tfPortNumbers []types.Int64
+			if utils.CheckErr(diags, c.Ports.ElementsAs(ctx, &tfPortNumbers, false)) {
+				return nil
+			}
+			for _, portNum := range tfPortNumbers {
+				if utils.HasValue(portNum) {
+					input.Port = append(input.Port, scalars.Port(portNum.String()))
+				}
+			}
+		}
+
+		// Port range
+		if utils.HasValue(c.PortRange) {
+			var tfPortRange PortRange
+			if utils.CheckErr(diags, c.PortRange.As(ctx, &tfPortRange, basetypes.ObjectAsOptions{})) {
+				return nil
+			}
+			input.PortRange = &cato_models.PortRangeInput{
+				From: scalars.Port(tfPortRange.From.String()),
+				To:   scalars.Port(tfPortRange.To.String()),
+			}
+		}
+
+		// IP ranges
+		if utils.HasValue(c.IPRanges) {
+			var tfIPRanges []CustomAppIPRange
+			if utils.CheckErr(diags, c.IPRanges.ElementsAs(ctx, &tfIPRanges, false)) {
+				return nil
+			}
+			for _, ipRange := range tfIPRanges {
+				input.IPRange = append(input.IPRange, &cato_models.IPAddressRangeInput{
+					From: ipRange.From.ValueString(),
+					To:   ipRange.To.ValueString(),
+				})
+			}
+		}
+
+		out = append(out, &input)
+	}
+
+	return out
+}
+
+// emptyToNil returns nil for an empty slice so that unset optional attributes stay null in the state
+func emptyToNil[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
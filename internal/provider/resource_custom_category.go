@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &customCategoryResource{}
+	_ resource.ResourceWithConfigure   = &customCategoryResource{}
+	_ resource.ResourceWithImportState = &customCategoryResource{}
+)
+
+var ErrCustomCategoryNotFound = errors.New("custom-category not found")
+
+func NewCustomCategoryResource() resource.Resource {
+	return &customCategoryResource{}
+}
+
+type customCategoryResource struct {
+	client *catoClientData
+}
+
+func (r *customCategoryResource) clientData() **catoClientData { return &r.client }
+
+func (r *customCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_category"
+}
+
+func (r *customCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_custom_category` resource contains the configuration parameters necessary to manage a custom category. " +
+			"Custom categories can be referenced by `id` or `name` in the `custom_category` attributes of the policy rules.",
+		Attributes: map[string]schema.Attribute{
+			"applications": schema.SetNestedAttribute{
+				Description: "Applications included in the category",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes:    parse.SchemaNameID("Application"),
+					PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+				},
+			},
+			"custom_apps": schema.SetNestedAttribute{
+				Description: "Custom applications included in the category",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes:    parse.SchemaNameID("Custom app"),
+					PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the custom category",
+				Optional:    true,
+			},
+			"domains": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Domains included in the category, including all their subdomains (e.g. example.com)",
+				Optional:    true,
+			},
+			"fqdns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Fully qualified domain names included in the category (e.g. app.example.com)",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the custom category",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the custom category",
+				Required:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *customCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *customCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new custom category
+func (r *customCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan CustomCategoryModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateCustomCategoryInput{
+		Description: parse.KnownStringPointer(plan.Description),
+		Items:       r.prepareItems(ctx, plan, &resp.Diagnostics),
+		Name:        plan.Name.ValueString(),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new custom category
+	tflog.Debug(ctx, "CustomCategoryCreateCustomCategory", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomCategoryCreateCustomCategory(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomCategoryCreateCustomCategory", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API CustomCategoryCreateCustomCategory error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetCustomCategory().GetCreateCustomCategory().GetCategory().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateCustomCategoryState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating custom-category state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the custom category
+func (r *customCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state CustomCategoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateCustomCategoryState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if custom-category was found
+		if errors.Is(hydrateErr, ErrCustomCategoryNotFound) {
+			tflog.Warn(ctx, "custom category not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating custom-category state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the custom category
+func (r *customCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state CustomCategoryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("CustomCategoryUpdateCustomCategory: ID is unknown", "CustomCategory ID is not set in TF state")
+		return
+	}
+
+	// the category items are replaced as a whole
+	input := cato_models.UpdateCustomCategoryInput{
+		Description: parse.KnownStringPointer(plan.Description),
+		ID:          id,
+		Items:       r.prepareItems(ctx, plan, &resp.Diagnostics),
+		Name:        parse.KnownStringPointer(plan.Name),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "CustomCategoryUpdateCustomCategory", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomCategoryUpdateCustomCategory(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomCategoryUpdateCustomCategory", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API CustomCategoryUpdateCustomCategory error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateCustomCategoryState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating custom-category state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the custom category
+func (r *customCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state CustomCategoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteCustomCategoryInput{
+		CustomCategory: &cato_models.CustomCategoryRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the custom category
+	tflog.Debug(ctx, "CustomCategoryDeleteCustomCategory", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomCategoryDeleteCustomCategory(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomCategoryDeleteCustomCategory", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API CustomCategoryDeleteCustomCategory error", err.Error())
+		return
+	}
+}
+
+// hydrateCustomCategoryState fetches the current state of a custom category from the API
+func (r *customCategoryResource) hydrateCustomCategoryState(ctx context.Context, customCategoryID string,
+) (*CustomCategoryModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.CustomCategoryRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: customCategoryID,
+	}
+
+	// Call Cato API to get a custom-category
+	tflog.Debug(ctx, "CustomCategoryReadCustomCategory", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.CustomCategoryReadCustomCategory(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "CustomCategoryReadCustomCategory", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to CustomCategoryModel
+	category := result.GetCustomCategory().GetCustomCategory()
+	if category == nil {
+		return nil, diags, ErrCustomCategoryNotFound
+	}
+
+	items := category.Items
+	state := &CustomCategoryModel{
+		Applications: parse.IDRefSet(ctx, emptyToNil(items.Application), &diags),
+		CustomApps:   parse.IDRefSet(ctx, emptyToNil(items.CustomApp), &diags),
+		Description:  types.StringPointerValue(category.Description),
+		Domains:      parse.StringSetFunc(ctx, emptyToNil(items.Domain), func(s string) string { return s }, &diags),
+		Fqdns:        parse.StringSetFunc(ctx, emptyToNil(items.Fqdn), func(s string) string { return s }, &diags),
+		ID:           types.StringValue(category.ID),
+		Name:         types.StringValue(category.Name),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+func (r *customCategoryResource) prepareItems(ctx context.Context, plan CustomCategoryModel, diags *diag.Diagnostics,
+) *cato_models.CustomCategoryItemsInput {
+	return &cato_models.CustomCategoryItemsInput{
+		Application: parse.PrepareIDRefSet[cato_models.ApplicationRefInput](ctx, plan.Applications, diags),
+		CustomApp:   parse.PrepareIDRefSet[cato_models.CustomApplicationRefInput](ctx, plan.CustomApps, diags),
+		Domain:      parse.PrepareStrings[string](ctx, plan.Domains, diags),
+		Fqdn:        parse.PrepareStrings[string](ctx, plan.Fqdns, diags),
+	}
+}
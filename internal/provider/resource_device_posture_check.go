@@ -0,0 +1,622 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &devicePostureCheckResource{}
+	_ resource.ResourceWithConfigure   = &devicePostureCheckResource{}
+	_ resource.ResourceWithImportState = &devicePostureCheckResource{}
+	_ resource.ResourceWithModifyPlan  = &devicePostureCheckResource{}
+)
+
+var ErrDevicePostureCheckNotFound = errors.New("device posture check not found")
+
+// device posture check types, each configured by the block of the same (lower case) name
+const (
+	devicePostureAntivirus      = "ANTIVIRUS"
+	devicePostureFirewall       = "FIREWALL"
+	devicePostureDiskEncryption = "DISK_ENCRYPTION"
+	devicePostureOsVersion      = "OS_VERSION"
+	devicePostureCertificate    = "CERTIFICATE"
+	devicePostureRegistry       = "REGISTRY"
+	devicePostureProcess        = "PROCESS"
+)
+
+var devicePostureDesktopPlatforms = []string{"WINDOWS", "MACOS", "LINUX"}
+
+func NewDevicePostureCheckResource() resource.Resource {
+	return &devicePostureCheckResource{}
+}
+
+type devicePostureCheckResource struct {
+	client *catoClientData
+}
+
+func (r *devicePostureCheckResource) clientData() **catoClientData { return &r.client }
+
+type devicePostureCheck = cato_go_sdk.DevicePostureReadCheck_DevicePosture_Check
+
+func (r *devicePostureCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_posture_check"
+}
+
+func (r *devicePostureCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// exactly one check block must be set, the block determines the check type
+	exactlyOneCheck := objectvalidator.ExactlyOneOf(
+		path.MatchRoot("antivirus"), path.MatchRoot("firewall"), path.MatchRoot("disk_encryption"),
+		path.MatchRoot("os_version"), path.MatchRoot("certificate"), path.MatchRoot("registry"), path.MatchRoot("process"),
+	)
+	vendorAttr := schema.StringAttribute{
+		Description: "Required product vendor; any vendor is accepted when not set",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_device_posture_check` resource contains the configuration parameters necessary to manage " +
+			"a device posture check. Exactly one check block must be set. Checks are grouped into `cato_device_posture_profile` resources.",
+		Attributes: map[string]schema.Attribute{
+			"antivirus": schema.SingleNestedAttribute{
+				Description: "Antivirus check",
+				Optional:    true,
+				Validators: []validator.Object{
+					exactlyOneCheck,
+					validators.DevicePosturePlatformValidator{Platforms: devicePostureDesktopPlatforms},
+				},
+				Attributes: map[string]schema.Attribute{
+					"max_definitions_age_days": schema.Int64Attribute{
+						Description: "Maximum age (in days) of the antivirus definitions",
+						Optional:    true,
+						Validators:  []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"real_time_protection": schema.BoolAttribute{
+						Description: "Require real-time protection to be enabled",
+						Optional:    true,
+					},
+					"vendor": vendorAttr,
+				},
+			},
+			"certificate": schema.SingleNestedAttribute{
+				Description: "Device certificate check",
+				Optional:    true,
+				Validators: []validator.Object{
+					exactlyOneCheck,
+					validators.DevicePosturePlatformValidator{Platforms: []string{"WINDOWS", "MACOS"}},
+				},
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						Description: "Issuer (CA) of the certificate",
+						Required:    true,
+					},
+					"store": schema.StringAttribute{
+						Description: "Certificate store to look in (USER or MACHINE)",
+						Optional:    true,
+						Validators:  []validator.String{stringvalidator.OneOf("USER", "MACHINE")},
+					},
+					"subject": schema.StringAttribute{
+						Description: "Subject of the certificate",
+						Optional:    true,
+					},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the device posture check",
+				Optional:    true,
+			},
+			"disk_encryption": schema.SingleNestedAttribute{
+				Description: "Disk encryption check",
+				Optional:    true,
+				Validators: []validator.Object{
+					exactlyOneCheck,
+					validators.DevicePosturePlatformValidator{Platforms: devicePostureDesktopPlatforms},
+				},
+				Attributes: map[string]schema.Attribute{"vendor": vendorAttr},
+			},
+			"firewall": schema.SingleNestedAttribute{
+				Description: "Firewall check",
+				Optional:    true,
+				Validators: []validator.Object{
+					exactlyOneCheck,
+					validators.DevicePosturePlatformValidator{Platforms: devicePostureDesktopPlatforms},
+				},
+				Attributes: map[string]schema.Attribute{"vendor": vendorAttr},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the device posture check",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the device posture check",
+				Required:    true,
+			},
+			"os_version": schema.SingleNestedAttribute{
+				Description: "Operating system version check",
+				Optional:    true,
+				Validators:  []validator.Object{exactlyOneCheck},
+				Attributes: map[string]schema.Attribute{
+					"min_version": schema.StringAttribute{
+						Description: "Minimum operating system version (e.g. 10.0.19045)",
+						Required:    true,
+					},
+				},
+			},
+			"platform": schema.StringAttribute{
+				Description: "Platform the check applies to (e.g. WINDOWS, MACOS, LINUX, IOS, ANDROID)",
+				Required:    true,
+				Validators:  []validator.String{validators.PlatformValidator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"process": schema.SingleNestedAttribute{
+				Description: "Running process check",
+				Optional:    true,
+				Validators: []validator.Object{
+					exactlyOneCheck,
+					validators.DevicePosturePlatformValidator{Platforms: devicePostureDesktopPlatforms},
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Process (executable) name",
+						Required:    true,
+					},
+					"signer": schema.StringAttribute{
+						Description: "Required signer of the process executable",
+						Optional:    true,
+					},
+				},
+			},
+			"registry": schema.SingleNestedAttribute{
+				Description: "Windows registry check",
+				Optional:    true,
+				Validators: []validator.Object{
+					exactlyOneCheck,
+					validators.DevicePosturePlatformValidator{Platforms: []string{"WINDOWS"}},
+				},
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						Description: "Registry key path (e.g. HKLM\\SOFTWARE\\Example)",
+						Required:    true,
+					},
+					"value_data": schema.StringAttribute{
+						Description: "Required data of the registry value",
+						Optional:    true,
+					},
+					"value_name": schema.StringAttribute{
+						Description: "Name of the registry value that must exist under the key",
+						Optional:    true,
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Check type, derived from the configured check block",
+				Computed:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *devicePostureCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *devicePostureCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan derives the check type from the configured block; the type of a check cannot be changed in place
+func (r *devicePostureCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DevicePostureCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkType := devicePostureCheckType(plan)
+	if checkType == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), checkType)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var stateType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+	if utils.HasValue(stateType) && stateType.ValueString() != checkType {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+	}
+}
+
+// Create a new device posture check
+func (r *devicePostureCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan DevicePostureCheckModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateDevicePostureCheckInput{
+		Description: parse.KnownStringPointer(plan.Description),
+		Name:        plan.Name.ValueString(),
+		Platform:    cato_models.OperatingSystem(plan.Platform.ValueString()),
+		Settings:    r.prepareSettings(ctx, plan, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new device posture check
+	tflog.Debug(ctx, "DevicePostureCreateCheck", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureCreateCheck(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureCreateCheck", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DevicePostureCreateCheck error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetDevicePosture().GetCreateCheck().GetCheck().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDevicePostureCheckState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating device posture check state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the device posture check
+func (r *devicePostureCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state DevicePostureCheckModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateDevicePostureCheckState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the device posture check was found
+		if errors.Is(hydrateErr, ErrDevicePostureCheckNotFound) {
+			tflog.Warn(ctx, "device posture check not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating device posture check state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the device posture check
+func (r *devicePostureCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state DevicePostureCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("DevicePostureUpdateCheck: ID is unknown", "Device posture check ID is not set in TF state")
+		return
+	}
+
+	input := cato_models.UpdateDevicePostureCheckInput{
+		Description: parse.KnownStringPointer(plan.Description),
+		ID:          id,
+		Name:        parse.KnownStringPointer(plan.Name),
+		Settings:    r.prepareSettings(ctx, plan, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "DevicePostureUpdateCheck", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureUpdateCheck(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureUpdateCheck", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DevicePostureUpdateCheck error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDevicePostureCheckState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating device posture check state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the device posture check
+func (r *devicePostureCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state DevicePostureCheckModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteDevicePostureCheckInput{
+		Check: &cato_models.DevicePostureCheckRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the device posture check
+	tflog.Debug(ctx, "DevicePostureDeleteCheck", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureDeleteCheck(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureDeleteCheck", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DevicePostureDeleteCheck error", err.Error())
+		return
+	}
+}
+
+// hydrateDevicePostureCheckState fetches the current state of a device posture check from the API
+func (r *devicePostureCheckResource) hydrateDevicePostureCheckState(ctx context.Context, checkID string,
+) (*DevicePostureCheckModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.DevicePostureCheckRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: checkID,
+	}
+
+	// Call Cato API to get the device posture check
+	tflog.Debug(ctx, "DevicePostureReadCheck", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureReadCheck(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureReadCheck", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to DevicePostureCheckModel
+	check := result.GetDevicePosture().GetCheck()
+	if check == nil {
+		return nil, diags, ErrDevicePostureCheckNotFound
+	}
+
+	state := r.parseCheck(ctx, check, &diags)
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+//nolint:funlen
+func (r *devicePostureCheckResource) parseCheck(ctx context.Context, check *devicePostureCheck, diags *diag.Diagnostics,
+) *DevicePostureCheckModel {
+	state := &DevicePostureCheckModel{
+		Antivirus:      types.ObjectNull(DevicePostureAntivirusTypes),
+		Certificate:    types.ObjectNull(DevicePostureCertificateTypes),
+		Description:    types.StringPointerValue(check.Description),
+		DiskEncryption: types.ObjectNull(DevicePostureVendorTypes),
+		Firewall:       types.ObjectNull(DevicePostureVendorTypes),
+		ID:             types.StringValue(check.ID),
+		Name:           types.StringValue(check.Name),
+		OsVersion:      types.ObjectNull(DevicePostureOsVersionTypes),
+		Platform:       types.StringValue(check.Platform.String()),
+		Process:        types.ObjectNull(DevicePostureProcessTypes),
+		Registry:       types.ObjectNull(DevicePostureRegistryTypes),
+		Type:           types.StringValue(check.Type.String()),
+	}
+
+	settings := check.Settings
+	switch {
+	case settings.Antivirus != nil:
+		state.Antivirus = devicePostureObject(ctx, DevicePostureAntivirusTypes, DevicePostureAntivirus{
+			MaxDefinitionsAgeDays: types.Int64PointerValue(settings.Antivirus.MaxDefinitionsAgeDays),
+			RealTimeProtection:    types.BoolPointerValue(settings.Antivirus.RealTimeProtection),
+			Vendor:                types.StringPointerValue(settings.Antivirus.Vendor),
+		}, diags)
+	case settings.Firewall != nil:
+		state.Firewall = devicePostureObject(ctx, DevicePostureVendorTypes, DevicePostureVendor{
+			Vendor: types.StringPointerValue(settings.Firewall.Vendor),
+		}, diags)
+	case settings.DiskEncryption != nil:
+		state.DiskEncryption = devicePostureObject(ctx, DevicePostureVendorTypes, DevicePostureVendor{
+			Vendor: types.StringPointerValue(settings.DiskEncryption.Vendor),
+		}, diags)
+	case settings.OsVersion != nil:
+		state.OsVersion = devicePostureObject(ctx, DevicePostureOsVersionTypes, DevicePostureOsVersion{
+			MinVersion: types.StringValue(settings.OsVersion.MinVersion),
+		}, diags)
+	case settings.Certificate != nil:
+		store := types.StringNull()
+		if settings.Certificate.Store != nil {
+			store = types.StringValue(settings.Certificate.Store.String())
+		}
+		state.Certificate = devicePostureObject(ctx, DevicePostureCertificateTypes, DevicePostureCertificate{
+			Issuer:  types.StringValue(settings.Certificate.Issuer),
+			Store:   store,
+			Subject: types.StringPointerValue(settings.Certificate.Subject),
+		}, diags)
+	case settings.Registry != nil:
+		state.Registry = devicePostureObject(ctx, DevicePostureRegistryTypes, DevicePostureRegistry{
+			Path:      types.StringValue(settings.Registry.Path),
+			ValueData: types.StringPointerValue(settings.Registry.ValueData),
+			ValueName: types.StringPointerValue(settings.Registry.ValueName),
+		}, diags)
+	case settings.Process != nil:
+		state.Process = devicePostureObject(ctx, DevicePostureProcessTypes, DevicePostureProcess{
+			Name:   types.StringValue(settings.Process.Name),
+			Signer: types.StringPointerValue(settings.Process.Signer),
+		}, diags)
+	}
+
+	return state
+}
+
+//nolint:gocyclo
+func (r *devicePostureCheckResource) prepareSettings(ctx context.Context, plan DevicePostureCheckModel, diags *diag.Diagnostics,
+) *cato_models.DevicePostureCheckSettingsInput {
+	settings := &cato_models.DevicePostureCheckSettingsInput{}
+
+	switch devicePostureCheckType(plan) {
+	case devicePostureAntivirus:
+		var tfAntivirus DevicePostureAntivirus
+		if utils.CheckErr(diags, plan.Antivirus.As(ctx, &tfAntivirus, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.Antivirus = &cato_models.DevicePostureAntivirusInput{
+			MaxDefinitionsAgeDays: parse.KnownInt64Pointer(tfAntivirus.MaxDefinitionsAgeDays),
+			RealTimeProtection:    parse.KnownBoolPointer(tfAntivirus.RealTimeProtection),
+			Vendor:                parse.KnownStringPointer(tfAntivirus.Vendor),
+		}
+	case devicePostureFirewall:
+		var tfFirewall DevicePostureVendor
+		if utils.CheckErr(diags, plan.Firewall.As(ctx, &tfFirewall, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.Firewall = &cato_models.DevicePostureFirewallInput{Vendor: parse.KnownStringPointer(tfFirewall.Vendor)}
+	case devicePostureDiskEncryption:
+		var tfDiskEncryption DevicePostureVendor
+		if utils.CheckErr(diags, plan.DiskEncryption.As(ctx, &tfDiskEncryption, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.DiskEncryption = &cato_models.DevicePostureDiskEncryptionInput{Vendor: parse.KnownStringPointer(tfDiskEncryption.Vendor)}
+	case devicePostureOsVersion:
+		var tfOsVersion DevicePostureOsVersion
+		if utils.CheckErr(diags, plan.OsVersion.As(ctx, &tfOsVersion, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.OsVersion = &cato_models.DevicePostureOsVersionInput{MinVersion: tfOsVersion.MinVersion.ValueString()}
+	case devicePostureCertificate:
+		var tfCertificate DevicePostureCertificate
+		if utils.CheckErr(diags, plan.Certificate.As(ctx, &tfCertificate, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.Certificate = &cato_models.DevicePostureCertificateInput{
+			Issuer:  tfCertificate.Issuer.ValueString(),
+			Store:   (*cato_models.DevicePostureCertificateStore)(parse.KnownStringPointer(tfCertificate.Store)),
+			Subject: parse.KnownStringPointer(tfCertificate.Subject),
+		}
+	case devicePostureRegistry:
+		var tfRegistry DevicePostureRegistry
+		if utils.CheckErr(diags, plan.Registry.As(ctx, &tfRegistry, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.Registry = &cato_models.DevicePostureRegistryInput{
+			Path:      tfRegistry.Path.ValueString(),
+			ValueData: parse.KnownStringPointer(tfRegistry.ValueData),
+			ValueName: parse.KnownStringPointer(tfRegistry.ValueName),
+		}
+	case devicePostureProcess:
+		var tfProcess DevicePostureProcess
+		if utils.CheckErr(diags, plan.Process.As(ctx, &tfProcess, basetypes.ObjectAsOptions{})) {
+			return nil
+		}
+		settings.Process = &cato_models.DevicePostureProcessInput{
+			Name:   tfProcess.Name.ValueString(),
+			Signer: parse.KnownStringPointer(tfProcess.Signer),
+		}
+	}
+
+	return settings
+}
+
+// devicePostureCheckType returns the check type of the configured check block, empty when no block is set
+func devicePostureCheckType(m DevicePostureCheckModel) string {
+	blocks := []struct {
+		value     types.Object
+		checkType string
+	}{
+		{m.Antivirus, devicePostureAntivirus},
+		{m.Firewall, devicePostureFirewall},
+		{m.DiskEncryption, devicePostureDiskEncryption},
+		{m.OsVersion, devicePostureOsVersion},
+		{m.Certificate, devicePostureCertificate},
+		{m.Registry, devicePostureRegistry},
+		{m.Process, devicePostureProcess},
+	}
+	for _, block := range blocks {
+		if !block.value.IsNull() {
+			return block.checkType
+		}
+	}
+	return ""
+}
+
+func devicePostureObject(ctx context.Context, attrTypes map[string]attr.Type, value any, diags *diag.Diagnostics) types.Object {
+	obj, objDiags := types.ObjectValueFrom(ctx, attrTypes, value)
+	if utils.CheckErr(diags, objDiags) {
+		return types.ObjectNull(attrTypes)
+	}
+	return obj
+}
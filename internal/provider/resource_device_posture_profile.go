@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &devicePostureProfileResource{}
+	_ resource.ResourceWithConfigure   = &devicePostureProfileResource{}
+	_ resource.ResourceWithImportState = &devicePostureProfileResource{}
+)
+
+var ErrDevicePostureProfileNotFound = errors.New("device posture profile not found")
+
+func NewDevicePostureProfileResource() resource.Resource {
+	return &devicePostureProfileResource{}
+}
+
+type devicePostureProfileResource struct {
+	client *catoClientData
+}
+
+func (r *devicePostureProfileResource) clientData() **catoClientData { return &r.client }
+
+func (r *devicePostureProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_posture_profile"
+}
+
+func (r *devicePostureProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_device_posture_profile` resource contains the configuration parameters necessary to manage " +
+			"a device posture profile. A device matches the profile when it passes all of the profile checks. " +
+			"Profiles can be referenced by `id` or `name` in the `devices` attributes of the policy rules.",
+		Attributes: map[string]schema.Attribute{
+			"checks": schema.SetNestedAttribute{
+				Description: "Device posture checks the device must pass",
+				Required:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes:    parse.SchemaNameID("Device posture check"),
+					PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the device posture profile",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the device posture profile",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the device posture profile",
+				Required:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *devicePostureProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *devicePostureProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new device posture profile
+func (r *devicePostureProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan DevicePostureProfileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateDevicePostureProfileInput{
+		Checks:      parse.PrepareIDRefSet[cato_models.DevicePostureCheckRefInput](ctx, plan.Checks, &resp.Diagnostics),
+		Description: parse.KnownStringPointer(plan.Description),
+		Name:        plan.Name.ValueString(),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new device posture profile
+	tflog.Debug(ctx, "DevicePostureCreateProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureCreateProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureCreateProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DevicePostureCreateProfile error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetDevicePosture().GetCreateProfile().GetProfile().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDevicePostureProfileState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating device posture profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the device posture profile
+func (r *devicePostureProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state DevicePostureProfileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateDevicePostureProfileState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the device posture profile was found
+		if errors.Is(hydrateErr, ErrDevicePostureProfileNotFound) {
+			tflog.Warn(ctx, "device posture profile not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating device posture profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the device posture profile
+func (r *devicePostureProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state DevicePostureProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("DevicePostureUpdateProfile: ID is unknown", "Device posture profile ID is not set in TF state")
+		return
+	}
+
+	// the profile checks are replaced as a whole
+	input := cato_models.UpdateDevicePostureProfileInput{
+		Checks:      parse.PrepareIDRefSet[cato_models.DevicePostureCheckRefInput](ctx, plan.Checks, &resp.Diagnostics),
+		Description: parse.KnownStringPointer(plan.Description),
+		ID:          id,
+		Name:        parse.KnownStringPointer(plan.Name),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "DevicePostureUpdateProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureUpdateProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureUpdateProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DevicePostureUpdateProfile error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDevicePostureProfileState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating device posture profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the device posture profile
+func (r *devicePostureProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state DevicePostureProfileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteDevicePostureProfileInput{
+		Profile: &cato_models.DevicePostureProfileRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the device posture profile
+	tflog.Debug(ctx, "DevicePostureDeleteProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureDeleteProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureDeleteProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DevicePostureDeleteProfile error", err.Error())
+		return
+	}
+}
+
+// hydrateDevicePostureProfileState fetches the current state of a device posture profile from the API
+func (r *devicePostureProfileResource) hydrateDevicePostureProfileState(ctx context.Context, profileID string,
+) (*DevicePostureProfileModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.DevicePostureProfileRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: profileID,
+	}
+
+	// Call Cato API to get the device posture profile
+	tflog.Debug(ctx, "DevicePostureReadProfile", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DevicePostureReadProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DevicePostureReadProfile", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to DevicePostureProfileModel
+	profile := result.GetDevicePosture().GetProfile()
+	if profile == nil {
+		return nil, diags, ErrDevicePostureProfileNotFound
+	}
+
+	state := &DevicePostureProfileModel{
+		Checks:      parse.IDRefSet(ctx, profile.Checks, &diags),
+		Description: types.StringPointerValue(profile.Description),
+		ID:          types.StringValue(profile.ID),
+		Name:        types.StringValue(profile.Name),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
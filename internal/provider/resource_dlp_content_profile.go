@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &dlpContentProfileResource{}
+	_ resource.ResourceWithConfigure   = &dlpContentProfileResource{}
+	_ resource.ResourceWithImportState = &dlpContentProfileResource{}
+)
+
+var ErrDlpContentProfileNotFound = errors.New("DLP content profile not found")
+
+func NewDlpContentProfileResource() resource.Resource {
+	return &dlpContentProfileResource{}
+}
+
+type dlpContentProfileResource struct {
+	client *catoClientData
+}
+
+func (r *dlpContentProfileResource) clientData() **catoClientData { return &r.client }
+
+func (r *dlpContentProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dlp_content_profile"
+}
+
+func (r *dlpContentProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_dlp_content_profile` resource contains the configuration parameters necessary to manage " +
+			"a DLP content profile. The profile matches content by data type conditions, each with an occurrence threshold. " +
+			"Content profiles can be referenced by `id` or `name` in the `dlp_profile.content_profile` attribute of the application control data rules. " +
+			"The predefined data types are available in the `cato_dlp_data_types` data source.",
+		Attributes: map[string]schema.Attribute{
+			"conditions": schema.ListNestedAttribute{
+				Description: "Data type conditions of the profile",
+				Required:    true,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"data_type": schema.SingleNestedAttribute{
+							Description:   "Data type to match (predefined or custom)",
+							Required:      true,
+							Attributes:    parse.SchemaNameID("Data type"),
+							PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+						},
+						"threshold": schema.Int64Attribute{
+							Description: "Minimum number of occurrences of the data type for the condition to match (default 1)",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(1),
+							Validators:  []validator.Int64{int64validator.AtLeast(1)},
+						},
+					},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the DLP content profile",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the DLP content profile",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the DLP content profile",
+				Required:    true,
+			},
+			"operator": schema.StringAttribute{
+				Description: "How the conditions are combined: ANY (at least one condition matches) or ALL (default ANY)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(cato_models.DlpConditionOperatorAny)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(cato_models.DlpConditionOperatorAny), string(cato_models.DlpConditionOperatorAll)),
+				},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *dlpContentProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *dlpContentProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new DLP content profile
+func (r *dlpContentProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan DlpContentProfileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateDlpContentProfileInput{
+		Conditions:  r.prepareConditions(ctx, plan, &resp.Diagnostics),
+		Description: parse.KnownStringPointer(plan.Description),
+		Name:        plan.Name.ValueString(),
+		Operator:    cato_models.DlpConditionOperator(plan.Operator.ValueString()),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new DLP content profile
+	tflog.Debug(ctx, "DlpCreateContentProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DlpCreateContentProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpCreateContentProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DlpCreateContentProfile error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetDlp().GetCreateContentProfile().GetProfile().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDlpContentProfileState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating DLP content profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the DLP content profile
+func (r *dlpContentProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state DlpContentProfileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateDlpContentProfileState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the DLP content profile was found
+		if errors.Is(hydrateErr, ErrDlpContentProfileNotFound) {
+			tflog.Warn(ctx, "DLP content profile not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating DLP content profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the DLP content profile
+func (r *dlpContentProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state DlpContentProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("DlpUpdateContentProfile: ID is unknown", "DLP content profile ID is not set in TF state")
+		return
+	}
+
+	// the profile conditions are replaced as a whole
+	operator := cato_models.DlpConditionOperator(plan.Operator.ValueString())
+	input := cato_models.UpdateDlpContentProfileInput{
+		Conditions:  r.prepareConditions(ctx, plan, &resp.Diagnostics),
+		Description: parse.KnownStringPointer(plan.Description),
+		ID:          id,
+		Name:        parse.KnownStringPointer(plan.Name),
+		Operator:    &operator,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "DlpUpdateContentProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DlpUpdateContentProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpUpdateContentProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DlpUpdateContentProfile error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDlpContentProfileState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating DLP content profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the DLP content profile
+func (r *dlpContentProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state DlpContentProfileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteDlpContentProfileInput{
+		Profile: &cato_models.DlpContentProfileRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the DLP content profile
+	tflog.Debug(ctx, "DlpDeleteContentProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DlpDeleteContentProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpDeleteContentProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DlpDeleteContentProfile error", err.Error())
+		return
+	}
+}
+
+// hydrateDlpContentProfileState fetches the current state of a DLP content profile from the API
+func (r *dlpContentProfileResource) hydrateDlpContentProfileState(ctx context.Context, profileID string,
+) (*DlpContentProfileModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.DlpContentProfileRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: profileID,
+	}
+
+	// Call Cato API to get the DLP content profile
+	tflog.Debug(ctx, "DlpReadContentProfile", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DlpReadContentProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpReadContentProfile", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to DlpContentProfileModel
+	profile := result.GetDlp().GetContentProfile()
+	if profile == nil {
+		return nil, diags, ErrDlpContentProfileNotFound
+	}
+
+	conditions := make([]attr.Value, 0, len(profile.Conditions))
+	for _, c := range profile.Conditions {
+		if c == nil || c.DataType == nil {
+			continue
+		}
+		obj, objDiags := types.ObjectValue(DlpDataTypeConditionTypes, map[string]attr.Value{
+			"data_type": parse.IDRef(ctx, *c.DataType, &diags),
+			"threshold": types.Int64Value(c.Threshold),
+		})
+		diags.Append(objDiags...)
+		conditions = append(conditions, obj)
+	}
+	conditionList, listDiags := types.ListValue(types.ObjectType{AttrTypes: DlpDataTypeConditionTypes}, conditions)
+	diags.Append(listDiags...)
+
+	state := &DlpContentProfileModel{
+		Conditions:  conditionList,
+		Description: types.StringPointerValue(profile.Description),
+		ID:          types.StringValue(profile.ID),
+		Name:        types.StringValue(profile.Name),
+		Operator:    types.StringValue(profile.Operator.String()),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+func (r *dlpContentProfileResource) prepareConditions(ctx context.Context, plan DlpContentProfileModel, diags *diag.Diagnostics,
+) []*cato_models.DlpDataTypeConditionInput {
+	var tfConditions []DlpDataTypeCondition
+	if utils.CheckErr(diags, plan.Conditions.ElementsAs(ctx, &tfConditions, false)) {
+		return nil
+	}
+
+	conditions := make([]*cato_models.DlpDataTypeConditionInput, 0, len(tfConditions))
+	for _, c := range tfConditions {
+		conditions = append(conditions, &cato_models.DlpDataTypeConditionInput{
+			DataType:  parse.PrepareIDRef[cato_models.DlpDataTypeRefInput](ctx, c.DataType, diags),
+			Threshold: c.Threshold.ValueInt64(),
+		})
+	}
+	return conditions
+}
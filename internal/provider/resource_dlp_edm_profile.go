@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &dlpEdmProfileResource{}
+	_ resource.ResourceWithConfigure   = &dlpEdmProfileResource{}
+	_ resource.ResourceWithImportState = &dlpEdmProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &dlpEdmProfileResource{}
+)
+
+var ErrDlpEdmProfileNotFound = errors.New("DLP EDM profile not found")
+
+func NewDlpEdmProfileResource() resource.Resource {
+	return &dlpEdmProfileResource{}
+}
+
+type dlpEdmProfileResource struct {
+	client *catoClientData
+}
+
+func (r *dlpEdmProfileResource) clientData() **catoClientData { return &r.client }
+
+// edmDataset is the hashed content of an EDM source file
+type edmDataset struct {
+	Columns []string
+	Rows    [][]string // SHA-256 hashes of the normalized values, empty values are kept empty
+	Sha256  string     // SHA-256 of the whole source file, used to detect changes
+}
+
+func (r *dlpEdmProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dlp_edm_profile"
+}
+
+func (r *dlpEdmProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_dlp_edm_profile` resource contains the configuration parameters necessary to manage " +
+			"a DLP Exact Data Match (EDM) profile. The dataset is read from a local CSV file; every value is normalized " +
+			"(trimmed, whitespace collapsed, lower-cased) and SHA-256 hashed by the provider, so the raw data never leaves the machine. " +
+			"The dataset is uploaded again whenever the file content changes. " +
+			"EDM profiles can be referenced by `id` or `name` in the `dlp_profile.edm_profile` attribute of the application control data rules.",
+		Attributes: map[string]schema.Attribute{
+			"columns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Column names of the dataset, taken from the header row (or column_1, column_2, ... without a header)",
+				Computed:    true,
+			},
+			"delimiter": schema.StringAttribute{
+				Description: "Field delimiter of the source file (default \",\")",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(","),
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 1)},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the DLP EDM profile",
+				Optional:    true,
+			},
+			"has_header": schema.BoolAttribute{
+				Description: "The first row of the source file contains the column names (default true)",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the DLP EDM profile",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the DLP EDM profile",
+				Required:    true,
+			},
+			"row_count": schema.Int64Attribute{
+				Description: "Number of data rows in the dataset",
+				Computed:    true,
+			},
+			"source_file": schema.StringAttribute{
+				Description: "Path to the local CSV file with the dataset",
+				Required:    true,
+			},
+			"source_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the source file content, a change triggers a new upload of the dataset",
+				Computed:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *dlpEdmProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *dlpEdmProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan reads and hashes the source file, so that a change of the file content is shown in the plan
+func (r *dlpEdmProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() { // resource destruction
+		return
+	}
+
+	var plan DlpEdmProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.SourceFile.IsUnknown() || plan.Delimiter.IsUnknown() || plan.HasHeader.IsUnknown() {
+		return
+	}
+
+	dataset, err := readEdmDataset(plan.SourceFile.ValueString(), plan.Delimiter.ValueString(), plan.HasHeader.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_file"), "Invalid EDM source file", err.Error())
+		return
+	}
+
+	plan.Columns = parse.StringListFunc(ctx, dataset.Columns, func(s string) string { return s }, &resp.Diagnostics)
+	plan.RowCount = types.Int64Value(int64(len(dataset.Rows)))
+	plan.SourceSha256 = types.StringValue(dataset.Sha256)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create a new DLP EDM profile
+func (r *dlpEdmProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan DlpEdmProfileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataset := r.prepareDataset(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.CreateDlpEdmProfileInput{
+		Dataset:     dataset,
+		Description: parse.KnownStringPointer(plan.Description),
+		Name:        plan.Name.ValueString(),
+	}
+
+	// Call Cato API to create a new DLP EDM profile, the dataset rows are not logged
+	tflog.Debug(ctx, "DlpCreateEdmProfile", map[string]interface{}{"name": input.Name, "rows": len(dataset.Rows)})
+	result, err := r.client.catov2.DlpCreateEdmProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpCreateEdmProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DlpCreateEdmProfile error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetDlp().GetCreateEdmProfile().GetProfile().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDlpEdmProfileState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating DLP EDM profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the DLP EDM profile
+func (r *dlpEdmProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state DlpEdmProfileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateDlpEdmProfileState(ctx, state)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the DLP EDM profile was found
+		if errors.Is(hydrateErr, ErrDlpEdmProfileNotFound) {
+			tflog.Warn(ctx, "DLP EDM profile not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating DLP EDM profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the DLP EDM profile, the dataset is uploaded only when the file content or its format changed
+func (r *dlpEdmProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state DlpEdmProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("DlpUpdateEdmProfile: ID is unknown", "DLP EDM profile ID is not set in TF state")
+		return
+	}
+
+	input := cato_models.UpdateDlpEdmProfileInput{
+		Description: parse.KnownStringPointer(plan.Description),
+		ID:          id,
+		Name:        parse.KnownStringPointer(plan.Name),
+	}
+	if !plan.SourceSha256.Equal(state.SourceSha256) || !plan.Delimiter.Equal(state.Delimiter) || !plan.HasHeader.Equal(state.HasHeader) {
+		input.Dataset = r.prepareDataset(plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "DlpUpdateEdmProfile", map[string]interface{}{"id": id, "dataset": input.Dataset != nil})
+	result, err := r.client.catov2.DlpUpdateEdmProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpUpdateEdmProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DlpUpdateEdmProfile error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateDlpEdmProfileState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating DLP EDM profile state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the DLP EDM profile
+func (r *dlpEdmProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state DlpEdmProfileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteDlpEdmProfileInput{
+		Profile: &cato_models.DlpEdmProfileRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the DLP EDM profile
+	tflog.Debug(ctx, "DlpDeleteEdmProfile", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DlpDeleteEdmProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpDeleteEdmProfile", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API DlpDeleteEdmProfile error", err.Error())
+		return
+	}
+}
+
+// hydrateDlpEdmProfileState fetches the current state of a DLP EDM profile from the API;
+// the source file attributes are not known to the API and are kept from the prior state or plan
+func (r *dlpEdmProfileResource) hydrateDlpEdmProfileState(ctx context.Context, prior DlpEdmProfileModel,
+) (*DlpEdmProfileModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.DlpEdmProfileRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: prior.ID.ValueString(),
+	}
+
+	// Call Cato API to get the DLP EDM profile
+	tflog.Debug(ctx, "DlpReadEdmProfile", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.DlpReadEdmProfile(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "DlpReadEdmProfile", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to DlpEdmProfileModel
+	profile := result.GetDlp().GetEdmProfile()
+	if profile == nil {
+		return nil, diags, ErrDlpEdmProfileNotFound
+	}
+
+	state := &DlpEdmProfileModel{
+		Columns:      parse.StringListFunc(ctx, profile.Columns, func(s string) string { return s }, &diags),
+		Delimiter:    prior.Delimiter,
+		Description:  types.StringPointerValue(profile.Description),
+		HasHeader:    prior.HasHeader,
+		ID:           types.StringValue(profile.ID),
+		Name:         types.StringValue(profile.Name),
+		RowCount:     types.Int64Value(profile.RowCount),
+		SourceFile:   prior.SourceFile,
+		SourceSha256: prior.SourceSha256,
+	}
+	// imported profiles have no format settings yet
+	if state.Delimiter.IsNull() {
+		state.Delimiter = types.StringValue(",")
+	}
+	if state.HasHeader.IsNull() {
+		state.HasHeader = types.BoolValue(true)
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+func (r *dlpEdmProfileResource) prepareDataset(plan DlpEdmProfileModel, diags *diag.Diagnostics) *cato_models.DlpEdmDatasetInput {
+	dataset, err := readEdmDataset(plan.SourceFile.ValueString(), plan.Delimiter.ValueString(), plan.HasHeader.ValueBool())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Invalid EDM source file", err.Error())
+		return nil
+	}
+	if dataset.Sha256 != plan.SourceSha256.ValueString() {
+		diags.AddAttributeError(path.Root("source_file"), "EDM source file changed",
+			"the content of the source file changed after the plan was created, run the plan again")
+		return nil
+	}
+
+	rows := make([]*cato_models.DlpEdmRowInput, 0, len(dataset.Rows))
+	for _, row := range dataset.Rows {
+		rows = append(rows, &cato_models.DlpEdmRowInput{Values: row})
+	}
+	return &cato_models.DlpEdmDatasetInput{
+		Columns: dataset.Columns,
+		Rows:    rows,
+	}
+}
+
+// readEdmDataset reads the EDM source file and hashes its content
+func readEdmDataset(fileName, delimiter string, hasHeader bool) (*edmDataset, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	comma, _ := utf8.DecodeRuneInString(delimiter)
+	return hashEdmDataset(data, comma, hasHeader)
+}
+
+// hashEdmDataset parses the CSV data and replaces every value by the SHA-256 of its normalized form
+func hashEdmDataset(data []byte, delimiter rune, hasHeader bool) (*edmDataset, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = delimiter
+	reader.TrimLeadingSpace = true
+
+	fileSum := sha256.Sum256(data)
+	dataset := &edmDataset{Sha256: hex.EncodeToString(fileSum[:])}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if dataset.Columns == nil {
+			if hasHeader {
+				dataset.Columns = make([]string, 0, len(record))
+				for _, name := range record {
+					dataset.Columns = append(dataset.Columns, strings.TrimSpace(name))
+				}
+				continue
+			}
+			dataset.Columns = make([]string, 0, len(record))
+			for i := range record {
+				dataset.Columns = append(dataset.Columns, fmt.Sprintf("column_%d", i+1))
+			}
+		}
+
+		row := make([]string, 0, len(record))
+		for _, value := range record {
+			row = append(row, hashEdmValue(value))
+		}
+		dataset.Rows = append(dataset.Rows, row)
+	}
+
+	if len(dataset.Rows) == 0 {
+		return nil, errors.New("the dataset has no data rows")
+	}
+	return dataset, nil
+}
+
+// normalizeEdmValue trims the value, collapses inner whitespace and lower-cases it,
+// so that the hashes match regardless of formatting differences
+func normalizeEdmValue(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), " "))
+}
+
+func hashEdmValue(value string) string {
+	normalized := normalizeEdmValue(value)
+	if normalized == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
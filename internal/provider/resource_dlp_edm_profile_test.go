@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEdmValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"john.doe@example.com":     "john.doe@example.com",
+		"  John.Doe@Example.COM  ": "john.doe@example.com",
+		"John \t  Doe":             "john doe",
+		"":                         "",
+		"   ":                      "",
+		"123-45-6789":              "123-45-6789",
+		"Line1\nLine2":             "line1 line2",
+		"ÉCOLE":                    "école",
+	}
+	for in, want := range tests {
+		require.Equal(t, want, normalizeEdmValue(in), "input %q", in)
+	}
+}
+
+func TestHashEdmDataset(t *testing.T) {
+	t.Parallel()
+
+	sha := func(s string) string {
+		sum := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(sum[:])
+	}
+
+	t.Run("with header", func(t *testing.T) {
+		t.Parallel()
+		data := []byte("name, email\nJohn Doe,John.Doe@example.com\n  jane   doe , \n")
+		ds, err := hashEdmDataset(data, ',', true)
+		require.NoError(t, err)
+		require.Equal(t, []string{"name", "email"}, ds.Columns)
+		require.Equal(t, [][]string{
+			{sha("john doe"), sha("john.doe@example.com")},
+			{sha("jane doe"), ""},
+		}, ds.Rows)
+		require.Equal(t, sha(string(data)), ds.Sha256)
+	})
+
+	t.Run("without header", func(t *testing.T) {
+		t.Parallel()
+		ds, err := hashEdmDataset([]byte("a;b;c\nd;e;f\n"), ';', false)
+		require.NoError(t, err)
+		require.Equal(t, []string{"column_1", "column_2", "column_3"}, ds.Columns)
+		require.Len(t, ds.Rows, 2)
+		require.Equal(t, sha("a"), ds.Rows[0][0])
+	})
+
+	t.Run("formatting does not change value hashes", func(t *testing.T) {
+		t.Parallel()
+		ds1, err := hashEdmDataset([]byte("id\nABC 123\n"), ',', true)
+		require.NoError(t, err)
+		ds2, err := hashEdmDataset([]byte("id\n  abc   123\n"), ',', true)
+		require.NoError(t, err)
+		require.Equal(t, ds1.Rows, ds2.Rows)
+		require.NotEqual(t, ds1.Sha256, ds2.Sha256)
+	})
+
+	t.Run("header only", func(t *testing.T) {
+		t.Parallel()
+		_, err := hashEdmDataset([]byte("name,email\n"), ',', true)
+		require.Error(t, err)
+	})
+
+	t.Run("inconsistent field count", func(t *testing.T) {
+		t.Parallel()
+		_, err := hashEdmDataset([]byte("a,b\nc\n"), ',', false)
+		require.Error(t, err)
+	})
+}
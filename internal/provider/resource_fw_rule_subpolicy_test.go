@@ -103,8 +103,9 @@ func TestInternetFwRuleImportStateComposite(t *testing.T) {
 		State: tfsdk.State{Schema: getInternetFwRuleSchema(ctx, t)},
 	}
 	diags := resp.State.Set(ctx, InternetFirewallRule{
-		Rule: types.ObjectNull(InternetFirewallRuleRuleAttrTypes),
-		At:   types.ObjectNull(PositionAttrTypes),
+		Rule:            types.ObjectNull(InternetFirewallRuleRuleAttrTypes),
+		At:              types.ObjectNull(PositionAttrTypes),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	})
 	if diags.HasError() {
 		t.Fatalf("unexpected seed state diagnostics: %+v", diags)
@@ -135,8 +136,9 @@ func TestInternetFwRuleImportStateCompositeInvalid(t *testing.T) {
 	r := &internetFwRuleResource{}
 	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: getInternetFwRuleSchema(ctx, t)}}
 	_ = resp.State.Set(ctx, InternetFirewallRule{
-		Rule: types.ObjectNull(InternetFirewallRuleRuleAttrTypes),
-		At:   types.ObjectNull(PositionAttrTypes),
+		Rule:            types.ObjectNull(InternetFirewallRuleRuleAttrTypes),
+		At:              types.ObjectNull(PositionAttrTypes),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	})
 
 	r.ImportState(ctx, resource.ImportStateRequest{ID: "sub-42/"}, resp)
@@ -33,6 +33,8 @@ type globalIPRangesResource struct {
 	client *catoClientData
 }
 
+func (r *globalIPRangesResource) clientData() **catoClientData { return &r.client }
+
 type ipRangePlanDetails struct {
 	toCreate []tf.GlobalIPRange
 	toUpdate []tf.GlobalIPRange
@@ -77,6 +79,7 @@ func (r *globalIPRangesResource) Schema(_ context.Context, _ resource.SchemaRequ
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -128,6 +131,7 @@ func (r *globalIPRangesResource) ModifyPlan(ctx context.Context, req resource.Mo
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.AccountID = cfg.AccountID
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
@@ -140,7 +144,10 @@ func (r *globalIPRangesResource) Configure(_ context.Context, req resource.Confi
 	r.client = req.ProviderData.(*catoClientData)
 }
 
-func (r *globalIPRangesResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *globalIPRangesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	accountID := importAccountID(ctx, &req, resp)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
 	if state := r.hydrate(ctx, &resp.Diagnostics); state != nil {
 		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 	}
@@ -148,6 +155,10 @@ func (r *globalIPRangesResource) ImportState(ctx context.Context, _ resource.Imp
 
 // Create creates global IP ranges in bulk.
 func (r *globalIPRangesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan tf.GlobalIPRangesModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -188,7 +199,11 @@ func (r *globalIPRangesResource) Create(ctx context.Context, req resource.Create
 }
 
 // Read the global IP ranges, this is needed to refresh the state after create/update and for import
-func (r *globalIPRangesResource) Read(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+func (r *globalIPRangesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	state := r.hydrate(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -200,6 +215,10 @@ func (r *globalIPRangesResource) Read(ctx context.Context, _ resource.ReadReques
 // Update the IP ranges
 // based on the config and state, figure out which ranges need to be created, updated or deleted and call the respective APIs
 func (r *globalIPRangesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var cfg, state *tf.GlobalIPRangesModel
 	var cfgRanges, stateRanges []tf.GlobalIPRange
 
@@ -267,6 +286,9 @@ func (r *globalIPRangesResource) Update(ctx context.Context, req resource.Update
 
 // Delete all the global IP ranges in the state
 func (r *globalIPRangesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state *tf.GlobalIPRangesModel
 	var stateRanges []tf.GlobalIPRange
 
@@ -0,0 +1,1061 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+const ifPolicyDocumentID = "internet_firewall"
+
+var (
+	_ resource.Resource               = &ifPolicyDocumentResource{}
+	_ resource.ResourceWithConfigure  = &ifPolicyDocumentResource{}
+	_ resource.ResourceWithModifyPlan = &ifPolicyDocumentResource{}
+)
+
+func NewIfPolicyDocumentResource() resource.Resource {
+	return &ifPolicyDocumentResource{}
+}
+
+type ifPolicyDocumentResource struct {
+	client *catoClientData
+	ifwDoc InternetFirewallPolicyDocumentClient // optional override for tests
+}
+
+func (r *ifPolicyDocumentResource) clientData() **catoClientData { return &r.client }
+
+func (r *ifPolicyDocumentResource) policyClient() InternetFirewallPolicyDocumentClient {
+	if r.ifwDoc != nil {
+		return r.ifwDoc
+	}
+	return r.client.catov2
+}
+
+func (r *ifPolicyDocumentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_if_policy_document"
+}
+
+func (r *ifPolicyDocumentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Reuse the cato_if_rule "rule" schema so a document rule takes the same parameters;
+	// the rule name is the key of the rules map
+	var ruleSchema resource.SchemaResponse
+	(&internetFwRuleResource{}).Schema(ctx, resource.SchemaRequest{}, &ruleSchema)
+	ruleAttributes := maps.Clone(ruleSchema.Schema.Attributes["rule"].(schema.SingleNestedAttribute).Attributes)
+	delete(ruleAttributes, "name")
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_if_policy_document` resource owns the whole Internet Firewall policy: its sections, " +
+			"the order of the sections and the rules in each of them. On apply the provider computes the changes " +
+			"(create, update, move and delete) against the current policy and publishes them in a single policy revision; " +
+			"when a change fails the revision is discarded. " +
+			"Rules and sections not in the document are reported as drift in `unmanaged_rules` and `unmanaged_sections` when Terraform plans; " +
+			"they are kept in the policy unless `delete_unmanaged` is set, in which case the ones listed in the plan are deleted on apply. " +
+			"System rules and sub-policies keep their position and are never managed by the document. " +
+			"Do not combine this resource with `cato_if_rule`, `cato_if_section` or `cato_bulk_if_move_rule`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Fixed identifier of the policy (" + ifPolicyDocumentID + ")",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sections": schema.ListNestedAttribute{
+				Description: "Sections of the policy, in policy order",
+				Required:    true,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Section ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Section name",
+							Required:    true,
+						},
+						"rules": schema.ListAttribute{
+							Description: "Names of the rules of the section, in policy order; each name is a key of `rules`",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"rules": schema.MapNestedAttribute{
+				Description: "Rules of the policy by rule name, taking the parameters of the `cato_if_rule` rule " +
+					"(https://api.catonetworks.com/documentation/#definition-InternetFirewallAddRuleDataInput). " +
+					"Renaming a rule deletes it and creates a new one.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ruleAttributes,
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				Description: "Delete the rules and sections listed in `unmanaged_rules` and `unmanaged_sections` on apply (default false, they are kept in the policy)",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"unmanaged_rules": schema.ListAttribute{
+				Description: "Names of the rules of the policy that are not in the document, as of the last plan or refresh; " +
+					"they are deleted on apply when `delete_unmanaged` is set",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"unmanaged_sections": schema.ListAttribute{
+				Description: "Names of the sections of the policy that are not in the document, as of the last plan or refresh; " +
+					"they are deleted on apply when `delete_unmanaged` is set",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *ifPolicyDocumentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+// ModifyPlan validates the document and lists the unmanaged rules and sections of the live policy,
+// which apply deletes when delete_unmanaged is set
+func (r *ifPolicyDocumentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan IfPolicyDocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state IfPolicyDocumentModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if plan.Sections.IsUnknown() || plan.Rules.IsUnknown() {
+		return
+	}
+
+	doc, known, diags := ifPolicyDocumentSections(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if known {
+		if err := validateIfPolicyDocument(doc, slices.Sorted(maps.Keys(plan.Rules.Elements()))); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("sections"), "Invalid Internet Firewall policy document", err.Error())
+			return
+		}
+	}
+
+	// section IDs follow the section names, as the list index of a section changes when sections are moved
+	stateSectionIDs := map[string]types.String{}
+	if !state.Sections.IsNull() && !state.Sections.IsUnknown() {
+		var stateSections []IfPolicyDocumentSection
+		resp.Diagnostics.Append(state.Sections.ElementsAs(ctx, &stateSections, false)...)
+		for _, sec := range stateSections {
+			stateSectionIDs[sec.Name.ValueString()] = sec.ID
+		}
+	}
+	var planSections []IfPolicyDocumentSection
+	resp.Diagnostics.Append(plan.Sections.ElementsAs(ctx, &planSections, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, sec := range planSections {
+		id, ok := stateSectionIDs[sec.Name.ValueString()]
+		if !ok || sec.Name.IsUnknown() {
+			id = types.StringUnknown()
+		}
+		planSections[i].ID = id
+	}
+	plan.Sections, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: IfPolicyDocumentSectionTypes}, planSections)
+	resp.Diagnostics.Append(diags...)
+
+	// list what the document leaves out of the live policy, so the plan shows what apply keeps or deletes
+	plan.UnmanagedRules = types.ListUnknown(types.StringType)
+	plan.UnmanagedSections = types.ListUnknown(types.StringType)
+	if known && r.client != nil {
+		snapshot, err := scopedToAccount(ctx, r, accountIDOverride(ctx, req.Plan)).readIfPolicySnapshot(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Cato API PolicyInternetFirewall error", err.Error())
+			return
+		}
+		unmanagedRules, unmanagedSections := ifPolicyDocumentUnmanaged(doc, snapshot.sections, snapshot.rules)
+		plan.UnmanagedRules, diags = types.ListValueFrom(ctx, types.StringType, policyRuleNames(unmanagedRules))
+		resp.Diagnostics.Append(diags...)
+		plan.UnmanagedSections, diags = types.ListValueFrom(ctx, types.StringType, policySectionNames(unmanagedSections))
+		resp.Diagnostics.Append(diags...)
+		if plan.DeleteUnmanaged.ValueBool() && (len(unmanagedRules) > 0 || len(unmanagedSections) > 0) {
+			resp.Diagnostics.AddWarning("Unmanaged Internet Firewall rules and sections will be deleted",
+				fmt.Sprintf("delete_unmanaged is set: apply deletes the rules %v and the sections %v, which are not in the document.",
+					policyRuleNames(unmanagedRules), policySectionNames(unmanagedSections)))
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create adopts the policy and applies the document
+func (r *ifPolicyDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan IfPolicyDocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyIfPolicyDocument(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags, hydrateErr := r.hydrateIfPolicyDocumentState(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if hydrateErr != nil {
+		resp.Diagnostics.AddError("Error hydrating if_policy_document state", hydrateErr.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keepPlannedIfPolicyDocumentUnmanaged(plan, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ifPolicyDocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state IfPolicyDocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags, hydrateErr := r.hydrateIfPolicyDocumentState(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if hydrateErr != nil {
+		resp.Diagnostics.AddError("Error hydrating if_policy_document state", hydrateErr.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *ifPolicyDocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state IfPolicyDocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyIfPolicyDocument(ctx, plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags, hydrateErr := r.hydrateIfPolicyDocumentState(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if hydrateErr != nil {
+		resp.Diagnostics.AddError("Error hydrating if_policy_document state", hydrateErr.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keepPlannedIfPolicyDocumentUnmanaged(plan, newState)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// keepPlannedIfPolicyDocumentUnmanaged stores the unmanaged rules and sections listed in the plan,
+// which the apply either kept or deleted; the next refresh lists the ones left in the policy.
+func keepPlannedIfPolicyDocumentUnmanaged(plan IfPolicyDocumentModel, state *IfPolicyDocumentModel) {
+	if !plan.UnmanagedRules.IsUnknown() {
+		state.UnmanagedRules = plan.UnmanagedRules
+	}
+	if !plan.UnmanagedSections.IsUnknown() {
+		state.UnmanagedSections = plan.UnmanagedSections
+	}
+}
+
+// Delete removes the rules and sections of the document and publishes the policy
+func (r *ifPolicyDocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state IfPolicyDocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.readIfPolicySnapshot(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API PolicyInternetFirewall error", err.Error())
+		return
+	}
+	doc, _, diags := ifPolicyDocumentSections(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an empty document removes the rules and sections of the state, the rest is left as is
+	docRules := map[string]struct{}{}
+	docSections := map[string]struct{}{}
+	for _, sec := range doc {
+		docSections[sec.name] = struct{}{}
+		for _, name := range sec.rules {
+			docRules[name] = struct{}{}
+		}
+	}
+	for _, row := range snapshot.rules {
+		if _, ok := docRules[row.RuleName]; !ok || row.IsSystem || row.SectionID == "" {
+			continue
+		}
+		if r.removeIfPolicyDocumentRule(ctx, row, &resp.Diagnostics) {
+			r.discardIfPolicyDocument(ctx, &resp.Diagnostics)
+			return
+		}
+	}
+	protected := protectedPolicySectionIDs(snapshot.rules)
+	for _, sec := range snapshot.sections {
+		if _, ok := docSections[sec.Name]; !ok {
+			continue
+		}
+		if _, ok := protected[sec.ID]; ok {
+			resp.Diagnostics.AddWarning("Internet firewall section not deleted",
+				fmt.Sprintf("Section %q contains a system rule or a sub-policy and was left in the policy.", sec.Name))
+			continue
+		}
+		if r.removeIfPolicyDocumentSection(ctx, sec, &resp.Diagnostics) {
+			r.discardIfPolicyDocument(ctx, &resp.Diagnostics)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.publishIfPolicyDocument(ctx)...)
+	if resp.Diagnostics.HasError() {
+		r.discardIfPolicyDocument(ctx, &resp.Diagnostics)
+	}
+}
+
+// ifPolicySnapshot is the current structure of the Internet Firewall policy
+type ifPolicySnapshot struct {
+	policy   *cato_go_sdk.Policy
+	sections []BulkPolicySectionRef
+	rules    []BulkPolicyRuleRow // sub-policy rules are marked as system rules, they are never moved nor removed
+}
+
+func (r *ifPolicyDocumentResource) readIfPolicySnapshot(ctx context.Context) (*ifPolicySnapshot, error) {
+	policy, err := r.policyClient().PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, r.client.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	subPolicyRuleIDs := map[string]struct{}{}
+	for _, rp := range policy.GetPolicy().GetInternetFirewall().GetPolicy().GetRules() {
+		rt := rp.GetRuleType()
+		if (rt != nil && *rt == subPolicyScopeRuleType) || (rp.GetSubPolicy() != nil && rp.GetSubPolicy().GetID() != "") {
+			subPolicyRuleIDs[rp.GetRule().GetID()] = struct{}{}
+		}
+	}
+
+	sectionsIndex, err := r.policyClient().PolicyInternetFirewallSectionsIndex(ctx, r.client.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	rulesIndex, err := r.policyClient().PolicyInternetFirewallRulesIndex(ctx, r.client.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &ifPolicySnapshot{policy: policy}
+	for _, item := range sectionsIndex.Policy.InternetFirewall.Policy.Sections {
+		snapshot.sections = append(snapshot.sections, BulkPolicySectionRef{ID: item.Section.ID, Name: item.Section.Name})
+	}
+	for _, item := range rulesIndex.Policy.InternetFirewall.Policy.Rules {
+		_, subPolicyRule := subPolicyRuleIDs[item.Rule.ID]
+		snapshot.rules = append(snapshot.rules, BulkPolicyRuleRow{
+			SectionID:   item.Rule.Section.ID,
+			SectionName: item.Rule.Section.Name,
+			RuleID:      item.Rule.ID,
+			RuleName:    item.Rule.Name,
+			Index:       item.Rule.Index,
+			IsSystem:    subPolicyRule || policyElementHasProperty(item.Properties, cato_models.PolicyElementPropertiesEnumSystem),
+		})
+	}
+	return snapshot, nil
+}
+
+// applyIfPolicyDocument applies the changes of the document to the policy draft and publishes it.
+// Rules equal in the plan and the prior state are not updated; without a prior state every rule is.
+func (r *ifPolicyDocumentResource) applyIfPolicyDocument(ctx context.Context, plan IfPolicyDocumentModel, state *IfPolicyDocumentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc, _, d := ifPolicyDocumentSections(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	planRules := plan.Rules.Elements()
+	unchanged := map[string]bool{}
+	if state != nil {
+		stateRules := state.Rules.Elements()
+		for name, rule := range planRules {
+			if stateRule, ok := stateRules[name]; ok && stateRule.Equal(rule) {
+				unchanged[name] = true
+			}
+		}
+	}
+
+	snapshot, err := r.readIfPolicySnapshot(ctx)
+	if err != nil {
+		diags.AddError("Cato API PolicyInternetFirewall error", err.Error())
+		return diags
+	}
+	changes, err := planIfPolicyDocument(doc, snapshot.sections, snapshot.rules, unchanged)
+	if err != nil {
+		diags.AddError("Invalid Internet Firewall policy document", err.Error())
+		return diags
+	}
+	var removeRules, removeSections []string
+	if plan.DeleteUnmanaged.ValueBool() {
+		diags.Append(plan.UnmanagedRules.ElementsAs(ctx, &removeRules, false)...)
+		diags.Append(plan.UnmanagedSections.ElementsAs(ctx, &removeSections, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	changes.limitRemovals(removeRules, removeSections)
+	tflog.Debug(ctx, "ifPolicyDocument changes", map[string]interface{}{
+		"add_sections":    changes.addSections,
+		"remove_sections": len(changes.removeSections),
+		"add_rules":       len(changes.addRules),
+		"update_rules":    len(changes.updateRules),
+		"remove_rules":    len(changes.removeRules),
+	})
+
+	diags.Append(r.applyIfPolicyDocumentChanges(ctx, doc, planRules, snapshot, changes)...)
+	return diags
+}
+
+// applyIfPolicyDocumentChanges sends the changes to the policy draft, then orders and publishes it.
+// On failure the draft is discarded, so a later publish does not ship a half-applied document.
+//
+//nolint:gocyclo,funlen
+func (r *ifPolicyDocumentResource) applyIfPolicyDocumentChanges(
+	ctx context.Context,
+	doc []ifPolicyDocumentSection,
+	planRules map[string]attr.Value,
+	snapshot *ifPolicySnapshot,
+	changes ifPolicyDocumentChanges,
+) (diags diag.Diagnostics) {
+	defer func() {
+		if diags.HasError() {
+			r.discardIfPolicyDocument(ctx, &diags)
+		}
+	}()
+
+	for _, row := range changes.removeRules {
+		if r.removeIfPolicyDocumentRule(ctx, row, &diags) {
+			return diags
+		}
+	}
+	for _, sec := range changes.removeSections {
+		if r.removeIfPolicyDocumentSection(ctx, sec, &diags) {
+			return diags
+		}
+	}
+
+	sectionIDs := map[string]string{}
+	for _, sec := range snapshot.sections {
+		sectionIDs[sec.Name] = sec.ID
+	}
+	for _, name := range changes.addSections {
+		input := cato_models.PolicyAddSectionInput{
+			At:      &cato_models.PolicySectionPositionInput{Position: cato_models.PolicySectionPositionEnumLastInPolicy},
+			Section: &cato_models.PolicyAddSectionInfoInput{Name: name},
+		}
+		tflog.Debug(ctx, "PolicyInternetFirewallAddSection", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.policyClient().PolicyInternetFirewallAddSection(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input, r.client.AccountId)
+		tflog.Debug(ctx, "PolicyInternetFirewallAddSection", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		if err != nil {
+			diags.AddError("Cato API PolicyInternetFirewallAddSection error", err.Error())
+			return diags
+		}
+		if errs := result.GetPolicy().GetInternetFirewall().GetAddSection().GetErrors(); len(errs) > 0 {
+			for _, e := range errs {
+				diags.AddError("API Error Creating Section "+name, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+			return diags
+		}
+		sectionIDs[name] = result.GetPolicy().GetInternetFirewall().GetAddSection().Section.GetSection().ID
+	}
+
+	for _, row := range changes.updateRules {
+		input, d := ifPolicyDocumentRuleInput(ctx, row.RuleName, planRules[row.RuleName])
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		input.update.ID = row.RuleID
+		tflog.Debug(ctx, "PolicyInternetFirewallUpdateRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input.update)})
+		result, err := r.policyClient().PolicyInternetFirewallUpdateRule(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input.update, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Cato API PolicyInternetFirewallUpdateRule error", err.Error())
+			return diags
+		}
+		if result.Policy.InternetFirewall.UpdateRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.InternetFirewall.UpdateRule.GetErrors() {
+				diags.AddError("API Error Updating Rule "+row.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+			return diags
+		}
+	}
+
+	lastInSection := cato_models.PolicyRulePositionEnumLastInSection
+	for _, placement := range changes.addRules {
+		input, d := ifPolicyDocumentRuleInput(ctx, placement.RuleName, planRules[placement.RuleName])
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		sectionID := sectionIDs[placement.SectionName]
+		input.create.At = &cato_models.PolicyRulePositionInput{Position: &lastInSection, Ref: &sectionID}
+		tflog.Debug(ctx, "PolicyInternetFirewallAddRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input.create)})
+		result, err := r.policyClient().PolicyInternetFirewallAddRule(ctx, input.create, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Cato API PolicyInternetFirewallAddRule error", err.Error())
+			return diags
+		}
+		if result.Policy.InternetFirewall.AddRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.InternetFirewall.AddRule.GetErrors() {
+				diags.AddError("API Error Creating Rule "+placement.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+			return diags
+		}
+	}
+
+	// order the sections, then the rules, from a fresh snapshot holding the new rules and sections
+	snapshot, err := r.readIfPolicySnapshot(ctx)
+	if err != nil {
+		diags.AddError("Cato API PolicyInternetFirewall error", err.Error())
+		return diags
+	}
+	if !policySectionOrderMatches(snapshot.sections, doc) {
+		sectionData := make([]IfwRulesSectionDataIndex, 0, len(doc))
+		for i, sec := range doc {
+			sectionData = append(sectionData, IfwRulesSectionDataIndex{SectionIndex: int64(i + 1), SectionName: sec.name})
+		}
+		for _, move := range buildIfwSectionMoves(sectionData, sectionIDs, protectedPolicySectionIDs(snapshot.rules), "") {
+			if move.protected {
+				diags.AddWarning("Internet firewall section move skipped", fmt.Sprintf(
+					"Section %q contains a system rule and cannot be moved; its current policy position was preserved.",
+					move.section.SectionName,
+				))
+				continue
+			}
+			moveErr := withPolicyRevisionConflictRetry(ctx, "PolicyInternetFirewallMoveSection", func() error {
+				result, callErr := r.policyClient().PolicyInternetFirewallMoveSection(ctx, nil, move.input, r.client.AccountId)
+				return internetFirewallMoveSectionError(result, callErr)
+			})
+			if moveErr != nil {
+				diags.AddError("Cato API PolicyInternetFirewallMoveSection error", moveErr.Error())
+				return diags
+			}
+		}
+		if snapshot, err = r.readIfPolicySnapshot(ctx); err != nil {
+			diags.AddError("Cato API PolicyInternetFirewall error", err.Error())
+			return diags
+		}
+	}
+
+	reorderIn, err := buildPolicyReorderInput(snapshot.sections, snapshot.rules, changes.planned)
+	if err != nil {
+		diags.AddError("Internet firewall policy reorder", err.Error())
+		return diags
+	}
+	if !policyReorderUnchanged(snapshot.rules, reorderIn) {
+		tflog.Debug(ctx, "PolicyInternetFirewallReorderPolicy", map[string]interface{}{"request": utils.InterfaceToJSONString(reorderIn)})
+		reorderErr := withPolicyRevisionConflictRetry(ctx, "PolicyInternetFirewallReorderPolicy", func() error {
+			result, callErr := r.policyClient().PolicyInternetFirewallReorderPolicy(
+				ctx, &cato_models.InternetFirewallPolicyMutationInput{}, reorderIn, r.client.AccountId)
+			return internetFirewallReorderError(result, callErr)
+		})
+		if reorderErr != nil {
+			diags.AddError("Cato API PolicyInternetFirewallReorderPolicy error", reorderErr.Error())
+			return diags
+		}
+	}
+
+	diags.Append(r.publishIfPolicyDocument(ctx)...)
+	return diags
+}
+
+func (r *ifPolicyDocumentResource) removeIfPolicyDocumentRule(ctx context.Context, row BulkPolicyRuleRow, diags *diag.Diagnostics) bool {
+	input := cato_models.InternetFirewallRemoveRuleInput{ID: row.RuleID}
+	tflog.Debug(ctx, "PolicyInternetFirewallRemoveRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.policyClient().PolicyInternetFirewallRemoveRule(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input, r.client.AccountId)
+	if err != nil {
+		diags.AddError("Cato API PolicyInternetFirewallRemoveRule error", err.Error())
+		return true
+	}
+	if result.Policy.InternetFirewall.RemoveRule.Status != ifwMutationStatusSuccess {
+		for _, e := range result.Policy.InternetFirewall.RemoveRule.GetErrors() {
+			diags.AddError("API Error Deleting Rule "+row.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+		}
+		return true
+	}
+	return false
+}
+
+func (r *ifPolicyDocumentResource) removeIfPolicyDocumentSection(ctx context.Context, sec BulkPolicySectionRef, diags *diag.Diagnostics) bool {
+	input := cato_models.PolicyRemoveSectionInput{ID: sec.ID}
+	tflog.Debug(ctx, "PolicyInternetFirewallRemoveSection", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.policyClient().PolicyInternetFirewallRemoveSection(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input, r.client.AccountId)
+	if err != nil {
+		diags.AddError("Cato API PolicyInternetFirewallRemoveSection error", err.Error())
+		return true
+	}
+	if errs := result.GetPolicy().GetInternetFirewall().GetRemoveSection().GetErrors(); len(errs) > 0 {
+		for _, e := range errs {
+			diags.AddError("API Error Deleting Section "+sec.Name, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+		}
+		return true
+	}
+	return false
+}
+
+func (r *ifPolicyDocumentResource) publishIfPolicyDocument(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	pubErr := withPolicyRevisionConflictRetry(ctx, "PolicyInternetFirewallPublishPolicyRevision", func() error {
+		_, err := r.policyClient().PolicyInternetFirewallPublishPolicyRevision(
+			ctx,
+			&cato_models.InternetFirewallPolicyMutationInput{},
+			&cato_models.PolicyPublishRevisionInput{},
+			r.client.AccountId,
+		)
+		return err
+	})
+	if pubErr != nil {
+		diags.AddError("Cato API PolicyInternetFirewallPublishPolicyRevision error", pubErr.Error())
+	}
+	return diags
+}
+
+// discardIfPolicyDocument discards the policy draft after a failed apply. A failed discard is only
+// reported as a warning, the draft may hold no change when the apply failed before the first mutation.
+func (r *ifPolicyDocumentResource) discardIfPolicyDocument(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.policyClient().PolicyInternetFirewallDiscardPolicyRevision(
+		ctx,
+		&cato_models.InternetFirewallPolicyMutationInput{},
+		&cato_models.PolicyDiscardRevisionInput{},
+		r.client.AccountId,
+	)
+	if err == nil {
+		if errs := result.GetPolicy().GetInternetFirewall().GetDiscardPolicyRevision().GetErrors(); len(errs) > 0 {
+			err = errors.New(formatInternetFirewallDiscardErrors(errs))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Cato API PolicyInternetFirewallDiscardPolicyRevision error",
+			"The Internet Firewall policy draft of the failed apply was not discarded, discard it before the next publish: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "discarded internet firewall policy draft of the failed apply")
+}
+
+// hydrateIfPolicyDocumentState reads the sections and rules of the document from the policy.
+// Sections and rules missing from the policy are dropped, and the sections and rules are listed
+// in policy order, so that out-of-band changes show as drift.
+func (r *ifPolicyDocumentResource) hydrateIfPolicyDocumentState(
+	ctx context.Context,
+	model IfPolicyDocumentModel,
+) (*IfPolicyDocumentModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	snapshot, err := r.readIfPolicySnapshot(ctx)
+	if err != nil {
+		return nil, diags, err
+	}
+	doc, _, d := ifPolicyDocumentSections(ctx, model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags, nil
+	}
+
+	apiRules := map[string]*cato_go_sdk.Policy_Policy_InternetFirewall_Policy_Rules_Rule{}
+	for _, rp := range snapshot.policy.GetPolicy().GetInternetFirewall().GetPolicy().GetRules() {
+		apiRules[rp.GetRule().GetID()] = rp.GetRule()
+	}
+
+	modelRules := model.Rules.Elements()
+	docSections := map[string]struct{}{}
+	for _, sec := range doc {
+		docSections[sec.name] = struct{}{}
+	}
+	sectionIDs := sectionIDSet(snapshot.sections)
+	rulesBySectionID, err := indexRulesBySectionID(sectionIDs, snapshot.rules)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	stateRules := map[string]attr.Value{}
+	stateSections := []IfPolicyDocumentSection{}
+	for _, sec := range snapshot.sections {
+		if _, ok := docSections[sec.Name]; !ok {
+			continue
+		}
+		names := []string{}
+		for _, row := range rulesBySectionID[sec.ID] {
+			modelRule, ok := modelRules[row.RuleName]
+			if !ok || row.IsSystem {
+				continue
+			}
+			rule, d := ifPolicyDocumentRuleState(ctx, row.RuleName, modelRule, apiRules[row.RuleID])
+			diags.Append(d...)
+			stateRules[row.RuleName] = rule
+			names = append(names, row.RuleName)
+		}
+		rules, d := types.ListValueFrom(ctx, types.StringType, names)
+		diags.Append(d...)
+		stateSections = append(stateSections, IfPolicyDocumentSection{
+			ID:    types.StringValue(sec.ID),
+			Name:  types.StringValue(sec.Name),
+			Rules: rules,
+		})
+	}
+	if diags.HasError() {
+		return nil, diags, nil
+	}
+
+	unmanagedRules, unmanagedSections := ifPolicyDocumentUnmanaged(doc, snapshot.sections, snapshot.rules)
+	state := &IfPolicyDocumentModel{
+		AccountID:       model.AccountID,
+		ID:              types.StringValue(ifPolicyDocumentID),
+		DeleteUnmanaged: types.BoolValue(model.DeleteUnmanaged.ValueBool()),
+	}
+	state.Sections, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: IfPolicyDocumentSectionTypes}, stateSections)
+	diags.Append(d...)
+	state.Rules, d = types.MapValue(types.ObjectType{AttrTypes: IfPolicyDocumentRuleTypes}, stateRules)
+	diags.Append(d...)
+	state.UnmanagedRules, d = types.ListValueFrom(ctx, types.StringType, policyRuleNames(unmanagedRules))
+	diags.Append(d...)
+	state.UnmanagedSections, d = types.ListValueFrom(ctx, types.StringType, policySectionNames(unmanagedSections))
+	diags.Append(d...)
+	return state, diags, nil
+}
+
+// ifPolicyDocumentRuleInput converts a document rule to the cato_if_rule API inputs
+func ifPolicyDocumentRuleInput(ctx context.Context, name string, rule attr.Value) (hydrateIfwAPITypes, diag.Diagnostics) {
+	attrs := maps.Clone(rule.(types.Object).Attributes())
+	attrs["name"] = types.StringValue(name)
+	ruleObj, diags := types.ObjectValue(InternetFirewallRuleRuleAttrTypes, attrs)
+	if diags.HasError() {
+		return hydrateIfwAPITypes{}, diags
+	}
+	input, d := hydrateIfwRuleAPI(ctx, InternetFirewallRule{Rule: ruleObj, At: types.ObjectNull(PositionAttrTypes)})
+	diags.Append(d...)
+	return input, diags
+}
+
+// ifPolicyDocumentRuleState hydrates a document rule from the policy rule, using the document
+// rule to keep the values the API does not return as configured
+func ifPolicyDocumentRuleState(
+	ctx context.Context,
+	name string,
+	rule attr.Value,
+	current *cato_go_sdk.Policy_Policy_InternetFirewall_Policy_Rules_Rule,
+) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if current == nil {
+		diags.AddError("Internet firewall rule not found", fmt.Sprintf("rule %q is in the rules index but not in the policy", name))
+		return types.ObjectNull(IfPolicyDocumentRuleTypes), diags
+	}
+
+	attrs := maps.Clone(rule.(types.Object).Attributes())
+	attrs["name"] = types.StringValue(name)
+	modelRule, d := types.ObjectValue(InternetFirewallRuleRuleAttrTypes, attrs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(IfPolicyDocumentRuleTypes), diags
+	}
+
+	ruleState := hydrateIfwRuleState(ctx, InternetFirewallRule{Rule: modelRule}, current)
+	ruleState.ID = types.StringValue(current.ID)
+	ruleObj, d := types.ObjectValueFrom(ctx, InternetFirewallRuleRuleAttrTypes, ruleState)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(IfPolicyDocumentRuleTypes), diags
+	}
+
+	attrs = maps.Clone(ruleObj.Attributes())
+	delete(attrs, "name")
+	stateRule, d := types.ObjectValue(IfPolicyDocumentRuleTypes, attrs)
+	diags.Append(d...)
+	return stateRule, diags
+}
+
+// ifPolicyDocumentSection is one section of the document with the names of its rules in order
+type ifPolicyDocumentSection struct {
+	name  string
+	rules []string
+}
+
+// ifPolicyDocumentSections returns the sections of the document, known is false when
+// a section name or rule list is not known yet
+func ifPolicyDocumentSections(ctx context.Context, model IfPolicyDocumentModel) ([]ifPolicyDocumentSection, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if model.Sections.IsNull() || model.Sections.IsUnknown() {
+		return nil, !model.Sections.IsUnknown(), diags
+	}
+
+	var sections []IfPolicyDocumentSection
+	diags.Append(model.Sections.ElementsAs(ctx, &sections, false)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+	doc := make([]ifPolicyDocumentSection, 0, len(sections))
+	known := true
+	for _, sec := range sections {
+		if sec.Name.IsUnknown() || sec.Rules.IsUnknown() {
+			known = false
+			continue
+		}
+		var rules []string
+		diags.Append(sec.Rules.ElementsAs(ctx, &rules, false)...)
+		doc = append(doc, ifPolicyDocumentSection{name: sec.Name.ValueString(), rules: rules})
+	}
+	return doc, known, diags
+}
+
+// validateIfPolicyDocument checks that the section names are unique and that every rule
+// of the rules map is listed in exactly one section
+func validateIfPolicyDocument(doc []ifPolicyDocumentSection, ruleNames []string) error {
+	defined := make(map[string]bool, len(ruleNames))
+	for _, name := range ruleNames {
+		defined[name] = false
+	}
+
+	var errs []error
+	sections := map[string]struct{}{}
+	for _, sec := range doc {
+		if _, dup := sections[sec.name]; dup {
+			errs = append(errs, fmt.Errorf("section %q is listed more than once", sec.name))
+		}
+		sections[sec.name] = struct{}{}
+		for _, name := range sec.rules {
+			listed, ok := defined[name]
+			switch {
+			case !ok:
+				errs = append(errs, fmt.Errorf("rule %q of section %q is not defined in rules", name, sec.name))
+			case listed:
+				errs = append(errs, fmt.Errorf("rule %q is listed more than once", name))
+			}
+			if ok {
+				defined[name] = true
+			}
+		}
+	}
+	for _, name := range ruleNames {
+		if !defined[name] {
+			errs = append(errs, fmt.Errorf("rule %q is not listed in any section", name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ifPolicyDocumentChanges are the changes applying a document makes to the policy
+type ifPolicyDocumentChanges struct {
+	addSections    []string
+	removeSections []BulkPolicySectionRef
+	addRules       []BulkPlannedRuleIndex
+	updateRules    []BulkPolicyRuleRow
+	removeRules    []BulkPolicyRuleRow
+	planned        []BulkPlannedRuleIndex // final placement of every rule of the document
+}
+
+// limitRemovals keeps only the removals of the named rules and sections: apply deletes the unmanaged
+// objects the plan listed, and keeps the ones created since or not opted in to deletion.
+func (c *ifPolicyDocumentChanges) limitRemovals(ruleNames, sectionNames []string) {
+	c.removeRules = slices.DeleteFunc(c.removeRules, func(row BulkPolicyRuleRow) bool {
+		return !slices.Contains(ruleNames, row.RuleName)
+	})
+	c.removeSections = slices.DeleteFunc(c.removeSections, func(sec BulkPolicySectionRef) bool {
+		return !slices.Contains(sectionNames, sec.Name)
+	})
+}
+
+// planIfPolicyDocument computes the changes applying the document makes to the current policy.
+// Rules are matched by name, the rules in unchanged are not updated.
+func planIfPolicyDocument(
+	doc []ifPolicyDocumentSection,
+	sections []BulkPolicySectionRef,
+	rules []BulkPolicyRuleRow,
+	unchanged map[string]bool,
+) (ifPolicyDocumentChanges, error) {
+	var changes ifPolicyDocumentChanges
+	for _, sec := range doc {
+		for i, name := range sec.rules {
+			changes.planned = append(changes.planned, BulkPlannedRuleIndex{
+				SectionName:    sec.name,
+				RuleName:       name,
+				IndexInSection: int64(i + 1),
+			})
+		}
+	}
+	if err := validateSystemRulesOmitted(rules, changes.planned); err != nil {
+		return ifPolicyDocumentChanges{}, err
+	}
+
+	existingRules := map[string]BulkPolicyRuleRow{}
+	for _, row := range rules {
+		if row.SectionID != "" && !row.IsSystem {
+			existingRules[row.RuleName] = row
+		}
+	}
+	for _, placement := range changes.planned {
+		row, ok := existingRules[placement.RuleName]
+		switch {
+		case !ok:
+			changes.addRules = append(changes.addRules, placement)
+		case !unchanged[placement.RuleName]:
+			changes.updateRules = append(changes.updateRules, row)
+		}
+	}
+
+	existingSections := map[string]struct{}{}
+	for _, sec := range sections {
+		existingSections[sec.Name] = struct{}{}
+	}
+	for _, sec := range doc {
+		if _, ok := existingSections[sec.name]; !ok {
+			changes.addSections = append(changes.addSections, sec.name)
+		}
+	}
+
+	changes.removeRules, changes.removeSections = ifPolicyDocumentUnmanaged(doc, sections, rules)
+	return changes, nil
+}
+
+// ifPolicyDocumentUnmanaged returns the rules and sections of the policy that are not in the document.
+// System and sub-policy rules, and the sections holding them, are never unmanaged.
+func ifPolicyDocumentUnmanaged(
+	doc []ifPolicyDocumentSection,
+	sections []BulkPolicySectionRef,
+	rules []BulkPolicyRuleRow,
+) ([]BulkPolicyRuleRow, []BulkPolicySectionRef) {
+	docSections := map[string]struct{}{}
+	docRules := map[string]struct{}{}
+	for _, sec := range doc {
+		docSections[sec.name] = struct{}{}
+		for _, name := range sec.rules {
+			docRules[name] = struct{}{}
+		}
+	}
+
+	var unmanagedRules []BulkPolicyRuleRow
+	for _, row := range rules {
+		if row.SectionID == "" || row.IsSystem {
+			continue
+		}
+		if _, ok := docRules[row.RuleName]; !ok {
+			unmanagedRules = append(unmanagedRules, row)
+		}
+	}
+
+	protected := protectedPolicySectionIDs(rules)
+	var unmanagedSections []BulkPolicySectionRef
+	for _, sec := range sections {
+		_, inDoc := docSections[sec.Name]
+		_, isProtected := protected[sec.ID]
+		if !inDoc && !isProtected {
+			unmanagedSections = append(unmanagedSections, sec)
+		}
+	}
+	return unmanagedRules, unmanagedSections
+}
+
+// protectedPolicySectionIDs returns the sections holding a system rule, which cannot be moved nor removed
+func protectedPolicySectionIDs(rules []BulkPolicyRuleRow) map[string]struct{} {
+	protected := map[string]struct{}{}
+	for _, row := range rules {
+		if row.IsSystem && row.SectionID != "" {
+			protected[row.SectionID] = struct{}{}
+		}
+	}
+	return protected
+}
+
+// policySectionOrderMatches reports whether the sections of the document are in document order in the policy
+func policySectionOrderMatches(sections []BulkPolicySectionRef, doc []ifPolicyDocumentSection) bool {
+	docIndex := make(map[string]int, len(doc))
+	for i, sec := range doc {
+		docIndex[sec.name] = i
+	}
+	next := 0
+	for _, sec := range sections {
+		i, ok := docIndex[sec.Name]
+		if !ok {
+			continue
+		}
+		if i != next {
+			return false
+		}
+		next++
+	}
+	return next == len(doc)
+}
+
+func policyRuleNames(rows []BulkPolicyRuleRow) []string {
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, row.RuleName)
+	}
+	return names
+}
+
+func policySectionNames(sections []BulkPolicySectionRef) []string {
+	names := make([]string, 0, len(sections))
+	for _, sec := range sections {
+		names = append(names, sec.Name)
+	}
+	return names
+}
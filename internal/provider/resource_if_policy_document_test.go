@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/mocks"
+)
+
+func TestValidateIfPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	doc := []ifPolicyDocumentSection{
+		{name: "Sec A", rules: []string{"a1", "a2"}},
+		{name: "Sec B", rules: []string{"b1"}},
+	}
+	require.NoError(t, validateIfPolicyDocument(doc, []string{"a1", "a2", "b1"}))
+
+	doc = []ifPolicyDocumentSection{
+		{name: "Sec A", rules: []string{"a1", "missing"}},
+		{name: "Sec A", rules: []string{"a1"}},
+	}
+	err := validateIfPolicyDocument(doc, []string{"a1", "orphan"})
+	require.ErrorContains(t, err, `section "Sec A" is listed more than once`)
+	require.ErrorContains(t, err, `rule "missing" of section "Sec A" is not defined in rules`)
+	require.ErrorContains(t, err, `rule "a1" is listed more than once`)
+	require.ErrorContains(t, err, `rule "orphan" is not listed in any section`)
+}
+
+func TestPlanIfPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	sections := []BulkPolicySectionRef{
+		{ID: "s1", Name: "Sec A"},
+		{ID: "s2", Name: "Old"},
+		{ID: "s3", Name: "System"},
+	}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r1", RuleName: "keep", Index: 1},
+		{SectionID: "s1", SectionName: "Sec A", RuleID: "r2", RuleName: "edit", Index: 2},
+		{SectionID: "s2", SectionName: "Old", RuleID: "r3", RuleName: "stray", Index: 3},
+		{SectionID: "s3", SectionName: "System", RuleID: "r4", RuleName: "Block P2P", Index: 4, IsSystem: true},
+		{RuleID: "r5", RuleName: "sub-policy child", Index: 5},
+	}
+	doc := []ifPolicyDocumentSection{
+		{name: "Sec B", rules: []string{"new"}},
+		{name: "Sec A", rules: []string{"edit", "keep"}},
+	}
+
+	changes, err := planIfPolicyDocument(doc, sections, rules, map[string]bool{"keep": true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Sec B"}, changes.addSections)
+	require.Equal(t, []BulkPolicySectionRef{{ID: "s2", Name: "Old"}}, changes.removeSections)
+	require.Equal(t, []BulkPlannedRuleIndex{{SectionName: "Sec B", RuleName: "new", IndexInSection: 1}}, changes.addRules)
+	require.Equal(t, []string{"edit"}, policyRuleNames(changes.updateRules))
+	require.Equal(t, []string{"stray"}, policyRuleNames(changes.removeRules))
+	require.Equal(t, []BulkPlannedRuleIndex{
+		{SectionName: "Sec B", RuleName: "new", IndexInSection: 1},
+		{SectionName: "Sec A", RuleName: "edit", IndexInSection: 1},
+		{SectionName: "Sec A", RuleName: "keep", IndexInSection: 2},
+	}, changes.planned)
+}
+
+func TestIfPolicyDocumentChangesLimitRemovals(t *testing.T) {
+	t.Parallel()
+
+	changes := ifPolicyDocumentChanges{
+		removeRules: []BulkPolicyRuleRow{
+			{SectionID: "s2", RuleID: "r3", RuleName: "stray"},
+			{SectionID: "s2", RuleID: "r6", RuleName: "added after plan"},
+		},
+		removeSections: []BulkPolicySectionRef{{ID: "s2", Name: "Old"}, {ID: "s4", Name: "New"}},
+	}
+	kept := ifPolicyDocumentChanges{removeRules: slices.Clone(changes.removeRules), removeSections: slices.Clone(changes.removeSections)}
+	kept.limitRemovals(nil, nil)
+	require.Empty(t, kept.removeRules)
+	require.Empty(t, kept.removeSections)
+
+	changes.limitRemovals([]string{"stray"}, []string{"Old"})
+	require.Equal(t, []string{"stray"}, policyRuleNames(changes.removeRules))
+	require.Equal(t, []string{"Old"}, policySectionNames(changes.removeSections))
+}
+
+func TestPlanIfPolicyDocumentWithoutPriorStateUpdatesAllRules(t *testing.T) {
+	t.Parallel()
+
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "Sec"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r1", RuleName: "a", Index: 1},
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r2", RuleName: "b", Index: 2},
+	}
+	doc := []ifPolicyDocumentSection{{name: "Sec", rules: []string{"a", "b"}}}
+
+	changes, err := planIfPolicyDocument(doc, sections, rules, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, policyRuleNames(changes.updateRules))
+	require.Empty(t, changes.addRules)
+	require.Empty(t, changes.removeRules)
+	require.Empty(t, changes.removeSections)
+}
+
+func TestPlanIfPolicyDocumentRejectsSystemRule(t *testing.T) {
+	t.Parallel()
+
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "Sec"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r1", RuleName: "System", Index: 1, IsSystem: true},
+	}
+	doc := []ifPolicyDocumentSection{{name: "Sec", rules: []string{"System"}}}
+
+	_, err := planIfPolicyDocument(doc, sections, rules, nil)
+	require.ErrorContains(t, err, `system rule "System"`)
+}
+
+func TestPolicySectionOrderMatches(t *testing.T) {
+	t.Parallel()
+
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "A"}, {ID: "s2", Name: "System"}, {ID: "s3", Name: "B"}}
+	require.True(t, policySectionOrderMatches(sections, []ifPolicyDocumentSection{{name: "A"}, {name: "B"}}))
+	require.False(t, policySectionOrderMatches(sections, []ifPolicyDocumentSection{{name: "B"}, {name: "A"}}))
+	require.False(t, policySectionOrderMatches(sections, []ifPolicyDocumentSection{{name: "A"}, {name: "C"}}))
+}
+
+func TestPolicyReorderUnchanged(t *testing.T) {
+	t.Parallel()
+
+	sections := []BulkPolicySectionRef{{ID: "s1", Name: "Sec"}}
+	rules := []BulkPolicyRuleRow{
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r1", RuleName: "A", Index: 1},
+		{SectionID: "s1", SectionName: "Sec", RuleID: "r2", RuleName: "B", Index: 2},
+	}
+
+	in, err := buildPolicyReorderInput(sections, rules, []BulkPlannedRuleIndex{
+		{SectionName: "Sec", RuleName: "A", IndexInSection: 1},
+		{SectionName: "Sec", RuleName: "B", IndexInSection: 2},
+	})
+	require.NoError(t, err)
+	require.True(t, policyReorderUnchanged(rules, in))
+
+	in, err = buildPolicyReorderInput(sections, rules, []BulkPlannedRuleIndex{
+		{SectionName: "Sec", RuleName: "B", IndexInSection: 1},
+	})
+	require.NoError(t, err)
+	require.False(t, policyReorderUnchanged(rules, in))
+}
+
+func TestApplyIfPolicyDocumentDiscardsDraftOnFailure(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &ifPolicySnapshot{
+		sections: []BulkPolicySectionRef{{ID: "s1", Name: "Sec A"}},
+		rules:    []BulkPolicyRuleRow{{SectionID: "s1", SectionName: "Sec A", RuleID: "r1", RuleName: "stray", Index: 1}},
+	}
+	changes := ifPolicyDocumentChanges{
+		removeRules: []BulkPolicyRuleRow{snapshot.rules[0]},
+		addSections: []string{"Sec B"},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		discardErr error
+		warnings   int
+	}{
+		{name: "discarded"},
+		{name: "discard failed", discardErr: errors.New("discard failed"), warnings: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			m := mocks.NewInternetFirewallPolicyDocumentClient(t)
+			m.On("PolicyInternetFirewallRemoveRule", ctx, mock.Anything, cato_models.InternetFirewallRemoveRuleInput{ID: "r1"}, "acc-1").
+				Return(ifRemoveRuleResponse(), nil).
+				Once()
+			m.On("PolicyInternetFirewallAddSection", ctx, mock.Anything, mock.Anything, "acc-1").
+				Return(nil, errors.New("section limit reached")).
+				Once()
+			m.On("PolicyInternetFirewallDiscardPolicyRevision", ctx, mock.Anything, mock.Anything, "acc-1").
+				Return(&cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision{}, tc.discardErr).
+				Once()
+
+			r := &ifPolicyDocumentResource{client: &catoClientData{AccountId: "acc-1"}, ifwDoc: m}
+			diags := r.applyIfPolicyDocumentChanges(ctx, nil, map[string]attr.Value{}, snapshot, changes)
+
+			require.True(t, diags.HasError())
+			require.Equal(t, "Cato API PolicyInternetFirewallAddSection error", diags.Errors()[0].Summary())
+			require.Len(t, diags.Warnings(), tc.warnings)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/planmodifiers"
 	"github.com/catonetworks/terraform-provider-cato/internal/utils"
 )
 
@@ -33,6 +36,8 @@ type ifSubPolicyResource struct {
 	subPolyClient InternetFirewallSubPolicyClient
 }
 
+func (r *ifSubPolicyResource) clientData() **catoClientData { return &r.client }
+
 func (r *ifSubPolicyResource) getClient() InternetFirewallSubPolicyClient {
 	if r.subPolyClient != nil {
 		return r.subPolyClient
@@ -68,6 +73,7 @@ func (r *ifSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 		Computed:    true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("name"),
 		},
 	}
 	scopeAttr.Attributes["description"] = schema.StringAttribute{
@@ -75,13 +81,15 @@ func (r *ifSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 		Computed:    true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("description"),
 		},
 	}
 
 	resp.Schema = schema.Schema{
 		Description: "The `cato_if_sub_policy` resource manages an Internet Firewall sub-policy " +
 			"(a nested policy scoped by a SUB_POLICY_SCOPE rule). The Cato API has no updateSubPolicy " +
-			"mutation, so changing `name` or `description` forces resource replacement. Documentation for the " +
+			"mutation, so `name` and `description` are updated in place through the SUB_POLICY_SCOPE rule, " +
+			"which the API keeps in sync with the sub-policy. Documentation for the " +
 			"underlying API can be found at mutation.policy.internetFirewall.addSubPolicy().",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -92,18 +100,12 @@ func (r *ifSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Sub-policy name. Changing this forces replacement (no updateSubPolicy API).",
+				Description: "Sub-policy name. Updated in place through the SUB_POLICY_SCOPE rule name.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Sub-policy description. Changing this forces replacement (no updateSubPolicy API).",
+				Description: "Sub-policy description. Updated in place through the SUB_POLICY_SCOPE rule description.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"scope_rule_id": schema.StringAttribute{
 				Description: "ID of the underlying SUB_POLICY_SCOPE rule.",
@@ -112,8 +114,10 @@ func (r *ifSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"at":    ifSubPolicyAtAttribute(),
-			"scope": scopeAttr,
+			"at":         ifSubPolicyAtAttribute(),
+			"scope":      scopeAttr,
+			"template":   subPolicyTemplateRefAttribute(),
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -149,6 +153,7 @@ func (r *ifSubPolicyResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *ifSubPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -162,8 +167,34 @@ func (r *ifSubPolicyResource) publish(ctx context.Context) error {
 	return err
 }
 
+// discard discards the policy draft after a failed template sync, so that the
+// next publish, of this or any other resource, does not apply it half-way. A
+// failed discard is only reported as a warning.
+func (r *ifSubPolicyResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.getClient().PolicyInternetFirewallDiscardPolicyRevision(
+		ctx,
+		&cato_models.InternetFirewallPolicyMutationInput{},
+		&cato_models.PolicyDiscardRevisionInput{},
+		r.client.AccountId,
+	)
+	if err == nil {
+		if errs := result.GetPolicy().GetInternetFirewall().GetDiscardPolicyRevision().GetErrors(); len(errs) > 0 {
+			err = errors.New(formatInternetFirewallDiscardErrors(errs))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Cato API PolicyInternetFirewallDiscardPolicyRevision error",
+			"The Internet Firewall policy draft of the failed template sync was not discarded, discard it before the next publish: "+err.Error())
+	}
+}
+
 //nolint:funlen
 func (r *ifSubPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer r.client.lockPolicy(subPolicyTemplateTypeIfw)()
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan InternetFirewallSubPolicy
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -261,10 +292,41 @@ func (r *ifSubPolicyResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	plan.Scope = scopeObj
 
+	// Template child rules go in a second revision: the cleanup rule they are
+	// anchored to only exists once the sub-policy is published.
+	changed, diags := syncIfwSubPolicyTemplate(ctx, r.getClient(), r.client.AccountId, subID, plan.Template)
+	resp.Diagnostics.Append(diags...)
+	if changed && !resp.Diagnostics.HasError() {
+		if err := r.publish(ctx); err != nil {
+			resp.Diagnostics.AddError("Catov2 API PolicyInternetFirewallPublishPolicyRevision error", err.Error())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		// The sub-policy itself is published: track it without its template,
+		// so that the next apply syncs the template child rules again.
+		if changed {
+			r.discard(ctx, &resp.Diagnostics)
+		}
+		plan.Template = types.ObjectNull(SubPolicyTemplateRefAttrTypes)
+	} else if changed {
+		// Record the synced child rules so that later edits of their content
+		// are detected. Read records them instead if the policy cannot be read.
+		synced, err := r.getClient().PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, r.client.AccountId)
+		if err != nil {
+			tflog.Warn(ctx, "unable to read synced sub-policy child rules", map[string]interface{}{"error": err.Error()})
+		} else {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, subPolicyTemplatePrivateKey, subPolicyTemplateSyncedState(ctx, plan.Template, subPolicyTemplateTypeIfw, ifwSubPolicyChildRules(synced, subID)))...)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *ifSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state InternetFirewallSubPolicy
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -308,10 +370,28 @@ func (r *ifSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	state.Scope = scopeObj
 
+	children := ifwSubPolicyChildRules(body, state.ID.ValueString())
+	synced, diags := req.Private.GetKey(ctx, subPolicyTemplatePrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if subPolicyTemplateDrifted(ctx, state.Template, subPolicyTemplateTypeIfw, children, synced) {
+		tflog.Warn(ctx, "sub-policy child rules drifted from template")
+		state.Template, diags = markSubPolicyTemplateDrifted(state.Template)
+		resp.Diagnostics.Append(diags...)
+	} else if !state.Template.IsNull() {
+		// Record the child rules of a template synced before they were tracked,
+		// so that later edits of their content are detected.
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, subPolicyTemplatePrivateKey, subPolicyTemplateSyncedState(ctx, state.Template, subPolicyTemplateTypeIfw, children))...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ifSubPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer r.client.lockPolicy(subPolicyTemplateTypeIfw)()
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan InternetFirewallSubPolicy
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -327,7 +407,6 @@ func (r *ifSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.ID = state.ID
 	plan.ScopeRuleID = state.ScopeRuleID
 
-	// name/description are RequiresReplace, so only the scope can change here.
 	scopeRule := InternetFirewallRule{Rule: plan.Scope, At: plan.At}
 	hydrated, diags := hydrateIfwRuleAPI(ctx, scopeRule)
 	resp.Diagnostics.Append(diags...)
@@ -335,9 +414,10 @@ func (r *ifSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 	hydrated.update.ID = scopeRuleID
-	// The API synchronizes scope name/description back to sub-policy metadata.
-	hydrated.update.Rule.Name = state.Name.ValueStringPointer()
-	hydrated.update.Rule.Description = state.Description.ValueStringPointer()
+	// The API synchronizes scope name/description back to sub-policy metadata,
+	// which renames the sub-policy in place and keeps its child rules.
+	hydrated.update.Rule.Name = plan.Name.ValueStringPointer()
+	hydrated.update.Rule.Description = plan.Description.ValueStringPointer()
 
 	updateResp, err := r.getClient().PolicyInternetFirewallUpdateRule(
 		ctx,
@@ -359,8 +439,23 @@ func (r *ifSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if !plan.Template.Equal(state.Template) {
+		_, diags := syncIfwSubPolicyTemplate(ctx, r.getClient(), r.client.AccountId, plan.ID.ValueString(), plan.Template)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			// Drop the scope update and the rules synced so far, state keeps
+			// the prior sub-policy.
+			r.discard(ctx, &resp.Diagnostics)
+			return
+		}
+	}
+
 	if err := r.publish(ctx); err != nil {
 		resp.Diagnostics.AddError("Catov2 API PolicyInternetFirewallPublishPolicyRevision error", err.Error())
+		// The scope rule is already renamed in the policy revision, track the sub-policy under its new name.
+		state.Name = plan.Name
+		state.Description = plan.Description
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
 
@@ -370,6 +465,19 @@ func (r *ifSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Catov2 API PolicyInternetFirewall error", err.Error())
 		return
 	}
+	info := ifwSubPolicyInfo(body, plan.ID.ValueString())
+	if info == nil {
+		resp.Diagnostics.AddError("Sub-Policy Not Found", "Sub-policy not found after update.")
+		return
+	}
+	renamed := !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description)
+	if renamed && (info.GetName() != plan.Name.ValueString() || info.GetDescription() != plan.Description.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Sub-Policy Not Renamed",
+			fmt.Sprintf("The API did not synchronize the name and description of sub-policy %s from its scope rule.", plan.ID.ValueString()),
+		)
+		return
+	}
 	scope := ifwScopeRule(body, plan.ID.ValueString())
 	if scope == nil {
 		resp.Diagnostics.AddError("Scope Rule Not Found", "Sub-policy scope rule not found after update.")
@@ -384,10 +492,18 @@ func (r *ifSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	plan.Scope = scopeObj
 
+	if !plan.Template.IsNull() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, subPolicyTemplatePrivateKey, subPolicyTemplateSyncedState(ctx, plan.Template, subPolicyTemplateTypeIfw, ifwSubPolicyChildRules(body, plan.ID.ValueString())))...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *ifSubPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer r.client.lockPolicy(subPolicyTemplateTypeIfw)()
+
 	var state InternetFirewallSubPolicy
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
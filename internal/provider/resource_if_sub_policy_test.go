@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
@@ -223,6 +224,115 @@ func TestIfSubPolicyUpdateScopeError(t *testing.T) {
 	}
 }
 
+func TestIfSubPolicyUpdateTemplateSyncErrorDiscardsDraft(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyInternetFirewallUpdateRule(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(successfulUpdateRuleResponse("scope-1"), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(nil, assertErr("read failed")).Once()
+	mockClient.EXPECT().PolicyInternetFirewallDiscardPolicyRevision(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(&cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision{}, nil).Once()
+
+	m := newIfSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	m.Template = types.ObjectValueMust(SubPolicyTemplateRefAttrTypes, map[string]attr.Value{
+		"id":          types.StringValue("tpl-1"),
+		"policy_type": types.StringValue(subPolicyTemplateTypeIfw),
+		"revision":    types.StringValue("rev-1"),
+		"rules":       types.StringValue("[]"),
+	})
+	plan := tfsdk.Plan{Schema: getIfSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+
+	r := &ifSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: newIfSubPolicyStateWithID(ctx, t)}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: newIfSubPolicyStateWithID(ctx, t)}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for sync error")
+	}
+	mockClient.AssertNotCalled(t, "PolicyInternetFirewallPublishPolicyRevision", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIfSubPolicyUpdateRenameInPlace(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyInternetFirewallUpdateRule(mock.Anything, mock.Anything, mock.MatchedBy(
+		func(in cato_models.InternetFirewallUpdateRuleInput) bool {
+			return in.ID == "scope-1" && derefStr(in.Rule.Name) == "renamed-sub" && derefStr(in.Rule.Description) == "renamed desc"
+		}), "account-123").
+		Return(successfulUpdateRuleResponse("scope-1"), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallPublishPolicyRevision(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(ifSubPolicyResponse("sub-1", "renamed-sub", "renamed desc", "scope-1", "renamed-sub"), nil).Once()
+
+	r := &ifSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getIfSubPolicySchema(ctx, t)}}
+	req := resource.UpdateRequest{
+		Plan:  newIfSubPolicyRenamePlan(ctx, t),
+		State: newIfSubPolicyStateWithID(ctx, t),
+	}
+	r.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var got InternetFirewallSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" {
+		t.Fatalf("expected sub-1 renamed in place, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
+func TestIfSubPolicyUpdateRenameNotSynchronized(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyInternetFirewallUpdateRule(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(successfulUpdateRuleResponse("scope-1"), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallPublishPolicyRevision(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(ifSubPolicyResponse("sub-1", "test-sub", "a sub", "scope-1", "renamed-sub"), nil).Once()
+
+	r := &ifSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getIfSubPolicySchema(ctx, t)}}
+	req := resource.UpdateRequest{
+		Plan:  newIfSubPolicyRenamePlan(ctx, t),
+		State: newIfSubPolicyStateWithID(ctx, t),
+	}
+	r.Update(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics when the sub-policy name is not synchronized")
+	}
+}
+
+func TestIfSubPolicyUpdatePublishErrorKeepsRename(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyInternetFirewallUpdateRule(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(successfulUpdateRuleResponse("scope-1"), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallPublishPolicyRevision(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(nil, errors.New("publish failed")).Once()
+
+	r := &ifSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getIfSubPolicySchema(ctx, t)}}
+	req := resource.UpdateRequest{
+		Plan:  newIfSubPolicyRenamePlan(ctx, t),
+		State: newIfSubPolicyStateWithID(ctx, t),
+	}
+	r.Update(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for the publish error")
+	}
+	var got InternetFirewallSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" || got.Description.ValueString() != "renamed desc" {
+		t.Fatalf("expected sub-1 tracked under its new name, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
 func TestIfSubPolicyDeleteSuccess(t *testing.T) {
 	ctx := context.Background()
 	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
@@ -274,7 +384,8 @@ func newIfSubPolicyModel(id string) InternetFirewallSubPolicy {
 			"position": types.StringValue("LAST_IN_POLICY"),
 			"ref":      types.StringNull(),
 		}),
-		Scope: scope.Rule,
+		Scope:    scope.Rule,
+		Template: types.ObjectNull(SubPolicyTemplateRefAttrTypes),
 	}
 }
 
@@ -298,6 +409,19 @@ func newIfSubPolicyPlanWithID(ctx context.Context, t *testing.T, id, scopeID str
 	return plan
 }
 
+func newIfSubPolicyRenamePlan(ctx context.Context, t *testing.T) tfsdk.Plan {
+	t.Helper()
+	m := newIfSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	m.Name = types.StringValue("renamed-sub")
+	m.Description = types.StringValue("renamed desc")
+	plan := tfsdk.Plan{Schema: getIfSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+	return plan
+}
+
 func newIfSubPolicyStateWithID(ctx context.Context, t *testing.T) tfsdk.State {
 	t.Helper()
 	m := newIfSubPolicyModel("sub-1")
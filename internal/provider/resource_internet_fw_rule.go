@@ -37,6 +37,7 @@ var (
 	_ resource.Resource                = &internetFwRuleResource{}
 	_ resource.ResourceWithConfigure   = &internetFwRuleResource{}
 	_ resource.ResourceWithImportState = &internetFwRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &internetFwRuleResource{}
 )
 
 const (
@@ -49,6 +50,8 @@ type internetFwRuleResource struct {
 	ifwClient InternetFirewallPolicyClient
 }
 
+func (r *internetFwRuleResource) clientData() **catoClientData { return &r.client }
+
 func NewInternetFwRuleResource() resource.Resource {
 	return &internetFwRuleResource{}
 }
@@ -1462,6 +1465,8 @@ func (r *internetFwRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 					},
 				},
 			},
+			"account_id":       accountIDOverrideAttribute(),
+			"lifecycle_window": lifecycleWindowActivePeriodAttribute(),
 		},
 	}
 }
@@ -1475,6 +1480,7 @@ func (r *internetFwRuleResource) Configure(_ context.Context, req resource.Confi
 }
 
 func (r *internetFwRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Accept either "<rule-id>" (main-policy rule) or "<sub-policy-id>/<rule-id>"
 	// (sub-policy rule).
 	if subID, ruleID, ok := strings.Cut(req.ID, "/"); ok {
@@ -1492,14 +1498,31 @@ func (r *internetFwRuleResource) ImportState(ctx context.Context, req resource.I
 	resource.ImportStatePassthroughID(ctx, path.Root("rule").AtName("id"), req, resp)
 }
 
+func (r *internetFwRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLifecycleWindowPlan(ctx, req, resp)
+	planLifecycleWindowActivePeriod(ctx, req, resp)
+}
+
 //nolint:gocyclo,funlen
 func (r *internetFwRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan InternetFirewallRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+		return
+	}
 
 	input, diags := hydrateIfwRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -1626,12 +1649,19 @@ func (r *internetFwRuleResource) Create(ctx context.Context, req resource.Create
 
 //nolint:gocyclo,funlen
 func (r *internetFwRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state InternetFirewallRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 
 	queryIfwPolicy := &cato_models.InternetFirewallPolicyInput{}
 	body, err := r.getIfwClient().PolicyInternetFirewall(ctx, queryIfwPolicy, r.client.AccountId)
@@ -1727,12 +1757,24 @@ func (r *internetFwRuleResource) Read(ctx context.Context, req resource.ReadRequ
 
 //nolint:gocyclo,funlen
 func (r *internetFwRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan InternetFirewallRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		removeLifecycleWindowExpiredRule(ctx, req, resp, r.Delete)
+		return
+	}
 
 	input, diags := hydrateIfwRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -1880,12 +1922,18 @@ func (r *internetFwRuleResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *internetFwRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state InternetFirewallRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 
 	// Retrieve rule ID
 	rule := PolicyPolicyInternetFirewallPolicyRulesRule{}
@@ -80,8 +80,9 @@ func TestInternetFwRuleImportState(t *testing.T) {
 	}
 
 	diags := resp.State.Set(ctx, InternetFirewallRule{
-		Rule: types.ObjectNull(InternetFirewallRuleRuleAttrTypes),
-		At:   types.ObjectNull(PositionAttrTypes),
+		Rule:            types.ObjectNull(InternetFirewallRuleRuleAttrTypes),
+		At:              types.ObjectNull(PositionAttrTypes),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	})
 	if diags.HasError() {
 		t.Fatalf("unexpected seed state diagnostics: %+v", diags)
@@ -705,6 +706,7 @@ func newMinimalInternetFwRuleModel(ruleID string) InternetFirewallRule {
 			"position": types.StringValue("LAST_IN_POLICY"),
 			"ref":      types.StringNull(),
 		}),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
 	}
 }
 
@@ -23,8 +23,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &ifwRulesIndexResource{}
-	_ resource.ResourceWithConfigure = &ifwRulesIndexResource{}
+	_ resource.Resource               = &ifwRulesIndexResource{}
+	_ resource.ResourceWithConfigure  = &ifwRulesIndexResource{}
+	_ resource.ResourceWithModifyPlan = &ifwRulesIndexResource{}
 )
 
 func NewIfwRulesIndexResource() resource.Resource {
@@ -36,6 +37,8 @@ type ifwRulesIndexResource struct {
 	ifwBulk InternetFirewallBulkPolicyClient // optional override for tests
 }
 
+func (r *ifwRulesIndexResource) clientData() **catoClientData { return &r.client }
+
 func (r *ifwRulesIndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bulk_if_move_rule"
 }
@@ -50,6 +53,7 @@ func (r *ifwRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 				// Computed:    true,
 			},
+			"planned_moves": bulkPlannedReorderAttribute("section"),
 			"rule_data": schema.MapNestedAttribute{
 				Description: "Map of IF Rule Policy Indexes keyed by rule_name",
 				Required:    false,
@@ -120,6 +124,9 @@ func (r *ifwRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 				},
 			},
+			"account_id":           accountIDOverrideAttribute(),
+			"unmanaged_rules":      bulkUnmanagedRulesAttribute("rule_data"),
+			"unmanaged_rule_names": bulkUnmanagedRuleNamesAttribute(),
 		},
 	}
 }
@@ -159,12 +166,162 @@ func (r *ifwRulesIndexResource) ifwBulkPolicy() InternetFirewallBulkPolicyClient
 	return r.client.catov2
 }
 
+// ModifyPlan previews the rule placements of the policy reorder of the plan in planned_moves
+func (r *ifwRulesIndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil { // resource destruction, or provider not configured yet
+		return
+	}
+	modifyBulkUnmanagedRules(ctx, req, resp)
+
+	var plan IfwRulesIndex
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.forAccount(ctx, plan.AccountID)
+
+	modifyBulkPlannedMoves(ctx, req, resp, func() ([]BulkRuleMovePreview, bool, error) {
+		planned, known := bulkPlannedRuleIndexes(plan.RuleData)
+		if !known || plan.AccountID.IsUnknown() {
+			return nil, false, nil
+		}
+		sectionIndexAPIData, err := r.ifwBulkPolicy().PolicyInternetFirewallSectionsIndex(ctx, client.AccountId)
+		if err != nil {
+			return nil, false, err
+		}
+		ruleIndexAPIData, err := r.ifwBulkPolicy().PolicyInternetFirewallRulesIndex(ctx, client.AccountId)
+		if err != nil {
+			return nil, false, err
+		}
+		previews, err := previewBulkPolicyReorder(ifwBulkSectionRefs(sectionIndexAPIData), ifwBulkRuleRows(ruleIndexAPIData), planned)
+		return previews, err == nil, err
+	})
+}
+
+// ifwBulkSectionRefs lists the Internet Firewall sections in policy order
+func ifwBulkSectionRefs(sectionIndexAPIData *cato_go_sdk.IfwSectionsIndexPolicy) []BulkPolicySectionRef {
+	sections := make([]BulkPolicySectionRef, 0, len(sectionIndexAPIData.Policy.InternetFirewall.Policy.Sections))
+	for _, item := range sectionIndexAPIData.Policy.InternetFirewall.Policy.Sections {
+		sections = append(sections, BulkPolicySectionRef{
+			ID:   item.Section.ID,
+			Name: item.Section.Name,
+		})
+	}
+	return sections
+}
+
+// ifwBulkRuleRows lists the Internet Firewall rules, system rules are flagged as such
+func ifwBulkRuleRows(ruleIndexAPIData *cato_go_sdk.IfwRulesIndexPolicy) []BulkPolicyRuleRow {
+	rules := make([]BulkPolicyRuleRow, 0, len(ruleIndexAPIData.Policy.InternetFirewall.Policy.Rules))
+	for _, item := range ruleIndexAPIData.Policy.InternetFirewall.Policy.Rules {
+		rules = append(rules, BulkPolicyRuleRow{
+			SectionID:   item.Rule.Section.ID,
+			SectionName: item.Rule.Section.Name,
+			RuleID:      item.Rule.ID,
+			RuleName:    item.Rule.Name,
+			Index:       item.Rule.Index,
+			Enabled:     item.Rule.Enabled,
+			IsSystem: policyElementHasProperty(
+				item.Properties,
+				cato_models.PolicyElementPropertiesEnumSystem,
+			),
+		})
+	}
+	return rules
+}
+
+// unmanagedRules returns the Internet Firewall rules of the rules index not in ruleData, the
+// SUB_POLICY_SCOPE rules the index does not tell apart are read from the policy and left out
+func (r *ifwRulesIndexResource) unmanagedRules(ctx context.Context, ruleIndexAPIData *cato_go_sdk.IfwRulesIndexPolicy, ruleData types.Map) ([]BulkPolicyRuleRow, error) {
+	policy, err := r.ifwBulkPolicy().PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, r.client.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	rules := flagSubPolicyScopeRules(ifwBulkRuleRows(ruleIndexAPIData), ifwSubPolicyScopeRuleIDs(policy))
+	return unmanagedPolicyRules(rules, bulkManagedRuleNames(ruleData)), nil
+}
+
+// handleUnmanagedRules applies unmanaged_rules to the Internet Firewall rules not in rule_data,
+// the publish of the rule moves that follow also publishes the disabled and deleted rules
+func (r *ifwRulesIndexResource) handleUnmanagedRules(ctx context.Context, plan IfwRulesIndex, diags *diag.Diagnostics) types.List {
+	if plan.UnmanagedRules.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	ruleIndexAPIData, err := r.ifwBulkPolicy().PolicyInternetFirewallRulesIndex(ctx, r.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyInternetFirewallRulesIndex error", err.Error())
+		return types.ListNull(types.StringType)
+	}
+	unmanaged, err := r.unmanagedRules(ctx, ruleIndexAPIData, plan.RuleData)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyInternetFirewall error", err.Error())
+		return types.ListNull(types.StringType)
+	}
+
+	disable := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.InternetFirewallUpdateRuleInput{
+			ID:   rule.RuleID,
+			Rule: &cato_models.InternetFirewallUpdateRuleDataInput{Enabled: ptr(false)},
+		}
+		tflog.Debug(ctx, "PolicyInternetFirewallUpdateRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.ifwBulkPolicy().PolicyInternetFirewallUpdateRule(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyInternetFirewallUpdateRule error", err.Error())
+			return
+		}
+		if result.Policy.InternetFirewall.UpdateRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.InternetFirewall.UpdateRule.GetErrors() {
+				diags.AddError("API Error Disabling Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	remove := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.InternetFirewallRemoveRuleInput{ID: rule.RuleID}
+		tflog.Debug(ctx, "PolicyInternetFirewallRemoveRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.ifwBulkPolicy().PolicyInternetFirewallRemoveRule(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyInternetFirewallRemoveRule error", err.Error())
+			return
+		}
+		if result.Policy.InternetFirewall.RemoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.InternetFirewall.RemoveRule.GetErrors() {
+				diags.AddError("API Error Deleting Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	return applyUnmanagedRules(ctx, plan.UnmanagedRules, "internet firewall", unmanaged, disable, remove, func(diags *diag.Diagnostics) { r.discard(ctx, diags) }, diags)
+}
+
+// discard discards the policy draft after unmanaged_rules failed part way, so that no later publish
+// applies the rules disabled or deleted so far. A failed discard is only reported as a warning.
+func (r *ifwRulesIndexResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.ifwBulkPolicy().PolicyInternetFirewallDiscardPolicyRevision(
+		ctx,
+		&cato_models.InternetFirewallPolicyMutationInput{},
+		&cato_models.PolicyDiscardRevisionInput{},
+		r.client.AccountId,
+	)
+	if err == nil {
+		if errs := result.GetPolicy().GetInternetFirewall().GetDiscardPolicyRevision().GetErrors(); len(errs) > 0 {
+			err = errors.New(formatInternetFirewallDiscardErrors(errs))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Catov2 API PolicyInternetFirewallDiscardPolicyRevision error",
+			"The Internet Firewall policy draft of the failed unmanaged_rules was not discarded, discard it before the next publish: "+err.Error())
+	}
+}
+
 // func (r *ifwRulesIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 // 	// Retrieve import ID and save to id attribute
 // 	// resource.ImportStatePassthroughID(ctx, path.Root("Id"), req, resp)
 // }
 
 func (r *ifwRulesIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan IfwRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -172,6 +329,11 @@ func (r *ifwRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveIfwRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -183,6 +345,7 @@ func (r *ifwRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 	resp.Diagnostics.Append(diags...)
 	plan.SectionData = sectionObjectsList
 	plan.RuleData = rulesObjectsList
+	plan.PlannedMoves = bulkPlannedMovesApplied(ctx, plan.PlannedMoves, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -193,6 +356,10 @@ func (r *ifwRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 
 //nolint:funlen
 func (r *ifwRulesIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state IfwRulesIndex
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -300,6 +467,18 @@ func (r *ifwRulesIndexResource) Read(ctx context.Context, req resource.ReadReque
 	)
 	diags = append(diags, ruleMapDiags...)
 	state.RuleData = ruleObjectsMap
+	var unmanaged []BulkPolicyRuleRow
+	if !state.UnmanagedRules.IsNull() {
+		unmanaged, err = r.unmanagedRules(ctx, ruleIndexAPIData, state.RuleData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Catov2 API PolicyInternetFirewall error",
+				err.Error(),
+			)
+			return
+		}
+	}
+	state.UnmanagedRuleNames = unmanagedRuleNamesValue(state.UnmanagedRules, unmanaged)
 
 	resp.Diagnostics.Append(diags...)
 	if diags := resp.State.Set(ctx, &state); diags.HasError() {
@@ -308,6 +487,10 @@ func (r *ifwRulesIndexResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *ifwRulesIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan IfwRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -315,6 +498,11 @@ func (r *ifwRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveIfwRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -326,6 +514,7 @@ func (r *ifwRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 	resp.Diagnostics.Append(diags...)
 	plan.SectionData = sectionObjectsList
 	plan.RuleData = rulesObjectsList
+	plan.PlannedMoves = bulkPlannedMovesApplied(ctx, plan.PlannedMoves, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -490,22 +679,10 @@ func (r *ifwRulesIndexResource) moveIfwRulesAndSections(
 			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
 		}
 
-		rules = make([]BulkPolicyRuleRow, 0, len(ruleIndexAPIData.Policy.InternetFirewall.Policy.Rules))
-		for _, item := range ruleIndexAPIData.Policy.InternetFirewall.Policy.Rules {
-			isSystem := policyElementHasProperty(
-				item.Properties,
-				cato_models.PolicyElementPropertiesEnumSystem,
-			)
-			rules = append(rules, BulkPolicyRuleRow{
-				SectionID:   item.Rule.Section.ID,
-				SectionName: item.Rule.Section.Name,
-				RuleID:      item.Rule.ID,
-				RuleName:    item.Rule.Name,
-				Index:       item.Rule.Index,
-				IsSystem:    isSystem,
-			})
-			if isSystem {
-				protectedSectionIDs[item.Rule.Section.ID] = struct{}{}
+		rules = ifwBulkRuleRows(ruleIndexAPIData)
+		for _, rule := range rules {
+			if rule.IsSystem {
+				protectedSectionIDs[rule.SectionID] = struct{}{}
 			}
 		}
 	}
@@ -660,13 +837,7 @@ func (r *ifwRulesIndexResource) moveIfwRulesAndSections(
 			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
 		}
 
-		sections := make([]BulkPolicySectionRef, 0, len(sectionIdxAfter.Policy.InternetFirewall.Policy.Sections))
-		for _, item := range sectionIdxAfter.Policy.InternetFirewall.Policy.Sections {
-			sections = append(sections, BulkPolicySectionRef{
-				ID:   item.Section.ID,
-				Name: item.Section.Name,
-			})
-		}
+		sections := ifwBulkSectionRefs(sectionIdxAfter)
 
 		planned := make([]BulkPlannedRuleIndex, 0, len(ruleListFromPlan))
 		for _, r := range ruleListFromPlan {
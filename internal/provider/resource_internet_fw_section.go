@@ -34,6 +34,8 @@ type internetFwSectionResource struct {
 	client *catoClientData
 }
 
+func (r *internetFwSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *internetFwSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_if_section"
 }
@@ -93,6 +95,7 @@ func (r *internetFwSectionResource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -106,12 +109,17 @@ func (r *internetFwSectionResource) Configure(_ context.Context, req resource.Co
 }
 
 func (r *internetFwSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 //nolint:funlen
 func (r *internetFwSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan InternetFirewallSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -242,6 +250,10 @@ func (r *internetFwSectionResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *internetFwSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state InternetFirewallSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -311,6 +323,10 @@ func (r *internetFwSectionResource) Read(ctx context.Context, req resource.ReadR
 
 //nolint:funlen
 func (r *internetFwSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan InternetFirewallSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -434,6 +450,9 @@ func (r *internetFwSectionResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *internetFwSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state InternetFirewallSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
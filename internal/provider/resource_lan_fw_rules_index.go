@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 
@@ -23,8 +24,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &lanRulesIndexResource{}
-	_ resource.ResourceWithConfigure = &lanRulesIndexResource{}
+	_ resource.Resource               = &lanRulesIndexResource{}
+	_ resource.ResourceWithConfigure  = &lanRulesIndexResource{}
+	_ resource.ResourceWithModifyPlan = &lanRulesIndexResource{}
 	// _ resource.ResourceWithImportState = &lanRulesIndexResource{}
 )
 
@@ -37,6 +39,8 @@ type lanRulesIndexResource struct {
 	catov2Client LanFwRuleClient
 }
 
+func (r *lanRulesIndexResource) clientData() **catoClientData { return &r.client }
+
 type LanFwRuleClient interface {
 	PolicySocketLanPolicy(ctx context.Context, accountID string, socketLanPolicyInput *cato_models.SocketLanPolicyInput,
 		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanPolicy, error)
@@ -52,6 +56,24 @@ type LanFwRuleClient interface {
 		socketLanPolicyMutationInput *cato_models.SocketLanPolicyMutationInput,
 		policyPublishRevisionInput *cato_models.PolicyPublishRevisionInput, accountID string,
 		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanPublishPolicyRevision, error)
+	PolicySocketLanDiscardPolicyRevision(ctx context.Context,
+		socketLanPolicyMutationInput *cato_models.SocketLanPolicyMutationInput,
+		policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput, accountID string,
+		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanDiscardPolicyRevision, error)
+	PolicySocketLanUpdateRule(ctx context.Context, socketLanPolicyMutationInput *cato_models.SocketLanPolicyMutationInput,
+		socketLanUpdateRuleInput cato_models.SocketLanUpdateRuleInput, accountID string,
+		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanUpdateRule, error)
+	PolicySocketLanRemoveRule(ctx context.Context, socketLanPolicyMutationInput *cato_models.SocketLanPolicyMutationInput,
+		socketLanRemoveRuleInput cato_models.SocketLanRemoveRuleInput, accountID string,
+		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanRemoveRule, error)
+	PolicySocketLanFirewallUpdateRule(ctx context.Context, accountID string,
+		socketLanPolicyMutationInput *cato_models.SocketLanPolicyMutationInput,
+		socketLanFirewallUpdateRuleInput cato_models.SocketLanFirewallUpdateRuleInput,
+		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanFirewallUpdateRule, error)
+	PolicySocketLanFirewallRemoveRule(ctx context.Context, accountID string,
+		socketLanPolicyMutationInput *cato_models.SocketLanPolicyMutationInput,
+		socketLanFirewallRemoveRuleInput cato_models.SocketLanFirewallRemoveRuleInput,
+		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanFirewallRemoveRule, error)
 }
 
 func (r *lanRulesIndexResource) getClient() LanFwRuleClient { return r.catov2Client }
@@ -171,6 +193,10 @@ func (r *lanRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 				},
 			},
+			"planned_moves":        bulkPlannedMovesAttribute("section or network rule"),
+			"account_id":           accountIDOverrideAttribute(),
+			"unmanaged_rules":      bulkUnmanagedRulesAttribute("network_rules and firewall_rules"),
+			"unmanaged_rule_names": bulkUnmanagedRuleNamesAttribute(),
 		},
 	}
 }
@@ -183,7 +209,62 @@ func (r *lanRulesIndexResource) Configure(_ context.Context, req resource.Config
 	r.catov2Client = r.client.catov2
 }
 
+// ModifyPlan previews the network rule, sub-policy and firewall rule moves of the plan in planned_moves
+func (r *lanRulesIndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil { // resource destruction, or provider not configured yet
+		return
+	}
+	modifyBulkUnmanagedRules(ctx, req, resp)
+
+	var plan LanFwRulesIndex
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyBulkPlannedMoves(ctx, req, resp, func() ([]BulkRuleMovePreview, bool, error) {
+		if !lanPlanPlacementsKnown(plan) || plan.AccountID.IsUnknown() {
+			return nil, false, nil
+		}
+		planner := *r
+		planner.client = r.client.forAccount(ctx, plan.AccountID)
+		var diags diag.Diagnostics
+		_, indexMap := planner.hydrateLanFwRulesIndex(ctx, &plan, &diags)
+		if diags.HasError() {
+			return nil, false, fmt.Errorf("%s: %s", diags.Errors()[0].Summary(), diags.Errors()[0].Detail())
+		}
+		previews, err := previewLanRuleMoves(indexMap)
+		return previews, err == nil, err
+	})
+}
+
+// lanPlanPlacementsKnown reports whether the names, keys and indexes of the plan are known,
+// the IDs are only resolved from the policy
+func lanPlanPlacementsKnown(plan LanFwRulesIndex) bool {
+	for _, items := range []types.Map{plan.SectionData, plan.NetworkRules, plan.FirewallRules} {
+		if items.IsUnknown() {
+			return false
+		}
+		for _, item := range items.Elements() {
+			obj, ok := item.(types.Object)
+			if !ok || obj.IsUnknown() {
+				return false
+			}
+			for name, value := range obj.Attributes() {
+				if name != "id" && value.IsUnknown() {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
 func (r *lanRulesIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan LanFwRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -196,6 +277,10 @@ func (r *lanRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	unmanagedRuleNames := r.handleUnmanagedRules(ctx, plan, indexMap, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if utils.CheckErr(&resp.Diagnostics, r.moveSections(ctx, indexMap.sections)) {
 		return
@@ -219,6 +304,9 @@ func (r *lanRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	hydratedState.PlannedMoves = bulkPlannedMovesApplied(ctx, plan.PlannedMoves, &resp.Diagnostics)
+	hydratedState.UnmanagedRules = plan.UnmanagedRules
+	hydratedState.UnmanagedRuleNames = unmanagedRuleNames
 	diags = resp.State.Set(ctx, &hydratedState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -244,6 +332,11 @@ type lfIndexMap struct {
 	//   current:[{name:FwRuleA,id:100},{name:FwRuleB,id:123},...],
 	//   target: [{name:FwRuleB,id:123},{name:FwRuleA,id:100},...],
 	firewallRules map[string]itemOrder
+
+	// Network rules and firewall rules left out of the maps above with unmanaged_rules set,
+	// in policy order, and the IDs of the firewall rules among them
+	unmanaged              []BulkPolicyRuleRow
+	unmanagedFirewallRules map[string]struct{}
 }
 type itemOrder struct {
 	parentID   string
@@ -283,6 +376,7 @@ type lanFwStateAliases struct {
 	firewallParentKeyByID map[string]string
 	manageNetworkRules    bool
 	manageFirewallRules   bool
+	unmanagedRules        types.String
 }
 
 func (r *lanRulesIndexResource) hydrateLanFwRulesIndex(ctx context.Context, plan *LanFwRulesIndex, diags *diag.Diagnostics,
@@ -310,6 +404,10 @@ func (r *lanRulesIndexResource) hydrateLanFwRulesIndexWithAliases(ctx context.Co
 		return nil, nil
 	}
 	policyBase := result.GetPolicy().GetSocketLan().GetPolicy()
+	unmanaged, unmanagedFirewallRules := r.lanUnmanagedRules(policyBase, plan, aliases)
+	if len(unmanaged) > 0 {
+		policyBase = lanManagedPolicy(policyBase, unmanaged)
+	}
 
 	policySections, sectionData, sectionKeyToID := r.hydrateSections(ctx, plan, aliases, policyBase, diags)
 	sectionRulesOrSubPols, netRuleData, netRuleKeyToID := r.hydrateNetRules(
@@ -320,14 +418,18 @@ func (r *lanRulesIndexResource) hydrateLanFwRulesIndexWithAliases(ctx context.Co
 	)
 
 	newState = &LanFwRulesIndex{
-		SectionData:   sectionData,
-		NetworkRules:  netRuleData,
-		FirewallRules: firewallRuleData,
+		SectionData:        sectionData,
+		NetworkRules:       netRuleData,
+		FirewallRules:      firewallRuleData,
+		PlannedMoves:       types.ListNull(types.ObjectType{AttrTypes: BulkPlannedMoveTypes}),
+		UnmanagedRuleNames: types.ListNull(types.StringType),
 	}
 	indexMap = &lfIndexMap{
-		sections:       policySections,
-		rulesOrSubPols: sectionRulesOrSubPols,
-		firewallRules:  ruleFirewallRules,
+		sections:               policySections,
+		rulesOrSubPols:         sectionRulesOrSubPols,
+		firewallRules:          ruleFirewallRules,
+		unmanaged:              unmanaged,
+		unmanagedFirewallRules: unmanagedFirewallRules,
 	}
 	return newState, indexMap
 }
@@ -587,6 +689,7 @@ func extractLanFwStateAliases(ctx context.Context, state *LanFwRulesIndex,
 		firewallParentKeyByID: make(map[string]string),
 		manageNetworkRules:    utils.HasValue(state.NetworkRules),
 		manageFirewallRules:   utils.HasValue(state.FirewallRules),
+		unmanagedRules:        state.UnmanagedRules,
 	}
 
 	var sections map[string]LanFwSectionData
@@ -1440,19 +1543,22 @@ func (r *lanRulesIndexResource) moveSectionToPosition(ctx context.Context, paren
 	return nil
 }
 
+// moveRulesOrSubPolicies moves the network rules and sub-policies of each section with the fewest
+// moves reaching the target order, see planLanItemMoves
 func (r *lanRulesIndexResource) moveRulesOrSubPolicies(ctx context.Context, rulesOrSubPols map[string]itemOrderType,
 ) (diags diag.Diagnostics) {
-	moveSectionRules := func(sect itemOrderType) (err error) {
-		for i := len(sect.target) - 1; i >= 0; i-- {
-			if sect.target[i].id != sect.current[i].id {
-				if sect.target[i].ruleType == cato_models.PolicyRuleTypeEnumPolicyRule {
-					err = r.moveNetRuleToPosition(ctx, sect.parentID, sect.current, sect.target[i].id, sect.target[i].name, i)
-				} else {
-					err = r.moveSubPolicyToPosition(ctx, sect.parentID, sect.current, sect.target[i].id, sect.target[i].name, i)
-				}
-				if err != nil {
-					return err
-				}
+	moveSectionRules := func(sect itemOrderType) error {
+		moves, err := planLanItemMoves(sect.parentID, sect.parentName, nameIDs(sect.current), nameIDs(sect.target))
+		if err != nil {
+			return err
+		}
+		subPolicies := make(map[string]bool, len(sect.current))
+		for _, item := range sect.current {
+			subPolicies[item.id] = item.ruleType != cato_models.PolicyRuleTypeEnumPolicyRule
+		}
+		for _, move := range moves {
+			if err := r.moveLanRule(ctx, move, subPolicies[move.RuleID]); err != nil {
+				return err
 			}
 		}
 		return nil
@@ -1493,126 +1599,144 @@ func (r *lanRulesIndexResource) moveFirewallRules(ctx context.Context, fwRules m
 	return nil
 }
 
-// moveNetRuleToPosition moves the rule with given ID to the given position in []currentRulesOrSubPols
-// (shifting the rest up) and calls the API to move the section in the CMA
-// Warning: it only moves the sections down, moving up is not supported!
-func (r *lanRulesIndexResource) moveNetRuleToPosition(ctx context.Context, parentSectionID string,
-	currentRulesOrSubPols []nameIDType, ruleID, ruleName string, newPosition int,
-) error {
-	var myRule nameIDType
-
-	tflog.Debug(ctx, "moving network rule '"+ruleName+"' to position "+strconv.Itoa(newPosition))
-
-	curPossition := -1
-	for i := range currentRulesOrSubPols {
-		if currentRulesOrSubPols[i].id == ruleID {
-			curPossition = i
-			myRule = currentRulesOrSubPols[i]
-			break
+// previewLanRuleMoves lists the moves of moveRulesOrSubPolicies and moveFirewallRules, in the order
+// they are applied within each section or network rule
+func previewLanRuleMoves(indexMap *lfIndexMap) ([]BulkRuleMovePreview, error) {
+	previews := make([]BulkRuleMovePreview, 0)
+	for _, sectionID := range slices.Sorted(maps.Keys(indexMap.rulesOrSubPols)) {
+		rules := indexMap.rulesOrSubPols[sectionID]
+		sectionPreviews, err := previewLanPlannedMoves(rules.parentID, rules.parentName, nameIDs(rules.current), nameIDs(rules.target))
+		if err != nil {
+			return nil, err
 		}
+		previews = append(previews, sectionPreviews...)
 	}
-	if curPossition == -1 {
-		return fmt.Errorf("internal error: failed to find rule ID %s", ruleID)
-	}
-	if curPossition == newPosition {
-		return nil // nothing to do
+	for _, netRuleID := range slices.Sorted(maps.Keys(indexMap.firewallRules)) {
+		rules := indexMap.firewallRules[netRuleID]
+		previews = append(previews, previewLanItemMoves(rules.parentName, rules.current, rules.target)...)
 	}
+	return previews, nil
+}
 
-	for i := curPossition; i < newPosition; i++ {
-		currentRulesOrSubPols[i] = currentRulesOrSubPols[i+1]
+// planLanItemMoves computes the fewest relative moves turning the current order of the items of a
+// section into the target order, see planMinimalRuleMoves. Without a target the items are not managed.
+func planLanItemMoves(parentID, parentName string, current, target []nameID) ([]BulkPlannedRuleMove, error) {
+	if len(target) == 0 {
+		return nil, nil
 	}
-	currentRulesOrSubPols[newPosition] = myRule
-
-	// Prepare input for API to move the network rule
-	input := cato_models.PolicyMoveRuleInput{
-		ID: ruleID,
-		To: &cato_models.PolicyRulePositionInput{},
+	rules := make([]BulkPolicyRuleRow, 0, len(current))
+	for i, item := range current {
+		rules = append(rules, BulkPolicyRuleRow{
+			SectionID:   parentID,
+			SectionName: parentName,
+			RuleID:      item.id,
+			RuleName:    item.name,
+			Index:       int64(i),
+		})
 	}
-	if newPosition == len(currentRulesOrSubPols)-1 { // move to LAST_IN_SECTION
-		input.To.Position = new(cato_models.PolicyRulePositionEnumLastInSection)
-		input.To.Ref = new(parentSectionID)
-	} else {
-		input.To.Position = new(cato_models.PolicyRulePositionEnumBeforeRule)
-		input.To.Ref = new(currentRulesOrSubPols[newPosition+1].id)
+	section := &cato_models.PolicyReorderSectionInput{
+		Ref: &cato_models.PolicyElementRefInput{By: cato_models.ObjectRefByID, Input: parentID},
 	}
+	for _, item := range target {
+		section.Rules = append(section.Rules, &cato_models.PolicyReorderRuleInput{
+			Ref: &cato_models.PolicyElementRefInput{By: cato_models.ObjectRefByID, Input: item.id},
+		})
+	}
+	return planMinimalRuleMoves(
+		[]BulkPolicySectionRef{{ID: parentID, Name: parentName}},
+		rules,
+		cato_models.PolicyReorderInput{Sections: []*cato_models.PolicyReorderSectionInput{section}},
+	)
+}
 
-	// Call the API to move the rule
-	result, err := r.getClient().PolicySocketLanMoveRule(ctx, input, r.client.AccountId)
+// previewLanPlannedMoves lists the moves of planLanItemMoves with the current and target index of each moved item
+func previewLanPlannedMoves(parentID, parentName string, current, target []nameID) ([]BulkRuleMovePreview, error) {
+	moves, err := planLanItemMoves(parentID, parentName, current, target)
 	if err != nil {
-		return err
-	}
-	move := result.GetPolicy().GetSocketLan().GetMoveRule()
-	if errors := move.GetErrors(); len(errors) > 0 {
-		msg := "unknown error"
-		if m := errors[0].GetErrorMessage(); m != nil {
-			msg = *m
-		}
-		return fmt.Errorf("failed to move LAN policy rule '%s': %v", ruleName, msg)
-	}
-	if err := lanPolicyMutationStatusError(move.GetStatus()); err != nil {
-		return fmt.Errorf("failed to move LAN policy rule '%s': %w", ruleName, err)
+		return nil, err
+	}
+	previews := make([]BulkRuleMovePreview, 0, len(moves))
+	for _, move := range moves {
+		previews = append(previews, BulkRuleMovePreview{
+			RuleName:    move.RuleName,
+			FromSection: parentName,
+			FromIndex:   int64(slices.IndexFunc(current, func(item nameID) bool { return item.id == move.RuleID }) + 1),
+			ToSection:   parentName,
+			ToIndex:     int64(slices.IndexFunc(target, func(item nameID) bool { return item.id == move.RuleID }) + 1),
+			Anchor:      move.anchor(),
+		})
 	}
-	return nil
+	return previews, nil
 }
 
-// moveSubPolicyToPosition moves the sub-policy with given ID to the given position in []currentRulesOrSubPols
-// (shifting the rest up) and calls the API to move the section in the CMA
-// Warning: it only moves the sections down, moving up is not supported!
-func (r *lanRulesIndexResource) moveSubPolicyToPosition(ctx context.Context, parentSectionID string,
-	currentRulesOrSubPols []nameIDType, policyID, policyName string, newPosition int,
-) error {
-	var myPolicy nameIDType
-
-	tflog.Debug(ctx, "moving sub-policy '"+policyName+"' to position "+strconv.Itoa(newPosition))
-
-	curPossition := -1
-	for i := range currentRulesOrSubPols {
-		if currentRulesOrSubPols[i].id == policyID {
-			curPossition = i
-			myPolicy = currentRulesOrSubPols[i]
-			break
-		}
-	}
-	if curPossition == -1 {
-		return fmt.Errorf("internal error: failed to find sub-policy ID %s", policyID)
-	}
-	if curPossition == newPosition {
-		return nil // nothing to do
+func nameIDs(items []nameIDType) []nameID {
+	out := make([]nameID, 0, len(items))
+	for _, item := range items {
+		out = append(out, nameID{name: item.name, id: item.id})
 	}
+	return out
+}
 
-	for i := curPossition; i < newPosition; i++ {
-		currentRulesOrSubPols[i] = currentRulesOrSubPols[i+1]
+// previewLanItemMoves replays the moves of moveFwRuleToPosition on a copy of current:
+// going backwards, each item not at its target position is moved before the next item, or last
+func previewLanItemMoves(parentName string, current, target []nameID) []BulkRuleMovePreview {
+	current = slices.Clone(current)
+	fromIndex := make(map[string]int64, len(current))
+	for i, item := range current {
+		fromIndex[item.id] = int64(i + 1)
 	}
-	currentRulesOrSubPols[newPosition] = myPolicy
 
-	// Prepare input for API to move the sub-policy
-	input := cato_models.PolicyMoveRuleInput{
-		ID: policyID,
-		To: &cato_models.PolicyRulePositionInput{},
+	var previews []BulkRuleMovePreview
+	for i := min(len(target), len(current)) - 1; i >= 0; i-- {
+		if target[i].id == current[i].id {
+			continue
+		}
+		pos := slices.IndexFunc(current[:i], func(item nameID) bool { return item.id == target[i].id })
+		if pos == -1 {
+			continue
+		}
+		item := current[pos]
+		copy(current[pos:i], current[pos+1:i+1])
+		current[i] = item
+
+		anchor := "last"
+		if i < len(current)-1 {
+			anchor = fmt.Sprintf("before rule %q", current[i+1].name)
+		}
+		previews = append(previews, BulkRuleMovePreview{
+			RuleName:    item.name,
+			FromSection: parentName,
+			FromIndex:   fromIndex[item.id],
+			ToSection:   parentName,
+			ToIndex:     int64(i + 1),
+			Anchor:      anchor,
+		})
 	}
-	if newPosition == len(currentRulesOrSubPols)-1 { // move to LAST_IN_SECTION
-		input.To.Position = new(cato_models.PolicyRulePositionEnumLastInSection)
-		input.To.Ref = new(parentSectionID)
-	} else {
-		input.To.Position = new(cato_models.PolicyRulePositionEnumBeforeRule)
-		input.To.Ref = new(currentRulesOrSubPols[newPosition+1].id)
+	return previews
+}
+
+// moveLanRule moves a network rule or a sub-policy as planned by planLanItemMoves
+func (r *lanRulesIndexResource) moveLanRule(ctx context.Context, move BulkPlannedRuleMove, subPolicy bool) error {
+	kind := "LAN policy rule"
+	if subPolicy {
+		kind = "LAN sub-policy"
 	}
+	tflog.Debug(ctx, move.String())
 
-	// Call the API to move the sub-policy
-	result, err := r.getClient().PolicySocketLanMoveRule(ctx, input, r.client.AccountId)
+	result, err := r.getClient().PolicySocketLanMoveRule(ctx, move.input(), r.client.AccountId)
 	if err != nil {
 		return err
 	}
-	move := result.GetPolicy().GetSocketLan().GetMoveRule()
-	if errors := move.GetErrors(); len(errors) > 0 {
+	moveRule := result.GetPolicy().GetSocketLan().GetMoveRule()
+	if errors := moveRule.GetErrors(); len(errors) > 0 {
 		msg := "unknown error"
 		if m := errors[0].GetErrorMessage(); m != nil {
 			msg = *m
 		}
-		return fmt.Errorf("failed to move LAN sub-policy '%s': %v", policyName, msg)
+		return fmt.Errorf("failed to move %s '%s': %v", kind, move.RuleName, msg)
 	}
-	if err := lanPolicyMutationStatusError(move.GetStatus()); err != nil {
-		return fmt.Errorf("failed to move LAN sub-policy '%s': %w", policyName, err)
+	if err := lanPolicyMutationStatusError(moveRule.GetStatus()); err != nil {
+		return fmt.Errorf("failed to move %s '%s': %w", kind, move.RuleName, err)
 	}
 	return nil
 }
@@ -1679,7 +1803,189 @@ func (r *lanRulesIndexResource) moveFwRuleToPosition(ctx context.Context, parent
 	return nil
 }
 
+// lanUnmanagedRules lists, when unmanaged_rules is set, the network rules missing from network_rules
+// and the firewall rules of the other network rules missing from firewall_rules, in policy order.
+// System rules are left out. Firewall rules of an unmanaged network rule go along with it. Only the rule maps set are looked
+// at, plan rules are matched by name and state rules by ID.
+func (r *lanRulesIndexResource) lanUnmanagedRules(policyBase *cato_go_sdk.PolicySocketLanPolicy_Policy_SocketLan_Policy,
+	plan *LanFwRulesIndex, aliases *lanFwStateAliases,
+) (unmanaged []BulkPolicyRuleRow, firewallRuleIDs map[string]struct{}) {
+	var mode types.String
+	var manageNetworkRules, manageFirewallRules bool
+	var netRuleManaged, firewallRuleManaged func(id, name string) bool
+	switch {
+	case plan != nil:
+		mode = plan.UnmanagedRules
+		manageNetworkRules, manageFirewallRules = utils.HasValue(plan.NetworkRules), utils.HasValue(plan.FirewallRules)
+		netRuleNames := lanPlanRuleNames(plan.NetworkRules, "rule_name")
+		firewallRuleNames := lanPlanRuleNames(plan.FirewallRules, "firewall_rule_name")
+		netRuleManaged = func(_, name string) bool { _, ok := netRuleNames[name]; return ok }
+		firewallRuleManaged = func(_, name string) bool { _, ok := firewallRuleNames[name]; return ok }
+	case aliases != nil:
+		mode = aliases.unmanagedRules
+		manageNetworkRules, manageFirewallRules = aliases.manageNetworkRules, aliases.manageFirewallRules
+		netRuleManaged = func(id, _ string) bool { _, ok := aliases.netRuleKeyByID[id]; return ok }
+		firewallRuleManaged = func(id, _ string) bool { _, ok := aliases.firewallRuleKeyByID[id]; return ok }
+	}
+	if mode.IsNull() || mode.IsUnknown() {
+		return nil, nil
+	}
+
+	firewallRuleIDs = make(map[string]struct{})
+	for _, rul := range policyBase.Rules {
+		if r.ruleType(rul) != cato_models.PolicyRuleTypeEnumPolicyRule ||
+			policyElementHasProperty(rul.Properties, cato_models.PolicyElementPropertiesEnumSystem) {
+			continue
+		}
+		netRule := rul.GetRule()
+		if manageNetworkRules && !netRuleManaged(netRule.GetID(), netRule.GetName()) {
+			unmanaged = append(unmanaged, BulkPolicyRuleRow{
+				SectionID:   netRule.GetSection().GetID(),
+				SectionName: netRule.GetSection().GetName(),
+				RuleID:      netRule.GetID(),
+				RuleName:    netRule.GetName(),
+				Enabled:     netRule.Enabled,
+			})
+			continue
+		}
+		if !manageFirewallRules {
+			continue
+		}
+		for _, firewall := range netRule.GetFirewall() {
+			if firewall == nil || policyElementHasProperty(firewall.Properties, cato_models.PolicyElementPropertiesEnumSystem) {
+				continue
+			}
+			fwRule := firewall.GetRule()
+			if firewallRuleManaged(fwRule.GetID(), fwRule.GetName()) {
+				continue
+			}
+			unmanaged = append(unmanaged, BulkPolicyRuleRow{
+				SectionID:   netRule.GetID(),
+				SectionName: netRule.GetName(),
+				RuleID:      fwRule.GetID(),
+				RuleName:    fwRule.GetName(),
+				Enabled:     fwRule.Enabled,
+			})
+			firewallRuleIDs[fwRule.GetID()] = struct{}{}
+		}
+	}
+	return unmanaged, firewallRuleIDs
+}
+
+// lanPlanRuleNames returns the rule names of a network_rules or firewall_rules plan map, the map
+// key standing in for an unset name
+func lanPlanRuleNames(rules types.Map, nameAttr string) map[string]struct{} {
+	names := make(map[string]struct{})
+	for key, value := range rules.Elements() {
+		rule, ok := value.(types.Object)
+		if !ok {
+			continue
+		}
+		name, _ := rule.Attributes()[nameAttr].(types.String)
+		names[configuredName(name, key)] = struct{}{}
+	}
+	return names
+}
+
+// lanManagedPolicy returns a copy of policyBase without the unmanaged rules, so they are neither
+// validated against the plan, moved nor kept in state
+func lanManagedPolicy(policyBase *cato_go_sdk.PolicySocketLanPolicy_Policy_SocketLan_Policy,
+	unmanaged []BulkPolicyRuleRow,
+) *cato_go_sdk.PolicySocketLanPolicy_Policy_SocketLan_Policy {
+	skip := make(map[string]struct{}, len(unmanaged))
+	for _, rule := range unmanaged {
+		skip[rule.RuleID] = struct{}{}
+	}
+	managed := *policyBase
+	managed.Rules = make([]*cato_go_sdk.PolicySocketLanPolicy_Policy_SocketLan_Policy_Rules, 0, len(policyBase.Rules))
+	for _, rul := range policyBase.Rules {
+		if _, ok := skip[rul.GetRule().GetID()]; ok {
+			continue
+		}
+		managedRule := *rul
+		managedRule.Rule.Firewall = nil
+		for _, firewall := range rul.GetRule().GetFirewall() {
+			if firewall == nil {
+				continue
+			}
+			if _, ok := skip[firewall.GetRule().GetID()]; !ok {
+				managedRule.Rule.Firewall = append(managedRule.Rule.Firewall, firewall)
+			}
+		}
+		managed.Rules = append(managed.Rules, &managedRule)
+	}
+	return &managed
+}
+
+// handleUnmanagedRules applies unmanaged_rules to the rules listed in indexMap, the publish of
+// the moves that follow also publishes the disabled and deleted rules
+func (r *lanRulesIndexResource) handleUnmanagedRules(ctx context.Context, plan LanFwRulesIndex, indexMap *lfIndexMap,
+	diags *diag.Diagnostics,
+) types.List {
+	disable := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		summary := fmt.Sprintf("failed to disable LAN firewall rule '%s'", rule.RuleName)
+		if _, ok := indexMap.unmanagedFirewallRules[rule.RuleID]; ok {
+			input := cato_models.SocketLanFirewallUpdateRuleInput{
+				ID:   rule.RuleID,
+				Rule: &cato_models.SocketLanFirewallUpdateRuleDataInput{Enabled: ptr(false)},
+			}
+			result, err := r.getClient().PolicySocketLanFirewallUpdateRule(ctx, r.client.AccountId, nil, input)
+			update := result.GetPolicy().GetSocketLan().GetFirewall().GetUpdateRule()
+			if !utils.CheckAPIErrors(err, update.GetErrors(), summary, diags) {
+				checkPolicyMutationStatus(update.GetStatus(), summary, diags)
+			}
+			return
+		}
+		input := cato_models.SocketLanUpdateRuleInput{
+			ID:   rule.RuleID,
+			Rule: &cato_models.SocketLanUpdateRuleDataInput{Enabled: ptr(false)},
+		}
+		result, err := r.getClient().PolicySocketLanUpdateRule(ctx, nil, input, r.client.AccountId)
+		update := result.GetPolicy().GetSocketLan().GetUpdateRule()
+		if !utils.CheckAPIErrors(err, update.GetErrors(), summary, diags) {
+			checkPolicyMutationStatus(update.GetStatus(), summary, diags)
+		}
+	}
+	remove := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		summary := fmt.Sprintf("failed to delete LAN firewall rule '%s'", rule.RuleName)
+		if _, ok := indexMap.unmanagedFirewallRules[rule.RuleID]; ok {
+			input := cato_models.SocketLanFirewallRemoveRuleInput{ID: rule.RuleID}
+			result, err := r.getClient().PolicySocketLanFirewallRemoveRule(ctx, r.client.AccountId, nil, input)
+			removed := result.GetPolicy().GetSocketLan().GetFirewall().GetRemoveRule()
+			if !utils.CheckAPIErrors(err, removed.GetErrors(), summary, diags) {
+				checkPolicyMutationStatus(removed.GetStatus(), summary, diags)
+			}
+			return
+		}
+		input := cato_models.SocketLanRemoveRuleInput{ID: rule.RuleID}
+		result, err := r.getClient().PolicySocketLanRemoveRule(ctx, nil, input, r.client.AccountId)
+		removed := result.GetPolicy().GetSocketLan().GetRemoveRule()
+		if !utils.CheckAPIErrors(err, removed.GetErrors(), summary, diags) {
+			checkPolicyMutationStatus(removed.GetStatus(), summary, diags)
+		}
+	}
+	discard := func(diags *diag.Diagnostics) { r.discard(ctx, diags) }
+	return applyUnmanagedRules(ctx, plan.UnmanagedRules, "LAN firewall", indexMap.unmanaged, disable, remove, discard, diags)
+}
+
+// discard discards the draft policy revision after unmanaged_rules failed part way, so that no later
+// publish applies the rules disabled or deleted so far. A failed discard is only reported as a warning.
+func (r *lanRulesIndexResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	const summary = "failed to discard LAN firewall policy draft"
+	result, err := r.getClient().PolicySocketLanDiscardPolicyRevision(ctx, nil, nil, r.client.AccountId)
+	var discardDiags diag.Diagnostics
+	utils.CheckAPIErrors(err, result.GetPolicy().GetSocketLan().GetDiscardPolicyRevision().GetErrors(), summary, &discardDiags)
+	for _, d := range discardDiags.Errors() {
+		diags.AddWarning(d.Summary(), "The LAN firewall policy draft of the failed unmanaged_rules was not discarded, "+
+			"discard it before the next publish: "+d.Detail())
+	}
+}
+
 func (r *lanRulesIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state LanFwRulesIndex
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1688,7 +1994,7 @@ func (r *lanRulesIndexResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Hydrate state from API
-	hydratedState, _ := r.hydrateLanFwRulesIndexForRead(ctx, &state, &resp.Diagnostics)
+	hydratedState, indexMap := r.hydrateLanFwRulesIndexForRead(ctx, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1698,12 +2004,19 @@ func (r *lanRulesIndexResource) Read(ctx context.Context, req resource.ReadReque
 	if state.FirewallRules.IsNull() {
 		hydratedState.FirewallRules = state.FirewallRules
 	}
+	hydratedState.PlannedMoves = state.PlannedMoves
+	hydratedState.UnmanagedRules = state.UnmanagedRules
+	hydratedState.UnmanagedRuleNames = unmanagedRuleNamesValue(state.UnmanagedRules, indexMap.unmanaged)
 	if diags := resp.State.Set(ctx, &hydratedState); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
 }
 
 func (r *lanRulesIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan LanFwRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1716,6 +2029,10 @@ func (r *lanRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	unmanagedRuleNames := r.handleUnmanagedRules(ctx, plan, indexMap, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if utils.CheckErr(&resp.Diagnostics, r.moveSections(ctx, indexMap.sections)) {
 		return
@@ -1739,6 +2056,9 @@ func (r *lanRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	hydratedState.PlannedMoves = bulkPlannedMovesApplied(ctx, plan.PlannedMoves, &resp.Diagnostics)
+	hydratedState.UnmanagedRules = plan.UnmanagedRules
+	hydratedState.UnmanagedRuleNames = unmanagedRuleNames
 	diags = resp.State.Set(ctx, &hydratedState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -475,6 +475,61 @@ func TestHydrateLanFwAmbiguousNetworkRuleNameRequiresKey(t *testing.T) {
 		`network rule name "Shared network rule" is ambiguous; set net_rule_key to the parent map key`)
 }
 
+func TestLanFwUnmanagedRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := duplicateLanPolicy()
+	policyBase := &policy.Policy.SocketLan.Policy
+	policyBase.Rules[0].Rule.Firewall = append(policyBase.Rules[0].Rule.Firewall,
+		&cato_go_sdk.PolicySocketLanPolicy_Policy_SocketLan_Policy_Rules_Rule_Firewall{
+			Rule: cato_go_sdk.PolicySocketLanPolicy_Policy_SocketLan_Policy_Rules_Rule_Firewall_Rule{
+				ID:   "f-main-extra",
+				Name: "Extra main firewall rule",
+			},
+		})
+	policyBase.Rules = append(policyBase.Rules, duplicateLanRule("r-extra", "Extra network rule", "s-main",
+		"Shared section", "f-extra", "Extra firewall rule"))
+	client := &lanPolicyMockClient{policy: policy}
+	res := lanRulesIndexResource{
+		client:       &catoClientData{AccountId: "testID"},
+		catov2Client: client,
+	}
+	plan := lPMockClient.createPlan(duplicateLanPolicyPlan(true, true))
+	plan.UnmanagedRules = types.StringValue(unmanagedRulesDelete)
+
+	var diags diag.Diagnostics
+	state, indexMap := res.hydrateLanFwRulesIndex(ctx, plan, &diags)
+
+	require.False(t, diags.HasError(), "unexpected diagnostics: %+v", diags)
+	require.Equal(t, []string{"child-net", "main-net"}, mapKeys[LanNetworkRule](ctx, t, state.NetworkRules))
+	require.Equal(t, []string{"child-fw", "main-fw"}, mapKeys[LanFirewallRule](ctx, t, state.FirewallRules))
+	require.Equal(t, []string{"Extra main firewall rule", "Extra network rule"}, bulkRuleRowNames(indexMap.unmanaged))
+
+	names := res.handleUnmanagedRules(ctx, *plan, indexMap, &diags)
+
+	require.False(t, diags.HasError(), "unexpected diagnostics: %+v", diags)
+	require.Empty(t, names.Elements())
+	require.Equal(t, []string{"r-extra"}, client.removeRuleCalls)
+	require.Equal(t, []string{"f-main-extra"}, client.firewallRemoveRuleCalls)
+	require.Empty(t, client.updateRuleCalls)
+	require.Zero(t, client.discardCalls)
+
+	// a failing rule discards the draft, all the unmanaged rules are left pending
+	client.mutationStatus = cato_models.PolicyMutationStatusFailure
+	diags = nil
+	names = res.handleUnmanagedRules(ctx, *plan, indexMap, &diags)
+	require.True(t, diags.HasError())
+	require.Equal(t, 1, client.discardCalls)
+	require.Len(t, names.Elements(), 2)
+
+	// without unmanaged_rules the extra rules are a mismatch with the plan
+	plan.UnmanagedRules = types.StringNull()
+	diags = nil
+	res.hydrateLanFwRulesIndex(ctx, plan, &diags)
+	require.True(t, diags.HasError())
+}
+
 func mapKeys[T any](ctx context.Context, t *testing.T, value types.Map) []string {
 	t.Helper()
 	var elements map[string]T
@@ -547,17 +602,17 @@ func TestLanFwMoveMutationsRejectFailureStatusWithoutErrors(t *testing.T) {
 		{
 			name: "network rule",
 			call: func(ctx context.Context, r *lanRulesIndexResource) error {
-				return r.moveNetRuleToPosition(ctx, "section",
-					[]nameIDType{{name: "first", id: "first"}, {name: "second", id: "second"}},
-					"first", "first", 1)
+				return r.moveLanRule(ctx, BulkPlannedRuleMove{
+					RuleID: "first", RuleName: "first", Position: ifwRulePositionAfterRule, Ref: "second",
+				}, false)
 			},
 		},
 		{
 			name: "sub-policy",
 			call: func(ctx context.Context, r *lanRulesIndexResource) error {
-				return r.moveSubPolicyToPosition(ctx, "section",
-					[]nameIDType{{name: "first", id: "first"}, {name: "second", id: "second"}},
-					"first", "first", 1)
+				return r.moveLanRule(ctx, BulkPlannedRuleMove{
+					RuleID: "first", RuleName: "first", Position: ifwRulePositionAfterRule, Ref: "second",
+				}, true)
 			},
 		},
 		{
@@ -651,20 +706,20 @@ func TestCreate(t *testing.T) {
 	require.ElementsMatch(t, []lanPolicyMoveRuleCall{
 		{
 			input: cato_models.PolicyMoveRuleInput{
-				ID: "sub1.3",
+				ID: "r1.2",
 				To: &cato_models.PolicyRulePositionInput{
-					Position: ptr(cato_models.PolicyRulePositionEnumBeforeRule),
-					Ref:      ptr("r1.3"),
+					Position: ptr(cato_models.PolicyRulePositionEnum(ifwRulePositionAfterRule)),
+					Ref:      ptr("r1.1"),
 				},
 			},
 			accountID: "testID",
 		},
 		{
 			input: cato_models.PolicyMoveRuleInput{
-				ID: "r1.2",
+				ID: "sub1.3",
 				To: &cato_models.PolicyRulePositionInput{
-					Position: ptr(cato_models.PolicyRulePositionEnumBeforeRule),
-					Ref:      ptr("sub1.1"),
+					Position: ptr(cato_models.PolicyRulePositionEnum(ifwRulePositionAfterRule)),
+					Ref:      ptr("sub1.2"),
 				},
 			},
 			accountID: "testID",
@@ -673,8 +728,8 @@ func TestCreate(t *testing.T) {
 			input: cato_models.PolicyMoveRuleInput{
 				ID: "r1.1.2.3",
 				To: &cato_models.PolicyRulePositionInput{
-					Position: ptr(cato_models.PolicyRulePositionEnumLastInSection),
-					Ref:      ptr("s1.1.2"),
+					Position: ptr(cato_models.PolicyRulePositionEnum(ifwRulePositionAfterRule)),
+					Ref:      ptr("r1.1.2.2"),
 				},
 			},
 			accountID: "testID",
@@ -719,7 +774,13 @@ type lanPolicyMockClient struct {
 	moveRuleCalls         []lanPolicyMoveRuleCall
 	firewallMoveRuleCalls []lanPolicyFirewallMoveRuleCall
 	publishCalls          int
+	discardCalls          int
 	mutationStatus        cato_models.PolicyMutationStatus
+
+	updateRuleCalls         []string
+	removeRuleCalls         []string
+	firewallUpdateRuleCalls []string
+	firewallRemoveRuleCalls []string
 }
 
 type lanPolicyMoveSectionCall struct {
@@ -815,6 +876,79 @@ func (m *lanPolicyMockClient) PolicySocketLanPublishPolicyRevision(_ context.Con
 	}, nil
 }
 
+func (m *lanPolicyMockClient) PolicySocketLanDiscardPolicyRevision(_ context.Context,
+	_ *cato_models.SocketLanPolicyMutationInput, _ *cato_models.PolicyDiscardRevisionInput, _ string,
+	_ ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicySocketLanDiscardPolicyRevision, error) {
+	m.discardCalls++
+	return &cato_go_sdk.PolicySocketLanDiscardPolicyRevision{}, nil
+}
+
+func (m *lanPolicyMockClient) PolicySocketLanUpdateRule(_ context.Context, _ *cato_models.SocketLanPolicyMutationInput,
+	input cato_models.SocketLanUpdateRuleInput, _ string, _ ...clientv2.RequestInterceptor,
+) (*cato_go_sdk.PolicySocketLanUpdateRule, error) {
+	m.updateRuleCalls = append(m.updateRuleCalls, input.ID)
+	return &cato_go_sdk.PolicySocketLanUpdateRule{
+		Policy: &cato_go_sdk.PolicySocketLanUpdateRule_Policy{
+			SocketLan: &cato_go_sdk.PolicySocketLanUpdateRule_Policy_SocketLan{
+				UpdateRule: cato_go_sdk.PolicySocketLanUpdateRule_Policy_SocketLan_UpdateRule{
+					Status: m.responseMutationStatus(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (m *lanPolicyMockClient) PolicySocketLanRemoveRule(_ context.Context, _ *cato_models.SocketLanPolicyMutationInput,
+	input cato_models.SocketLanRemoveRuleInput, _ string, _ ...clientv2.RequestInterceptor,
+) (*cato_go_sdk.PolicySocketLanRemoveRule, error) {
+	m.removeRuleCalls = append(m.removeRuleCalls, input.ID)
+	return &cato_go_sdk.PolicySocketLanRemoveRule{
+		Policy: &cato_go_sdk.PolicySocketLanRemoveRule_Policy{
+			SocketLan: &cato_go_sdk.PolicySocketLanRemoveRule_Policy_SocketLan{
+				RemoveRule: cato_go_sdk.PolicySocketLanRemoveRule_Policy_SocketLan_RemoveRule{
+					Status: m.responseMutationStatus(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (m *lanPolicyMockClient) PolicySocketLanFirewallUpdateRule(_ context.Context, _ string,
+	_ *cato_models.SocketLanPolicyMutationInput, input cato_models.SocketLanFirewallUpdateRuleInput,
+	_ ...clientv2.RequestInterceptor,
+) (*cato_go_sdk.PolicySocketLanFirewallUpdateRule, error) {
+	m.firewallUpdateRuleCalls = append(m.firewallUpdateRuleCalls, input.ID)
+	return &cato_go_sdk.PolicySocketLanFirewallUpdateRule{
+		Policy: &cato_go_sdk.PolicySocketLanFirewallUpdateRule_Policy{
+			SocketLan: &cato_go_sdk.PolicySocketLanFirewallUpdateRule_Policy_SocketLan{
+				Firewall: cato_go_sdk.PolicySocketLanFirewallUpdateRule_Policy_SocketLan_Firewall{
+					UpdateRule: cato_go_sdk.PolicySocketLanFirewallUpdateRule_Policy_SocketLan_Firewall_UpdateRule{
+						Status: m.responseMutationStatus(),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (m *lanPolicyMockClient) PolicySocketLanFirewallRemoveRule(_ context.Context, _ string,
+	_ *cato_models.SocketLanPolicyMutationInput, input cato_models.SocketLanFirewallRemoveRuleInput,
+	_ ...clientv2.RequestInterceptor,
+) (*cato_go_sdk.PolicySocketLanFirewallRemoveRule, error) {
+	m.firewallRemoveRuleCalls = append(m.firewallRemoveRuleCalls, input.ID)
+	return &cato_go_sdk.PolicySocketLanFirewallRemoveRule{
+		Policy: &cato_go_sdk.PolicySocketLanFirewallRemoveRule_Policy{
+			SocketLan: &cato_go_sdk.PolicySocketLanFirewallRemoveRule_Policy_SocketLan{
+				Firewall: cato_go_sdk.PolicySocketLanFirewallRemoveRule_Policy_SocketLan_Firewall{
+					RemoveRule: cato_go_sdk.PolicySocketLanFirewallRemoveRule_Policy_SocketLan_Firewall_RemoveRule{
+						Status: m.responseMutationStatus(),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
 type lanPolicyPlanItem struct {
 	sectionData      map[string]lanPolicySectionData
 	networkRuleData  map[string]lanPolocyNetRuleData
@@ -1038,9 +1172,11 @@ func (m *lanPolicyMockClient) createPlan(p lanPolicyPlanItem) *LanFwRulesIndex {
 	}
 
 	return &LanFwRulesIndex{
-		SectionData:   sectionData,
-		NetworkRules:  ruleData,
-		FirewallRules: firewallRuleData,
+		SectionData:        sectionData,
+		NetworkRules:       ruleData,
+		FirewallRules:      firewallRuleData,
+		PlannedMoves:       types.ListNull(types.ObjectType{AttrTypes: BulkPlannedMoveTypes}),
+		UnmanagedRuleNames: types.ListNull(types.StringType),
 	}
 }
 
@@ -1337,3 +1473,54 @@ var mockLanPolicy = map[string]*cato_go_sdk.PolicySocketLanPolicy{
 		},
 	},
 }
+
+func TestPlanLanItemMoves(t *testing.T) {
+	t.Parallel()
+
+	a, b, c, d := nameID{name: "A", id: "1"}, nameID{name: "B", id: "2"}, nameID{name: "C", id: "3"}, nameID{name: "D", id: "4"}
+
+	moves, err := planLanItemMoves("s", "Sec", []nameID{a, b, c}, []nameID{a, b, c})
+	require.NoError(t, err)
+	require.Empty(t, moves)
+	moves, err = planLanItemMoves("s", "Sec", []nameID{a, b, c}, nil) // rules not managed
+	require.NoError(t, err)
+	require.Empty(t, moves)
+
+	// a single rule moving to the end is a single move, the others keep their place
+	previews, err := previewLanPlannedMoves("s", "Sec", []nameID{a, b, c, d}, []nameID{b, c, d, a})
+	require.NoError(t, err)
+	require.Equal(t, []BulkRuleMovePreview{
+		{RuleName: "A", FromSection: "Sec", FromIndex: 1, ToSection: "Sec", ToIndex: 4, Anchor: `after rule "D"`},
+	}, previews)
+
+	// a rule moving to the front is a single move too, where moving backwards took three
+	moves, err = planLanItemMoves("s", "Sec", []nameID{a, b, c, d}, []nameID{d, a, b, c})
+	require.NoError(t, err)
+	require.Equal(t, []BulkPlannedRuleMove{{
+		RuleID: "4", RuleName: "D", SectionID: "s", SectionName: "Sec",
+		Position: ifwRulePositionFirstInSection, Ref: "s", RefName: "Sec",
+	}}, moves)
+}
+
+func TestPreviewLanItemMoves(t *testing.T) {
+	t.Parallel()
+
+	a, b, c, d := nameID{name: "A", id: "1"}, nameID{name: "B", id: "2"}, nameID{name: "C", id: "3"}, nameID{name: "D", id: "4"}
+
+	require.Empty(t, previewLanItemMoves("Sec", []nameID{a, b, c}, []nameID{a, b, c}))
+	require.Empty(t, previewLanItemMoves("Sec", []nameID{a, b, c}, nil)) // rules not managed
+
+	current := []nameID{a, b, c, d}
+	previews := previewLanItemMoves("Sec", current, []nameID{b, c, d, a})
+	require.Equal(t, []BulkRuleMovePreview{
+		{RuleName: "A", FromSection: "Sec", FromIndex: 1, ToSection: "Sec", ToIndex: 4, Anchor: "last"},
+	}, previews)
+	require.Equal(t, []nameID{a, b, c, d}, current, "the current order must not be modified")
+
+	previews = previewLanItemMoves("Sec", []nameID{a, b, c, d}, []nameID{d, c, b, a})
+	require.Equal(t, []BulkRuleMovePreview{
+		{RuleName: "A", FromSection: "Sec", FromIndex: 1, ToSection: "Sec", ToIndex: 4, Anchor: "last"},
+		{RuleName: "B", FromSection: "Sec", FromIndex: 2, ToSection: "Sec", ToIndex: 3, Anchor: `before rule "A"`},
+		{RuleName: "C", FromSection: "Sec", FromIndex: 3, ToSection: "Sec", ToIndex: 2, Anchor: `before rule "B"`},
+	}, previews)
+}
@@ -39,6 +39,8 @@ type lanInterfaceResource struct {
 	client *catoClientData
 }
 
+func (r *lanInterfaceResource) clientData() **catoClientData { return &r.client }
+
 func (r *lanInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_lan_interface"
 }
@@ -115,6 +117,7 @@ func (r *lanInterfaceResource) Schema(_ context.Context, _ resource.SchemaReques
 					stringvalidator.OneOf("DIRECT_LINK", "VIA_SWITCH"),
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -128,6 +131,7 @@ func (r *lanInterfaceResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *lanInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
@@ -143,6 +147,10 @@ func (r *lanInterfaceResource) ImportState(ctx context.Context, req resource.Imp
 
 //nolint:gocyclo,funlen,lll
 func (r *lanInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var cfg, plan LanInterface
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -266,6 +274,10 @@ func (r *lanInterfaceResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *lanInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state LanInterface
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -293,6 +305,10 @@ func (r *lanInterfaceResource) Read(ctx context.Context, req resource.ReadReques
 
 //nolint:gocyclo,lll
 func (r *lanInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var cfg, plan LanInterface
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -383,6 +399,9 @@ func (r *lanInterfaceResource) Update(ctx context.Context, req resource.UpdateRe
 
 //nolint:gocyclo,gocritic,lll
 func (r *lanInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state LanInterface
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -35,6 +35,8 @@ type lanInterfaceLagMemberResource struct {
 	client *catoClientData
 }
 
+func (r *lanInterfaceLagMemberResource) clientData() **catoClientData { return &r.client }
+
 const lanLagMemberIDParts = 2
 const lanLagMemberDestType = "LAN_LAG_MEMBER"
 const lanInterfaceRequiredMessage = "At least one LAN interface must be defined"
@@ -89,6 +91,7 @@ func (r *lanInterfaceLagMemberResource) Schema(_ context.Context, _ resource.Sch
 				},
 				Default: stringdefault.StaticString(lanLagMemberDestType),
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -106,11 +109,16 @@ func (r *lanInterfaceLagMemberResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 func (r *lanInterfaceLagMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan LanInterfaceLagMember
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -165,6 +173,10 @@ func (r *lanInterfaceLagMemberResource) Create(ctx context.Context, req resource
 }
 
 func (r *lanInterfaceLagMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state LanInterfaceLagMember
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -191,6 +203,10 @@ func (r *lanInterfaceLagMemberResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *lanInterfaceLagMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan LanInterfaceLagMember
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -245,6 +261,9 @@ func (r *lanInterfaceLagMemberResource) Update(ctx context.Context, req resource
 }
 
 func (r *lanInterfaceLagMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state LanInterfaceLagMember
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
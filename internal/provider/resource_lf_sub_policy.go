@@ -40,6 +40,8 @@ type lfSubPolicyResource struct {
 	subPolicyClient SocketLanSubPolicyClient
 }
 
+func (r *lfSubPolicyResource) clientData() **catoClientData { return &r.client }
+
 func (r *lfSubPolicyResource) getClient() SocketLanSubPolicyClient {
 	if r.subPolicyClient != nil {
 		return r.subPolicyClient
@@ -126,7 +128,8 @@ func (r *lfSubPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest
 					},
 				},
 			},
-			"scope": r.policyScopeSchema(),
+			"scope":      r.policyScopeSchema(),
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -177,11 +180,16 @@ func (r *lfSubPolicyResource) Configure(_ context.Context, req resource.Configur
 
 // ImportState imports a LAN Firewall sub-policy by its ID.
 func (r *lfSubPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 // Create adds a LAN Firewall sub-policy and hydrates its Terraform state from the API.
 func (r *lfSubPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan LanFirewallSubPolicy
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -250,6 +258,10 @@ func (r *lfSubPolicyResource) Create(ctx context.Context, req resource.CreateReq
 
 // Update modifies the scope rule of a LAN Firewall sub-policy and refreshes its state.
 func (r *lfSubPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan LanFirewallSubPolicy
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -352,6 +364,9 @@ func preserveLfSubPolicyUpdateState(
 
 // Delete removes a LAN Firewall sub-policy through the Cato API.
 func (r *lfSubPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state LanFirewallSubPolicy
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -386,6 +401,10 @@ func (r *lfSubPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // Read refreshes a LAN Firewall sub-policy from the Cato API and removes missing resources from state.
 func (r *lfSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state LanFirewallSubPolicy
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
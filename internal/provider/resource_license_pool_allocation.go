@@ -0,0 +1,676 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &licensePoolAllocationResource{}
+	_ resource.ResourceWithConfigure   = &licensePoolAllocationResource{}
+	_ resource.ResourceWithImportState = &licensePoolAllocationResource{}
+	_ resource.ResourceWithModifyPlan  = &licensePoolAllocationResource{}
+)
+
+// licensePoolBandwidthUnit is the granularity of pooled bandwidth allocations, in Mbps
+const licensePoolBandwidthUnit = 10
+
+var ErrLicensePoolNotFound = errors.New("pooled bandwidth license not found")
+
+func NewLicensePoolAllocationResource() resource.Resource {
+	return &licensePoolAllocationResource{}
+}
+
+type licensePoolAllocationResource struct {
+	client *catoClientData
+}
+
+func (r *licensePoolAllocationResource) clientData() **catoClientData { return &r.client }
+
+func (r *licensePoolAllocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_pool_allocation"
+}
+
+func (r *licensePoolAllocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_license_pool_allocation` resource allocates the bandwidth of a pooled bandwidth license " +
+			"(`CATO_PB` or `CATO_PB_SSE`) across sites, either as a fixed bandwidth or by weight. The allocations are " +
+			"validated against the pool capacity at plan time, and applied in an order that never exceeds the capacity: " +
+			"allocations are removed and decreased before they are increased or added. Sites allocated from the pool " +
+			"outside of this resource are left untouched and their bandwidth is not available to it. Do not manage " +
+			"the same site and license with `cato_license` as well.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the pooled bandwidth license",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"license_id": schema.StringAttribute{
+				Description: "The ID of the pooled bandwidth license (CATO_PB or CATO_PB_SSE) to allocate",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocations": schema.MapNestedAttribute{
+				Description: "Allocations by site ID, each with either a fixed `bandwidth` or a `weight`",
+				Required:    true,
+				Validators:  []validator.Map{mapvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bandwidth": schema.Int64Attribute{
+							Description: "Fixed bandwidth to allocate to the site in Mbps, in increments of 10",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(licensePoolBandwidthUnit),
+								customInt64Validator{},
+							},
+						},
+						"weight": schema.Int64Attribute{
+							Description: "Relative weight of the site in the bandwidth left after the fixed allocations. " +
+								"Every weighted site gets at least 10 Mbps, the rest is split proportionally in increments of 10 Mbps.",
+							Optional:   true,
+							Validators: []validator.Int64{int64validator.AtLeast(1)},
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.ExactlyOneOf(
+							path.MatchRelative().AtName("bandwidth"),
+							path.MatchRelative().AtName("weight"),
+						),
+					},
+				},
+			},
+			"total_bandwidth": schema.Int64Attribute{
+				Description: "Bandwidth in Mbps to split across the allocations, in increments of 10 " +
+					"(default: all the pool bandwidth not allocated to other sites)",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(licensePoolBandwidthUnit),
+					customInt64Validator{},
+				},
+			},
+			"allocated_bandwidth": schema.MapAttribute{
+				Description: "The bandwidth allocated to each site in Mbps, by site ID",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"pool_total": schema.Int64Attribute{
+				Description: "Total bandwidth of the pool in Mbps",
+				Computed:    true,
+			},
+			"pool_available": schema.Int64Attribute{
+				Description: "Bandwidth of the pool in Mbps that is not allocated to any site",
+				Computed:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *licensePoolAllocationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *licensePoolAllocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("license_id"), req.ID)...)
+}
+
+// ModifyPlan resolves the weighted allocations and validates them against the pool capacity
+func (r *licensePoolAllocationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil { // resource destruction, or provider not configured yet
+		return
+	}
+
+	var plan LicensePoolAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	allocations, known := licensePoolAllocations(ctx, plan, &resp.Diagnostics)
+	if !known || !utils.HasValue(plan.LicenseID) || plan.TotalBandwidth.IsUnknown() || plan.AccountID.IsUnknown() {
+		return // computed after apply
+	}
+
+	var prior LicensePoolAllocationModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.client.forAccount(ctx, plan.AccountID)
+	pool, err := readLicensePool(ctx, client, plan.LicenseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("license_id"), "Invalid pooled bandwidth license", err.Error())
+		return
+	}
+
+	managed := licensePoolManagedSites(ctx, allocations, prior, &resp.Diagnostics)
+	capacity := pool.total - pool.allocatedExcept(managed)
+	desired, err := resolveLicensePoolAllocations(allocations, plan.TotalBandwidth, capacity)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("allocations"), "License pool over-allocated", err.Error())
+		return
+	}
+
+	plan.ID = plan.LicenseID
+	plan.PoolTotal = types.Int64Value(pool.total)
+	plan.PoolAvailable = types.Int64Value(capacity - sumBandwidth(desired))
+	plan.AllocatedBandwidth = licensePoolBandwidthMap(ctx, desired, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create the license pool allocations
+func (r *licensePoolAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan LicensePoolAllocationModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyLicensePoolAllocations(ctx, plan, LicensePoolAllocationModel{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateLicensePoolAllocationState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating license pool allocation state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the license pool allocations
+func (r *licensePoolAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state LicensePoolAllocationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateLicensePoolAllocationState(ctx, state)
+	if hydrateErr != nil {
+		if errors.Is(hydrateErr, ErrLicensePoolNotFound) {
+			tflog.Warn(ctx, "pooled bandwidth license not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating license pool allocation state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the license pool allocations
+func (r *licensePoolAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state LicensePoolAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyLicensePoolAllocations(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateLicensePoolAllocationState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating license pool allocation state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the allocations of the managed sites from the pool
+func (r *licensePoolAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state LicensePoolAllocationModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := readLicensePool(ctx, r.client, state.LicenseID.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrLicensePoolNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Cato API Licensing error", err.Error())
+		return
+	}
+
+	managed := licensePoolManagedSites[int64](ctx, nil, state, &resp.Diagnostics)
+	current := pool.allocationsOf(managed)
+	steps := planLicensePoolSteps(current, map[string]int64{})
+	for _, step := range steps {
+		if err := r.applyLicensePoolStep(ctx, pool, state.LicenseID.ValueString(), step); err != nil {
+			resp.Diagnostics.AddError("Cato API RemoveSiteBwLicense error", err.Error())
+			return
+		}
+	}
+}
+
+// applyLicensePoolAllocations moves the managed sites from their current to their planned allocations
+func (r *licensePoolAllocationResource) applyLicensePoolAllocations(ctx context.Context, plan, prior LicensePoolAllocationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	desired := make(map[string]int64)
+	diags.Append(plan.AllocatedBandwidth.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	licenseID := plan.LicenseID.ValueString()
+	pool, err := readLicensePool(ctx, r.client, licenseID)
+	if err != nil {
+		diags.AddError("Cato API Licensing error", err.Error())
+		return diags
+	}
+
+	managed := licensePoolManagedSites(ctx, desired, prior, &diags)
+	steps := planLicensePoolSteps(pool.allocationsOf(managed), desired)
+	if err := checkLicensePoolSteps(pool.total, pool.allocatedExcept(nil), steps); err != nil {
+		diags.AddError("License pool over-allocated", err.Error())
+		return diags
+	}
+
+	for _, step := range steps {
+		if err := r.applyLicensePoolStep(ctx, pool, licenseID, step); err != nil {
+			diags.AddError("Cato API license allocation error",
+				fmt.Sprintf("site %s, %d to %d Mbps: %s", step.siteID, step.from, step.to, err.Error()))
+			return diags
+		}
+	}
+	return diags
+}
+
+// applyLicensePoolStep changes the allocation of a single site
+func (r *licensePoolAllocationResource) applyLicensePoolStep(ctx context.Context, pool *licensePool, licenseID string,
+	step licensePoolStep,
+) error {
+	site := &cato_models.SiteRefInput{By: licenseSiteRefByID, Input: step.siteID}
+
+	switch {
+	case step.to == 0:
+		input := cato_models.RemoveSiteBwLicenseInput{LicenseID: licenseID, Site: site}
+		tflog.Debug(ctx, "RemoveSiteBwLicense", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		_, err := r.client.catov2.RemoveSiteBwLicense(ctx, r.client.AccountId, input)
+		return err
+
+	case step.from == 0:
+		bw := step.to
+		// a site moved from a site license to the pool replaces its license
+		if siteLicenseID, ok := getSiteLicenseBySiteID(step.siteID, pool.licensing); ok {
+			input := cato_models.ReplaceSiteBwLicenseInput{
+				Bw:                &bw,
+				LicenseIDToAdd:    licenseID,
+				LicenseIDToRemove: siteLicenseID,
+				Site:              site,
+			}
+			tflog.Debug(ctx, "ReplaceSiteBwLicense", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+			_, err := r.client.catov2.ReplaceSiteBwLicense(ctx, r.client.AccountId, input)
+			return err
+		}
+		input := cato_models.AssignSiteBwLicenseInput{Bw: &bw, LicenseID: licenseID, Site: site}
+		tflog.Debug(ctx, "AssignSiteBwLicense", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		_, err := r.client.catov2.AssignSiteBwLicense(ctx, r.client.AccountId, input)
+		return err
+
+	default:
+		input := cato_models.UpdateSiteBwLicenseInput{Bw: step.to, LicenseID: licenseID, Site: site}
+		tflog.Debug(ctx, "UpdateSiteBwLicense", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		_, err := r.client.catov2.UpdateSiteBwLicense(ctx, r.client.AccountId, input)
+		return err
+	}
+}
+
+func (r *licensePoolAllocationResource) hydrateLicensePoolAllocationState(ctx context.Context, prior LicensePoolAllocationModel,
+) (*LicensePoolAllocationModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	pool, err := readLicensePool(ctx, r.client, prior.LicenseID.ValueString())
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// report the allocations of the configured sites only, a missing site shows up as a change
+	configured := make(map[string]LicensePoolSiteAllocationModel)
+	if utils.HasValue(prior.Allocations) {
+		diags.Append(prior.Allocations.ElementsAs(ctx, &configured, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	sites := make([]string, 0, len(configured))
+	for siteID := range configured {
+		sites = append(sites, siteID)
+	}
+
+	state := prior
+	state.ID = prior.LicenseID
+	state.PoolTotal = types.Int64Value(pool.total)
+	state.PoolAvailable = types.Int64Value(pool.total - pool.allocatedExcept(nil))
+	state.AllocatedBandwidth = licensePoolBandwidthMap(ctx, pool.allocationsOf(sites), &diags)
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return &state, diags, nil
+}
+
+// licensePool is a pooled bandwidth license with its current allocations
+type licensePool struct {
+	licensing *cato_go_sdk.Licensing
+	total     int64
+	allocated map[string]int64 // site ID -> allocated bandwidth
+}
+
+func readLicensePool(ctx context.Context, client *catoClientData, licenseID string) (*licensePool, error) {
+	licensing, err := client.catov2.Licensing(ctx, client.AccountId)
+	tflog.Debug(ctx, "Licensing.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(licensing),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	license, ok := getLicenseByID(ctx, licenseID, licensing)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrLicensePoolNotFound, licenseID)
+	}
+	if license.Sku != licenseSkuCatoPB && license.Sku != licenseSkuCatoPBSSE {
+		return nil, fmt.Errorf("license %s is a %s license, must be a 'CATO_PB' or 'CATO_PB_SSE' pooled bandwidth license",
+			licenseID, license.Sku)
+	}
+
+	pool := &licensePool{
+		licensing: licensing,
+		total:     license.PooledBandwidthLicense.Total,
+		allocated: make(map[string]int64),
+	}
+	for _, site := range license.PooledBandwidthLicense.Sites {
+		pool.allocated[site.SitePooledBandwidthLicenseSite.ID] = site.AllocatedBandwidth
+	}
+	return pool, nil
+}
+
+// allocatedExcept returns the bandwidth allocated to all the sites of the pool but the given ones
+func (p *licensePool) allocatedExcept(siteIDs []string) int64 {
+	var sum int64
+	for siteID, bw := range p.allocated {
+		if !slices.Contains(siteIDs, siteID) {
+			sum += bw
+		}
+	}
+	return sum
+}
+
+// allocationsOf returns the current allocations of the given sites that are allocated from the pool
+func (p *licensePool) allocationsOf(siteIDs []string) map[string]int64 {
+	out := make(map[string]int64)
+	for _, siteID := range siteIDs {
+		if bw, ok := p.allocated[siteID]; ok {
+			out[siteID] = bw
+		}
+	}
+	return out
+}
+
+// getSiteLicenseBySiteID returns the ID of the site license (not pooled) assigned to the site
+func getSiteLicenseBySiteID(siteID string, licensing *cato_go_sdk.Licensing) (string, bool) {
+	for _, license := range licensing.Licensing.LicensingInfo.Licenses {
+		if license.Sku != licenseSkuCatoSite && license.Sku != licenseSkuCatoSSESite {
+			continue
+		}
+		if license.ID != nil && license.SiteLicense.Site != nil && license.SiteLicense.Site.ID == siteID {
+			return *license.ID, true
+		}
+	}
+	return "", false
+}
+
+// licensePoolAllocations parses the configured allocations; known is false when a value is only known after apply
+func licensePoolAllocations(ctx context.Context, plan LicensePoolAllocationModel, diags *diag.Diagnostics,
+) (allocations map[string]licensePoolSiteAllocation, known bool) {
+	if !utils.HasValue(plan.Allocations) {
+		return nil, false
+	}
+	var tfAllocations map[string]LicensePoolSiteAllocationModel
+	if utils.CheckErr(diags, plan.Allocations.ElementsAs(ctx, &tfAllocations, false)) {
+		return nil, false
+	}
+
+	allocations = make(map[string]licensePoolSiteAllocation, len(tfAllocations))
+	for siteID, tfAllocation := range tfAllocations {
+		if tfAllocation.Bandwidth.IsUnknown() || tfAllocation.Weight.IsUnknown() {
+			return nil, false
+		}
+		allocations[siteID] = licensePoolSiteAllocation{
+			bandwidth: tfAllocation.Bandwidth.ValueInt64(),
+			weight:    tfAllocation.Weight.ValueInt64(),
+		}
+	}
+	return allocations, true
+}
+
+// licensePoolManagedSites returns the sites of the allocations and of the prior state, sorted
+func licensePoolManagedSites[T any](ctx context.Context, allocations map[string]T, prior LicensePoolAllocationModel,
+	diags *diag.Diagnostics,
+) []string {
+	sites := make([]string, 0, len(allocations))
+	for siteID := range allocations {
+		sites = append(sites, siteID)
+	}
+	if utils.HasValue(prior.AllocatedBandwidth) {
+		var priorBandwidth map[string]int64
+		diags.Append(prior.AllocatedBandwidth.ElementsAs(ctx, &priorBandwidth, false)...)
+		for siteID := range priorBandwidth {
+			sites = append(sites, siteID)
+		}
+	}
+	slices.Sort(sites)
+	return slices.Compact(sites)
+}
+
+func licensePoolBandwidthMap(ctx context.Context, bandwidth map[string]int64, diags *diag.Diagnostics) types.Map {
+	out, d := types.MapValueFrom(ctx, types.Int64Type, bandwidth)
+	diags.Append(d...)
+	return out
+}
+
+// licensePoolSiteAllocation is the configured allocation of a site, by fixed bandwidth or by weight
+type licensePoolSiteAllocation struct {
+	bandwidth int64
+	weight    int64
+}
+
+// resolveLicensePoolAllocations returns the bandwidth of every site. The fixed bandwidths are
+// allocated first, the rest of the budget (total, or the capacity when total is not set) is split
+// across the weighted sites in units of 10 Mbps: one unit per site, and the remaining units in
+// proportion to the weights, by largest remainder.
+func resolveLicensePoolAllocations(allocations map[string]licensePoolSiteAllocation, total types.Int64, capacity int64,
+) (map[string]int64, error) {
+	budget := capacity
+	if utils.HasValue(total) {
+		if total.ValueInt64() > capacity {
+			return nil, fmt.Errorf("total_bandwidth of %d Mbps exceeds the %d Mbps of the pool available to these sites",
+				total.ValueInt64(), capacity)
+		}
+		budget = total.ValueInt64()
+	}
+
+	out := make(map[string]int64, len(allocations))
+	var fixed, totalWeight int64
+	var weighted []string
+	for siteID, allocation := range allocations {
+		if allocation.weight > 0 {
+			weighted = append(weighted, siteID)
+			totalWeight += allocation.weight
+			continue
+		}
+		out[siteID] = allocation.bandwidth
+		fixed += allocation.bandwidth
+	}
+	if fixed > budget {
+		return nil, fmt.Errorf("fixed allocations of %d Mbps exceed the %d Mbps available", fixed, budget)
+	}
+	if len(weighted) == 0 {
+		return out, nil
+	}
+
+	units := (budget - fixed) / licensePoolBandwidthUnit
+	if units < int64(len(weighted)) {
+		return nil, fmt.Errorf("%d Mbps left after the fixed allocations cannot give %d weighted sites at least %d Mbps each",
+			budget-fixed, len(weighted), licensePoolBandwidthUnit)
+	}
+
+	// one unit per site, then the shares of the remaining units
+	sort.Strings(weighted)
+	spare := units - int64(len(weighted))
+	shares := make(map[string]int64, len(weighted))
+	remainders := make(map[string]int64, len(weighted))
+	var given int64
+	for _, siteID := range weighted {
+		share := spare * allocations[siteID].weight
+		shares[siteID] = 1 + share/totalWeight
+		remainders[siteID] = share % totalWeight
+		given += share / totalWeight
+	}
+	byRemainder := slices.Clone(weighted)
+	sort.SliceStable(byRemainder, func(i, j int) bool { // ties go to the heavier site
+		ri, rj := remainders[byRemainder[i]], remainders[byRemainder[j]]
+		if ri != rj {
+			return ri > rj
+		}
+		return allocations[byRemainder[i]].weight > allocations[byRemainder[j]].weight
+	})
+	for i := int64(0); i < spare-given; i++ {
+		shares[byRemainder[i]]++
+	}
+
+	for _, siteID := range weighted {
+		out[siteID] = shares[siteID] * licensePoolBandwidthUnit
+	}
+	return out, nil
+}
+
+// licensePoolStep changes the allocation of a site from one bandwidth to another, 0 meaning not allocated
+type licensePoolStep struct {
+	siteID string
+	from   int64
+	to     int64
+}
+
+// planLicensePoolSteps returns the steps moving the current allocations to the desired ones. Releasing
+// steps (removals and decreases) come first, so the pool usage only grows once it is at its lowest and
+// never exceeds the capacity when the desired allocations fit in it. Sites are ordered by ID in each group.
+func planLicensePoolSteps(current, desired map[string]int64) []licensePoolStep {
+	var releasing, growing []licensePoolStep
+	for siteID, from := range current {
+		to := desired[siteID]
+		if to < from {
+			releasing = append(releasing, licensePoolStep{siteID: siteID, from: from, to: to})
+		}
+	}
+	for siteID, to := range desired {
+		from := current[siteID]
+		if to > from {
+			growing = append(growing, licensePoolStep{siteID: siteID, from: from, to: to})
+		}
+	}
+
+	bySite := func(steps []licensePoolStep) {
+		sort.Slice(steps, func(i, j int) bool { return steps[i].siteID < steps[j].siteID })
+	}
+	bySite(releasing)
+	bySite(growing)
+	return append(releasing, growing...)
+}
+
+// checkLicensePoolSteps verifies that the pool usage stays within its capacity after every step
+func checkLicensePoolSteps(capacity, used int64, steps []licensePoolStep) error {
+	for _, step := range steps {
+		used += step.to - step.from
+		if used > capacity {
+			return fmt.Errorf("allocating %d Mbps to site %s would use %d Mbps of the %d Mbps pool",
+				step.to, step.siteID, used, capacity)
+		}
+	}
+	return nil
+}
+
+func sumBandwidth(bandwidth map[string]int64) int64 {
+	var sum int64
+	for _, bw := range bandwidth {
+		sum += bw
+	}
+	return sum
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLicensePoolAllocations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		allocations map[string]licensePoolSiteAllocation
+		total       types.Int64
+		capacity    int64
+		want        map[string]int64
+		wantErr     string
+	}{
+		{
+			name: "fixed only",
+			allocations: map[string]licensePoolSiteAllocation{
+				"1": {bandwidth: 100},
+				"2": {bandwidth: 50},
+			},
+			total:    types.Int64Null(),
+			capacity: 200,
+			want:     map[string]int64{"1": 100, "2": 50},
+		},
+		{
+			name: "weights split the capacity left after fixed",
+			allocations: map[string]licensePoolSiteAllocation{
+				"1": {bandwidth: 100},
+				"2": {weight: 1},
+				"3": {weight: 3},
+			},
+			total:    types.Int64Null(),
+			capacity: 500,
+			want:     map[string]int64{"1": 100, "2": 100, "3": 300},
+		},
+		{
+			name: "largest remainder rounding uses the whole budget",
+			allocations: map[string]licensePoolSiteAllocation{
+				"a": {weight: 1},
+				"b": {weight: 1},
+				"c": {weight: 1},
+			},
+			total:    types.Int64Value(100),
+			capacity: 1000,
+			want:     map[string]int64{"a": 40, "b": 30, "c": 30},
+		},
+		{
+			name: "every weighted site gets at least one unit",
+			allocations: map[string]licensePoolSiteAllocation{
+				"a": {weight: 1},
+				"b": {weight: 100},
+			},
+			total:    types.Int64Null(),
+			capacity: 30,
+			want:     map[string]int64{"a": 10, "b": 20},
+		},
+		{
+			name: "fixed over capacity",
+			allocations: map[string]licensePoolSiteAllocation{
+				"1": {bandwidth: 300},
+			},
+			total:    types.Int64Null(),
+			capacity: 200,
+			wantErr:  "exceed the 200 Mbps available",
+		},
+		{
+			name: "total over capacity",
+			allocations: map[string]licensePoolSiteAllocation{
+				"1": {weight: 1},
+			},
+			total:    types.Int64Value(300),
+			capacity: 200,
+			wantErr:  "total_bandwidth of 300 Mbps exceeds",
+		},
+		{
+			name: "not enough left for weighted sites",
+			allocations: map[string]licensePoolSiteAllocation{
+				"1": {bandwidth: 190},
+				"2": {weight: 1},
+				"3": {weight: 1},
+			},
+			total:    types.Int64Null(),
+			capacity: 200,
+			wantErr:  "cannot give 2 weighted sites",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveLicensePoolAllocations(tt.allocations, tt.total, tt.capacity)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlanLicensePoolStepsReleasesBeforeGrowing(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]int64{"a": 100, "b": 100, "c": 50}
+	desired := map[string]int64{"a": 150, "b": 20, "d": 80}
+
+	steps := planLicensePoolSteps(current, desired)
+	require.Equal(t, []licensePoolStep{
+		{siteID: "b", from: 100, to: 20},
+		{siteID: "c", from: 50, to: 0},
+		{siteID: "a", from: 100, to: 150},
+		{siteID: "d", from: 0, to: 80},
+	}, steps)
+
+	// the pool is full before and after the change, and never exceeded in between
+	require.NoError(t, checkLicensePoolSteps(300, 250, steps))
+	require.Error(t, checkLicensePoolSteps(200, 250, steps))
+}
+
+func TestPlanLicensePoolStepsNoChange(t *testing.T) {
+	t.Parallel()
+
+	allocations := map[string]int64{"a": 100}
+	require.Empty(t, planLicensePoolSteps(allocations, allocations))
+}
+
+func TestCheckLicensePoolStepsReportsOverflowingStep(t *testing.T) {
+	t.Parallel()
+
+	steps := []licensePoolStep{
+		{siteID: "a", from: 0, to: 50},
+		{siteID: "b", from: 0, to: 60},
+	}
+	require.ErrorContains(t, checkLicensePoolSteps(100, 0, steps), "site b")
+}
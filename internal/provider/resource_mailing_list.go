@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &mailingListResource{}
+	_ resource.ResourceWithConfigure   = &mailingListResource{}
+	_ resource.ResourceWithImportState = &mailingListResource{}
+)
+
+var ErrMailingListNotFound = errors.New("mailing list not found")
+
+func NewMailingListResource() resource.Resource {
+	return &mailingListResource{}
+}
+
+type mailingListResource struct {
+	client *catoClientData
+}
+
+func (r *mailingListResource) clientData() **catoClientData { return &r.client }
+
+func (r *mailingListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mailing_list"
+}
+
+func (r *mailingListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_mailing_list` resource contains the configuration parameters necessary to manage " +
+			"a mailing list for notifications. Mailing lists can be referenced by `id` or `name` in the `mailing_list` " +
+			"attributes of the rule tracking alerts and in `cato_subscription_group`.",
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Email addresses of the mailing list recipients",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(sdpUserEmailRE, "must be a valid email address")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the mailing list",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the mailing list",
+				Required:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *mailingListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *mailingListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new mailing list
+func (r *mailingListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan MailingListModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateMailingListInput{
+		Emails: parse.PrepareStrings[string](ctx, plan.Emails, &resp.Diagnostics),
+		Name:   plan.Name.ValueString(),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new mailing list
+	tflog.Debug(ctx, "NotificationCreateMailingList", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationCreateMailingList(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationCreateMailingList", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationCreateMailingList error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetNotification().GetCreateMailingList().GetMailingList().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateMailingListState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating mailing list state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the mailing list
+func (r *mailingListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state MailingListModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateMailingListState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the mailing list was found
+		if errors.Is(hydrateErr, ErrMailingListNotFound) {
+			tflog.Warn(ctx, "mailing list not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating mailing list state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the mailing list
+func (r *mailingListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state MailingListModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("NotificationUpdateMailingList: ID is unknown", "Mailing list ID is not set in TF state")
+		return
+	}
+
+	input := cato_models.UpdateMailingListInput{
+		Emails: parse.PrepareStrings[string](ctx, plan.Emails, &resp.Diagnostics),
+		ID:     id,
+		Name:   parse.KnownStringPointer(plan.Name),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "NotificationUpdateMailingList", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationUpdateMailingList(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationUpdateMailingList", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationUpdateMailingList error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateMailingListState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating mailing list state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the mailing list
+func (r *mailingListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state MailingListModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteMailingListInput{
+		MailingList: &cato_models.MailingListRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the mailing list
+	tflog.Debug(ctx, "NotificationDeleteMailingList", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationDeleteMailingList(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationDeleteMailingList", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationDeleteMailingList error", err.Error())
+		return
+	}
+}
+
+// hydrateMailingListState fetches the current state of a mailing list from the API
+func (r *mailingListResource) hydrateMailingListState(ctx context.Context, mailingListID string,
+) (*MailingListModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.MailingListRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: mailingListID,
+	}
+
+	// Call Cato API to get the mailing list
+	tflog.Debug(ctx, "NotificationReadMailingList", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationReadMailingList(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationReadMailingList", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to MailingListModel
+	mailingList := result.GetNotification().GetMailingList()
+	if mailingList == nil {
+		return nil, diags, ErrMailingListNotFound
+	}
+
+	state := &MailingListModel{
+		Emails: parse.StringSetFunc(ctx, mailingList.Emails, func(s string) string { return s }, &diags),
+		ID:     types.StringValue(mailingList.ID),
+		Name:   types.StringValue(mailingList.Name),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
@@ -65,6 +65,8 @@ type networkRangeResource struct {
 	networkRangeClient NetworkRangeClient
 }
 
+func (r *networkRangeResource) clientData() **catoClientData { return &r.client }
+
 type NetworkRangeClient interface {
 	SiteAddNetworkRange(ctx context.Context, lanSocketInterfaceID string, addNetworkRangeInput cato_models.AddNetworkRangeInput,
 		accountID string, interceptors ...clientv2.RequestInterceptor) (*cato.SiteAddNetworkRange, error)
@@ -177,6 +179,7 @@ func (r *networkRangeResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Network range VLAN ID (Only releveant for VLAN range_type)",
 				Optional:    true,
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -309,6 +312,9 @@ func defaultPlanValue(cfgValue, stateValue types.String, stateDefined bool) (pla
 }
 
 func (r *networkRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	accountID := importAccountID(ctx, &req, resp)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
@@ -336,6 +342,10 @@ func (r *networkRangeResource) ImportState(ctx context.Context, req resource.Imp
 
 // Create the network range resource
 func (r *networkRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var cfg, plan *tf.NetworkRange
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -403,6 +413,10 @@ func (r *networkRangeResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read the network range resource
 func (r *networkRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state *tf.NetworkRange
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -431,6 +445,10 @@ func (r *networkRangeResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update the network range resource
 func (r *networkRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var cfg, plan *tf.NetworkRange
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -495,6 +513,9 @@ func (r *networkRangeResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete the network range resource
 func (r *networkRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state tf.NetworkRange
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,535 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &policySettingsResource{}
+	_ resource.ResourceWithConfigure   = &policySettingsResource{}
+	_ resource.ResourceWithImportState = &policySettingsResource{}
+)
+
+// policySettings are the settings of a policy as read from or sent to the API
+type policySettings struct {
+	enabled          bool
+	defaultAction    *string
+	logImplicitRules *bool
+	publishedBy      *string
+	publishedTime    *string
+}
+
+// policySettingsKind binds the policy settings resource to the API of one policy
+type policySettingsKind struct {
+	typeName       string   // resource type name without the provider prefix
+	title          string   // policy name used in descriptions
+	id             string   // fixed ID of the singleton
+	defaultActions []string // accepted values of default_action, empty when the policy has no implicit rule
+	read           func(ctx context.Context, c *catoClientData) (*policySettings, error)
+	update         func(ctx context.Context, c *catoClientData, in policySettings, diags *diag.Diagnostics)
+	publish        func(ctx context.Context, c *catoClientData) error
+}
+
+func NewIfPolicyResource() resource.Resource {
+	return &policySettingsResource{kind: ifPolicySettings}
+}
+
+func NewWfPolicyResource() resource.Resource {
+	return &policySettingsResource{kind: wfPolicySettings}
+}
+
+func NewTLSPolicyResource() resource.Resource {
+	return &policySettingsResource{kind: tlsPolicySettings}
+}
+
+func NewWnwPolicyResource() resource.Resource {
+	return &policySettingsResource{kind: wnwPolicySettings}
+}
+
+func NewLfPolicyResource() resource.Resource {
+	return &policySettingsResource{kind: lfPolicySettings}
+}
+
+type policySettingsResource struct {
+	client *catoClientData
+	kind   policySettingsKind
+}
+
+func (r *policySettingsResource) clientData() **catoClientData { return &r.client }
+
+func (r *policySettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind.typeName
+}
+
+func (r *policySettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaultAction := schema.StringAttribute{
+		Description: fmt.Sprintf("Action of the implicit rule applied to traffic no rule matches (%s); "+
+			"the current action is kept when not set", strings.Join(r.kind.defaultActions, ", ")),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{stringvalidator.OneOf(r.kind.defaultActions...)},
+	}
+	logImplicitRules := schema.BoolAttribute{
+		Description: "Log the traffic matched by the implicit rules; the current setting is kept when not set",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	if len(r.kind.defaultActions) == 0 {
+		defaultAction = schema.StringAttribute{
+			Description: "Not applicable, the " + r.kind.title + " policy has no implicit rule; always null",
+			Computed:    true,
+		}
+		logImplicitRules = schema.BoolAttribute{
+			Description: "Not applicable, the " + r.kind.title + " policy has no implicit rule; always null",
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("The `cato_%s` resource manages the account-level settings of the %s policy. "+
+			"The policy always exists: creating the resource adopts it and destroying the resource leaves the policy as is. "+
+			"Changes are published in a new policy revision.", r.kind.typeName, r.kind.title),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Fixed identifier of the policy (" + r.kind.id + ")",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Is the " + r.kind.title + " policy enabled?",
+				Required:    true,
+			},
+			"default_action":     defaultAction,
+			"log_implicit_rules": logImplicitRules,
+			"audit": schema.SingleNestedAttribute{
+				Description: "Audit of the published policy revision",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"published_time": schema.StringAttribute{
+						Description: "Audit log published time",
+						Computed:    true,
+					},
+					"published_by": schema.StringAttribute{
+						Description: "Author of the published log record",
+						Computed:    true,
+					},
+				},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *policySettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *policySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.kind.id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), false)...)
+}
+
+// Create adopts the policy and applies its settings
+func (r *policySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan PolicySettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePolicySettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydratePolicySettingsState(ctx)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating "+r.kind.typeName+" state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the policy settings
+func (r *policySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydratePolicySettingsState(ctx)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating "+r.kind.typeName+" state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the policy settings
+func (r *policySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan PolicySettingsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePolicySettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydratePolicySettingsState(ctx)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating "+r.kind.typeName+" state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete does not do anything, the policy always exists
+func (r *policySettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Warn(ctx, "cato_"+r.kind.typeName+" delete is a no-op; policy always exists")
+}
+
+// updatePolicySettings applies the planned settings and publishes them
+func (r *policySettingsResource) updatePolicySettings(ctx context.Context, plan PolicySettingsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	in := policySettings{
+		enabled:          plan.Enabled.ValueBool(),
+		defaultAction:    parse.KnownStringPointer(plan.DefaultAction),
+		logImplicitRules: parse.KnownBoolPointer(plan.LogImplicitRules),
+	}
+	if r.kind.update(ctx, r.client, in, &diags); diags.HasError() {
+		return diags
+	}
+	if err := r.kind.publish(ctx, r.client); err != nil {
+		diags.AddError("Failed to publish "+r.kind.title+" policy", err.Error())
+	}
+	return diags
+}
+
+// hydratePolicySettingsState fetches the current settings of the policy from the API
+func (r *policySettingsResource) hydratePolicySettingsState(ctx context.Context) (*PolicySettingsModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	settings, err := r.kind.read(ctx, r.client)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	state := &PolicySettingsModel{
+		Audit:            types.ObjectNull(PolicyAuditTypes),
+		DefaultAction:    types.StringPointerValue(settings.defaultAction),
+		Enabled:          types.BoolValue(settings.enabled),
+		ID:               types.StringValue(r.kind.id),
+		LogImplicitRules: types.BoolPointerValue(settings.logImplicitRules),
+	}
+	if settings.publishedTime != nil {
+		var objDiags diag.Diagnostics
+		state.Audit, objDiags = types.ObjectValueFrom(ctx, PolicyAuditTypes, PolicyAudit{
+			PublishedBy:   types.StringPointerValue(settings.publishedBy),
+			PublishedTime: types.StringPointerValue(settings.publishedTime),
+		})
+		diags.Append(objDiags...)
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+// policyToggle converts an enabled flag to the API toggle state
+func policyToggle(enabled bool) *cato_models.PolicyToggleState {
+	st := cato_models.PolicyToggleStateDisabled
+	if enabled {
+		st = cato_models.PolicyToggleStateEnabled
+	}
+	return &st
+}
+
+// policyToggleEnabled converts an API toggle state to an enabled flag, nil when the API did not return one
+func policyToggleEnabled(st *cato_models.PolicyToggleState) *bool {
+	if st == nil {
+		return nil
+	}
+	enabled := *st == cato_models.PolicyToggleStateEnabled
+	return &enabled
+}
+
+var ifPolicySettings = policySettingsKind{
+	typeName:       "if_policy",
+	title:          "Internet Firewall",
+	id:             "internet_firewall",
+	defaultActions: []string{string(cato_models.InternetFirewallActionEnumAllow), string(cato_models.InternetFirewallActionEnumBlock)},
+	read: func(ctx context.Context, c *catoClientData) (*policySettings, error) {
+		body, err := c.catov2.PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, c.AccountId)
+		tflog.Debug(ctx, "PolicyInternetFirewall", map[string]interface{}{"response": utils.InterfaceToJSONString(body)})
+		if err != nil {
+			return nil, err
+		}
+		pol := body.GetPolicy().GetInternetFirewall().GetPolicy()
+		out := &policySettings{
+			enabled:          pol.Enabled,
+			logImplicitRules: policyToggleEnabled(pol.ImplicitRuleTracking),
+		}
+		if pol.DefaultAction != nil {
+			out.defaultAction = ptr(string(*pol.DefaultAction))
+		}
+		if pol.Audit != nil {
+			out.publishedBy, out.publishedTime = &pol.Audit.PublishedBy, &pol.Audit.PublishedTime
+		}
+		return out, nil
+	},
+	update: func(ctx context.Context, c *catoClientData, in policySettings, diags *diag.Diagnostics) {
+		input := cato_models.InternetFirewallPolicyUpdateInput{State: policyToggle(in.enabled)}
+		if in.defaultAction != nil {
+			action := cato_models.InternetFirewallActionEnum(*in.defaultAction)
+			input.DefaultAction = &action
+		}
+		if in.logImplicitRules != nil {
+			input.ImplicitRuleTracking = policyToggle(*in.logImplicitRules)
+		}
+		tflog.Debug(ctx, "PolicyInternetFirewallUpdatePolicy", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := c.catov2.PolicyInternetFirewallUpdatePolicy(ctx, &cato_models.InternetFirewallPolicyMutationInput{}, input, c.AccountId)
+		tflog.Debug(ctx, "PolicyInternetFirewallUpdatePolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		up := result.GetPolicy().GetInternetFirewall().GetUpdatePolicy()
+		if utils.CheckAPIErrors(err, up.GetErrors(), "Cato API PolicyInternetFirewallUpdatePolicy error", diags) {
+			return
+		}
+		checkPolicyMutationStatus(up.GetStatus(), "Cato API PolicyInternetFirewallUpdatePolicy error", diags)
+	},
+	publish: func(ctx context.Context, c *catoClientData) error {
+		_, err := c.catov2.PolicyInternetFirewallPublishPolicyRevision(
+			ctx,
+			&cato_models.InternetFirewallPolicyMutationInput{},
+			&cato_models.PolicyPublishRevisionInput{},
+			c.AccountId,
+		)
+		return err
+	},
+}
+
+var wfPolicySettings = policySettingsKind{
+	typeName:       "wf_policy",
+	title:          "WAN Firewall",
+	id:             "wan_firewall",
+	defaultActions: []string{string(cato_models.WanFirewallActionEnumAllow), string(cato_models.WanFirewallActionEnumBlock)},
+	read: func(ctx context.Context, c *catoClientData) (*policySettings, error) {
+		body, err := c.catov2.PolicyWanFirewall(ctx, &cato_models.WanFirewallPolicyInput{}, c.AccountId)
+		tflog.Debug(ctx, "PolicyWanFirewall", map[string]interface{}{"response": utils.InterfaceToJSONString(body)})
+		if err != nil {
+			return nil, err
+		}
+		pol := body.GetPolicy().GetWanFirewall().GetPolicy()
+		out := &policySettings{
+			enabled:          pol.Enabled,
+			logImplicitRules: policyToggleEnabled(pol.ImplicitRuleTracking),
+		}
+		if pol.DefaultAction != nil {
+			out.defaultAction = ptr(string(*pol.DefaultAction))
+		}
+		if pol.Audit != nil {
+			out.publishedBy, out.publishedTime = &pol.Audit.PublishedBy, &pol.Audit.PublishedTime
+		}
+		return out, nil
+	},
+	update: func(ctx context.Context, c *catoClientData, in policySettings, diags *diag.Diagnostics) {
+		input := cato_models.WanFirewallPolicyUpdateInput{State: policyToggle(in.enabled)}
+		if in.defaultAction != nil {
+			action := cato_models.WanFirewallActionEnum(*in.defaultAction)
+			input.DefaultAction = &action
+		}
+		if in.logImplicitRules != nil {
+			input.ImplicitRuleTracking = policyToggle(*in.logImplicitRules)
+		}
+		tflog.Debug(ctx, "PolicyWanFirewallUpdatePolicy", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := c.catov2.PolicyWanFirewallUpdatePolicy(ctx, &cato_models.WanFirewallPolicyMutationInput{}, input, c.AccountId)
+		tflog.Debug(ctx, "PolicyWanFirewallUpdatePolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		up := result.GetPolicy().GetWanFirewall().GetUpdatePolicy()
+		if utils.CheckAPIErrors(err, up.GetErrors(), "Cato API PolicyWanFirewallUpdatePolicy error", diags) {
+			return
+		}
+		checkPolicyMutationStatus(up.GetStatus(), "Cato API PolicyWanFirewallUpdatePolicy error", diags)
+	},
+	publish: func(ctx context.Context, c *catoClientData) error {
+		_, err := c.catov2.PolicyWanFirewallPublishPolicyRevision(ctx, &cato_models.PolicyPublishRevisionInput{}, c.AccountId)
+		return err
+	},
+}
+
+var tlsPolicySettings = policySettingsKind{
+	typeName:       "tls_policy",
+	title:          "TLS Inspection",
+	id:             "tls_inspect",
+	defaultActions: []string{"INSPECT", "BYPASS"},
+	read: func(ctx context.Context, c *catoClientData) (*policySettings, error) {
+		body, err := c.catov2.Tlsinspectpolicy(ctx, c.AccountId)
+		tflog.Debug(ctx, "Tlsinspectpolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(body)})
+		if err != nil {
+			return nil, err
+		}
+		pol := body.GetPolicy().GetTLSInspect().GetPolicy()
+		out := &policySettings{
+			enabled:          pol.Enabled,
+			logImplicitRules: policyToggleEnabled(pol.ImplicitRuleTracking),
+		}
+		if pol.DefaultAction != nil {
+			out.defaultAction = ptr(string(*pol.DefaultAction))
+		}
+		if pol.Audit != nil {
+			out.publishedBy, out.publishedTime = &pol.Audit.PublishedBy, &pol.Audit.PublishedTime
+		}
+		return out, nil
+	},
+	update: func(ctx context.Context, c *catoClientData, in policySettings, diags *diag.Diagnostics) {
+		input := cato_models.TLSInspectPolicyUpdateInput{State: policyToggle(in.enabled)}
+		if in.defaultAction != nil {
+			action := cato_models.TLSInspectActionEnum(*in.defaultAction)
+			input.DefaultAction = &action
+		}
+		if in.logImplicitRules != nil {
+			input.ImplicitRuleTracking = policyToggle(*in.logImplicitRules)
+		}
+		tflog.Debug(ctx, "PolicyTLSInspectUpdatePolicy", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := c.catov2.PolicyTLSInspectUpdatePolicy(ctx, input, c.AccountId)
+		tflog.Debug(ctx, "PolicyTLSInspectUpdatePolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		up := result.GetPolicy().GetTLSInspect().GetUpdatePolicy()
+		if utils.CheckAPIErrors(err, up.GetErrors(), "Cato API PolicyTLSInspectUpdatePolicy error", diags) {
+			return
+		}
+		checkPolicyMutationStatus(up.GetStatus(), "Cato API PolicyTLSInspectUpdatePolicy error", diags)
+	},
+	publish: func(ctx context.Context, c *catoClientData) error {
+		_, err := c.catov2.PolicyTLSInspectPublishPolicyRevision(ctx, c.AccountId)
+		return err
+	},
+}
+
+var wnwPolicySettings = policySettingsKind{
+	typeName: "wnw_policy",
+	title:    "WAN Network",
+	id:       "wan_network",
+	read: func(ctx context.Context, c *catoClientData) (*policySettings, error) {
+		body, err := c.catov2.WanNetworkPolicy(ctx, c.AccountId)
+		tflog.Debug(ctx, "WanNetworkPolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(body)})
+		if err != nil {
+			return nil, err
+		}
+		pol := body.GetPolicy().GetWanNetwork().GetPolicy()
+		out := &policySettings{enabled: pol.Enabled}
+		if pol.Audit != nil {
+			out.publishedBy, out.publishedTime = &pol.Audit.PublishedBy, &pol.Audit.PublishedTime
+		}
+		return out, nil
+	},
+	update: func(ctx context.Context, c *catoClientData, in policySettings, diags *diag.Diagnostics) {
+		input := cato_models.WanNetworkPolicyUpdateInput{State: policyToggle(in.enabled)}
+		tflog.Debug(ctx, "PolicyWanNetworkUpdatePolicy", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := c.catov2.PolicyWanNetworkUpdatePolicy(ctx, input, c.AccountId)
+		tflog.Debug(ctx, "PolicyWanNetworkUpdatePolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		up := result.GetPolicy().GetWanNetwork().GetUpdatePolicy()
+		if utils.CheckAPIErrors(err, up.GetErrors(), "Cato API PolicyWanNetworkUpdatePolicy error", diags) {
+			return
+		}
+		checkPolicyMutationStatus(up.GetStatus(), "Cato API PolicyWanNetworkUpdatePolicy error", diags)
+	},
+	publish: func(ctx context.Context, c *catoClientData) error {
+		_, err := c.catov2.PolicyWanNetworkPublishPolicyRevision(ctx, c.AccountId)
+		return err
+	},
+}
+
+var lfPolicySettings = policySettingsKind{
+	typeName: "lf_policy",
+	title:    "Socket LAN",
+	id:       "socket_lan",
+	read: func(ctx context.Context, c *catoClientData) (*policySettings, error) {
+		body, err := c.catov2.PolicySocketLanPolicy(ctx, c.AccountId, nil)
+		tflog.Debug(ctx, "PolicySocketLanPolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(body)})
+		if err != nil {
+			return nil, err
+		}
+		pol := body.GetPolicy().GetSocketLan().GetPolicy()
+		out := &policySettings{enabled: pol.Enabled}
+		if pol.Audit != nil {
+			out.publishedBy, out.publishedTime = &pol.Audit.PublishedBy, &pol.Audit.PublishedTime
+		}
+		return out, nil
+	},
+	update: func(ctx context.Context, c *catoClientData, in policySettings, diags *diag.Diagnostics) {
+		input := cato_models.SocketLanPolicyUpdateInput{State: policyToggle(in.enabled)}
+		tflog.Debug(ctx, "PolicySocketLanUpdatePolicy", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := c.catov2.PolicySocketLanUpdatePolicy(ctx, nil, input, c.AccountId)
+		tflog.Debug(ctx, "PolicySocketLanUpdatePolicy", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+		up := result.GetPolicy().GetSocketLan().GetUpdatePolicy()
+		if utils.CheckAPIErrors(err, up.GetErrors(), "Cato API PolicySocketLanUpdatePolicy error", diags) {
+			return
+		}
+		checkPolicyMutationStatus(up.GetStatus(), "Cato API PolicySocketLanUpdatePolicy error", diags)
+	},
+	publish: func(ctx context.Context, c *catoClientData) error {
+		_, err := c.catov2.PolicySocketLanPublishPolicyRevision(ctx, nil, &cato_models.PolicyPublishRevisionInput{}, c.AccountId)
+		return err
+	},
+}
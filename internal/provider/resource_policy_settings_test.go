@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyToggleRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, cato_models.PolicyToggleStateEnabled, *policyToggle(true))
+	require.Equal(t, cato_models.PolicyToggleStateDisabled, *policyToggle(false))
+
+	for _, enabled := range []bool{true, false} {
+		require.Equal(t, enabled, *policyToggleEnabled(policyToggle(enabled)))
+	}
+	require.Nil(t, policyToggleEnabled(nil))
+}
+
+func TestPolicySettingsKinds(t *testing.T) {
+	t.Parallel()
+
+	seen := map[string]bool{}
+	for _, kind := range []policySettingsKind{ifPolicySettings, wfPolicySettings, tlsPolicySettings, wnwPolicySettings, lfPolicySettings} {
+		require.False(t, seen[kind.typeName], "duplicate type name %s", kind.typeName)
+		seen[kind.typeName] = true
+		require.NotNil(t, kind.read)
+		require.NotNil(t, kind.update)
+		require.NotNil(t, kind.publish)
+	}
+}
+
+func TestPolicySettingsSchemaImplicitRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		kind         policySettingsKind
+		configurable bool
+	}{
+		{kind: ifPolicySettings, configurable: true},
+		{kind: wfPolicySettings, configurable: true},
+		{kind: tlsPolicySettings, configurable: true},
+		{kind: wnwPolicySettings},
+		{kind: lfPolicySettings},
+	}
+	for _, tc := range cases {
+		resp := &resource.SchemaResponse{}
+		(&policySettingsResource{kind: tc.kind}).Schema(ctx, resource.SchemaRequest{}, resp)
+		require.False(t, resp.Diagnostics.HasError(), tc.kind.typeName)
+
+		for _, name := range []string{"default_action", "log_implicit_rules"} {
+			attr := resp.Schema.Attributes[name]
+			require.Equal(t, tc.configurable, attr.IsOptional(), "%s.%s", tc.kind.typeName, name)
+			require.True(t, attr.IsComputed(), "%s.%s", tc.kind.typeName, name)
+		}
+	}
+}
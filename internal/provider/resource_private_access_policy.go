@@ -32,6 +32,8 @@ type privAccessPolicyResource struct {
 	client *catoClientData
 }
 
+func (r *privAccessPolicyResource) clientData() **catoClientData { return &r.client }
+
 func (r *privAccessPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_private_access_policy"
 }
@@ -65,6 +67,7 @@ func (r *privAccessPolicyResource) Schema(_ context.Context, _ resource.SchemaRe
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -77,13 +80,18 @@ func (r *privAccessPolicyResource) Configure(_ context.Context, req resource.Con
 	r.client = req.ProviderData.(*catoClientData)
 }
 
-func (r *privAccessPolicyResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *privAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), false)...)
 }
 
 // Create does not really create anything as the plolicy always exists.
 // It updates the 'enabled' field of the policy.
 func (r *privAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivAccessPolicyModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -106,6 +114,10 @@ func (r *privAccessPolicyResource) Create(ctx context.Context, req resource.Crea
 
 // Read the policy status
 func (r *privAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state PrivAccessPolicyModel
 
 	diags := req.State.Get(ctx, &state)
@@ -130,6 +142,10 @@ func (r *privAccessPolicyResource) Read(ctx context.Context, req resource.ReadRe
 
 // Update the policy
 func (r *privAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivAccessPolicyModel
 
 	diags := req.Plan.Get(ctx, &plan)
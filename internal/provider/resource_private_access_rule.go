@@ -44,6 +44,8 @@ type privAccessRuleResource struct {
 	client *catoClientData
 }
 
+func (r *privAccessRuleResource) clientData() **catoClientData { return &r.client }
+
 type (
 	privateAccessRuleSource         = cato_go_sdk.PolicyReadPrivateAccessPolicy_Policy_PrivateAccess_Policy_Rules_Rule_Source
 	privateAccessRuleTracking       = cato_go_sdk.PolicyReadPrivateAccessPolicy_Policy_PrivateAccess_Policy_Rules_Rule_Tracking
@@ -136,6 +138,7 @@ func (r *privAccessRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"user_attributes": r.schemaUserAttributes(),
 			// index is only used in bulk operation
 			// "section": r.schemaSection(), -- not available in the 1st phase
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -356,12 +359,17 @@ func (r *privAccessRuleResource) Configure(_ context.Context, req resource.Confi
 }
 
 func (r *privAccessRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 // Create private access policy rule
 func (r *privAccessRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivateAccessRuleModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -438,6 +446,10 @@ func (r *privAccessRuleResource) Create(ctx context.Context, req resource.Create
 
 // Read private access policy rule
 func (r *privAccessRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state PrivateAccessRuleModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -467,6 +479,10 @@ func (r *privAccessRuleResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update private access policy rule
 func (r *privAccessRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivateAccessRuleModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -544,6 +560,9 @@ func (r *privAccessRuleResource) Update(ctx context.Context, req resource.Update
 
 // Delete private access policy rule
 func (r *privAccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state PrivateAccessRuleModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -32,6 +32,8 @@ type privAccessRuleBulkResource struct {
 	client *catoClientData
 }
 
+func (r *privAccessRuleBulkResource) clientData() **catoClientData { return &r.client }
+
 func NewPrivAccessRuleBulkResource() resource.Resource {
 	return &privAccessRuleBulkResource{}
 }
@@ -81,11 +83,16 @@ func (r *privAccessRuleBulkResource) Schema(_ context.Context, _ resource.Schema
 				Description: "publish policy revision if there is a change",
 				Computed:    true,
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
 
 func (r *privAccessRuleBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivateAccessRuleBulkModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -128,6 +135,10 @@ func (r *privAccessRuleBulkResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *privAccessRuleBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state PrivateAccessRuleBulkModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -150,6 +161,10 @@ func (r *privAccessRuleBulkResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *privAccessRuleBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivateAccessRuleBulkModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
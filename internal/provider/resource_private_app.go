@@ -38,6 +38,8 @@ type privateAppResource struct {
 	client *catoClientData
 }
 
+func (r *privateAppResource) clientData() **catoClientData { return &r.client }
+
 type (
 	privateAppProtocolPorts      = cato_go_sdk.PrivateAppReadPrivateApp_PrivateApplication_PrivateApplication_ProtocolPorts
 	privateAppPublishedAppDomain = cato_go_sdk.PrivateAppReadPrivateApp_PrivateApplication_PrivateApplication_PublishedAppDomain
@@ -88,6 +90,7 @@ func (r *privateAppResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"published_app_domain": r.schemaPublishedAppDomain(),
+			"account_id":           accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -189,12 +192,17 @@ func (r *privateAppResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *privateAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 // Create a new private app
 func (r *privateAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivateAppModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -243,6 +251,10 @@ func (r *privateAppResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read the private app
 func (r *privateAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state PrivateAppModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -272,6 +284,10 @@ func (r *privateAppResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update the private app
 func (r *privateAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan PrivateAppModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -330,6 +346,9 @@ func (r *privateAppResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete the private app
 func (r *privateAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state PrivateAppModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,563 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource               = &privateAppsBulkResource{}
+	_ resource.ResourceWithConfigure  = &privateAppsBulkResource{}
+	_ resource.ResourceWithModifyPlan = &privateAppsBulkResource{}
+)
+
+func NewPrivateAppsBulkResource() resource.Resource {
+	return &privateAppsBulkResource{}
+}
+
+type privateAppsBulkResource struct {
+	client *catoClientData
+}
+
+func (r *privateAppsBulkResource) clientData() **catoClientData { return &r.client }
+
+func (r *privateAppsBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_apps_bulk"
+}
+
+func (r *privateAppsBulkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *privateAppsBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	protocolPorts := (&privateAppResource{}).schemaProtocolPorts()
+	protocolPorts.PlanModifiers = []planmodifier.Set{setplanmodifier.UseStateForUnknown()}
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_private_apps_bulk` resource manages a set of private apps keyed by name, e.g. decoded from " +
+			"a CSV or JSON export. Apps are matched to the existing private apps by name: existing apps are updated, " +
+			"missing apps are created and apps removed from the set are deleted. An app that fails is reported in `errors` " +
+			"and as a warning without failing the other apps, and is retried on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the bulk, always set to 0",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"apps": schema.MapNestedAttribute{
+				Description: "Map of private apps keyed by name",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique ID of the private app, null when the app could not be created",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "Optional description of the private app",
+							Optional:    true,
+						},
+						"internal_app_address": schema.StringAttribute{
+							Description: "The local address of the application, IPv4 address or FQDN",
+							Required:    true,
+						},
+						"allow_icmp_protocol": schema.BoolAttribute{
+							Description: "Is ICMP enabled? (default false)",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"protocol_ports": protocolPorts,
+						"published_app_domain": schema.StringAttribute{
+							Description: "Domain the app is published on; the app is published when set",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("connector_group_name")),
+							},
+						},
+						"connector_group_name": schema.StringAttribute{
+							Description: "App connector group serving the published app",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("published_app_domain")),
+							},
+						},
+					},
+				},
+			},
+			"errors": schema.MapAttribute{
+				Description: "Errors of the last apply keyed by app name, empty when all the apps were applied",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+// ModifyPlan retries the apps that failed in the last apply
+func (r *privateAppsBulkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() { // resource destruction or creation
+		return
+	}
+
+	var stateErrors types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("errors"), &stateErrors)...)
+	if resp.Diagnostics.HasError() || !utils.HasValue(stateErrors) || len(stateErrors.Elements()) == 0 {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("errors"), types.MapUnknown(types.StringType))...)
+}
+
+func (r *privateAppsBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan PrivateAppsBulkModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.applyPrivateApps(ctx, plan, PrivateAppsBulkModel{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *privateAppsBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state PrivateAppsBulkModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydratePrivateAppsBulkState(ctx, state)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating private apps bulk state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *privateAppsBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, prior PrivateAppsBulkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.applyPrivateApps(ctx, plan, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes all the apps of the bulk
+func (r *privateAppsBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state PrivateAppsBulkModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.listPrivateApps(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API PrivateAppReadPrivateApps error", err.Error())
+		return
+	}
+
+	managed := privateAppsBulkManaged(ctx, state, &resp.Diagnostics)
+	ops := planPrivateAppsBulk(nil, managed, existing, nil)
+	for _, name := range ops.remove {
+		if err := r.deletePrivateApp(ctx, existing[name]); err != nil {
+			resp.Diagnostics.AddError("Cato API PrivateAppDeletePrivateApp error", fmt.Sprintf("private app %q: %s", name, err.Error()))
+		}
+	}
+}
+
+// applyPrivateApps deletes, updates and creates the apps to match the plan. Failing apps are
+// reported in the errors attribute and as warnings, and keep their planned values in the state.
+func (r *privateAppsBulkResource) applyPrivateApps(ctx context.Context, plan, prior PrivateAppsBulkModel,
+) (*PrivateAppsBulkModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	desired := make(map[string]PrivateAppsBulkApp)
+	diags.Append(plan.Apps.ElementsAs(ctx, &desired, false)...)
+	priorApps := make(map[string]PrivateAppsBulkApp)
+	if utils.HasValue(prior.Apps) {
+		diags.Append(prior.Apps.ElementsAs(ctx, &priorApps, false)...)
+	}
+	managed := privateAppsBulkManaged(ctx, prior, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	existing, err := r.listPrivateApps(ctx)
+	if err != nil {
+		diags.AddError("Cato API PrivateAppReadPrivateApps error", err.Error())
+		return nil, diags
+	}
+
+	// apps without changes since the last successful apply are left alone
+	priorErrors := privateAppsBulkErrors(ctx, prior, &diags)
+	unchanged := make(map[string]bool)
+	for name, app := range desired {
+		priorApp, ok := priorApps[name]
+		_, failed := priorErrors[name]
+		unchanged[name] = ok && !failed && privateAppsBulkAppEqual(app, priorApp)
+	}
+	ops := planPrivateAppsBulk(slices.Sorted(maps.Keys(desired)), managed, existing, unchanged)
+
+	appErrors := make(map[string]string)
+	failed := func(name, method string, err error) {
+		tflog.Warn(ctx, "private app failed", map[string]interface{}{"name": name, "method": method, "error": err.Error()})
+		appErrors[name] = fmt.Sprintf("%s: %s", method, err.Error())
+		diags.AddWarning("Private app "+name+" not applied", fmt.Sprintf("Cato API %s error: %s", method, err.Error()))
+	}
+
+	for _, name := range ops.remove {
+		if err := r.deletePrivateApp(ctx, existing[name]); err != nil {
+			failed(name, "PrivateAppDeletePrivateApp", err)
+		}
+	}
+	for _, name := range ops.update {
+		if err := r.updatePrivateApp(ctx, existing[name], name, desired[name]); err != nil {
+			failed(name, "PrivateAppUpdatePrivateApp", err)
+		}
+	}
+	for _, name := range ops.create {
+		id, err := r.createPrivateApp(ctx, name, desired[name])
+		if err != nil {
+			failed(name, "PrivateAppCreatePrivateApp", err)
+			continue
+		}
+		existing[name] = id
+	}
+
+	// hydrate the applied apps, failed apps keep their planned values
+	helper := &privateAppResource{client: r.client}
+	apps := make(map[string]PrivateAppsBulkApp, len(desired))
+	for name, app := range desired {
+		id, ok := existing[name]
+		if _, appFailed := appErrors[name]; appFailed || !ok {
+			apps[name] = privateAppsBulkPlannedApp(app, id, ok)
+			continue
+		}
+		hydrated, hydrateDiags, err := helper.hydratePrivateAppState(ctx, id)
+		if err != nil {
+			diags.Append(hydrateDiags...)
+			failed(name, "PrivateAppReadPrivateApp", err)
+			apps[name] = privateAppsBulkPlannedApp(app, id, true)
+			continue
+		}
+		apps[name] = privateAppsBulkAppFromModel(ctx, hydrated, &diags)
+	}
+
+	state := &PrivateAppsBulkModel{AccountID: plan.AccountID, ID: types.StringValue("0")}
+	var d diag.Diagnostics
+	state.Apps, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: PrivateAppsBulkAppTypes}, apps)
+	diags.Append(d...)
+	state.Errors, d = types.MapValueFrom(ctx, types.StringType, appErrors)
+	diags.Append(d...)
+	return state, diags
+}
+
+func (r *privateAppsBulkResource) hydratePrivateAppsBulkState(ctx context.Context, prior PrivateAppsBulkModel,
+) (*PrivateAppsBulkModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	priorApps := make(map[string]PrivateAppsBulkApp)
+	if utils.HasValue(prior.Apps) {
+		diags.Append(prior.Apps.ElementsAs(ctx, &priorApps, false)...)
+	}
+	priorErrors := privateAppsBulkErrors(ctx, prior, &diags)
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+
+	existing, err := r.listPrivateApps(ctx)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// apps missing from the account are dropped from the state, so the next plan creates them again
+	helper := &privateAppResource{client: r.client}
+	apps := make(map[string]PrivateAppsBulkApp, len(priorApps))
+	for name := range priorApps {
+		id, ok := existing[name]
+		if !ok {
+			continue
+		}
+		hydrated, hydrateDiags, err := helper.hydratePrivateAppState(ctx, id)
+		if err != nil {
+			return nil, hydrateDiags, err
+		}
+		apps[name] = privateAppsBulkAppFromModel(ctx, hydrated, &diags)
+	}
+
+	// errors of removed apps are forgotten once the apps are gone
+	appErrors := make(map[string]string, len(priorErrors))
+	for name, msg := range priorErrors {
+		if _, ok := priorApps[name]; ok || existing[name] != "" {
+			appErrors[name] = msg
+		}
+	}
+
+	state := prior
+	var d diag.Diagnostics
+	state.Apps, d = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: PrivateAppsBulkAppTypes}, apps)
+	diags.Append(d...)
+	state.Errors, d = types.MapValueFrom(ctx, types.StringType, appErrors)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return &state, diags, nil
+}
+
+// listPrivateApps returns the IDs of the private apps of the account by name
+func (r *privateAppsBulkResource) listPrivateApps(ctx context.Context) (map[string]string, error) {
+	result, err := r.client.catov2.PrivateAppReadPrivateApps(ctx, r.client.AccountId)
+	tflog.Debug(ctx, "PrivateAppReadPrivateApps", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, err
+	}
+
+	apps := make(map[string]string)
+	for _, app := range result.GetPrivateApplication().GetPrivateApplicationList().GetItems() {
+		apps[app.GetName()] = app.GetID()
+	}
+	return apps, nil
+}
+
+func (r *privateAppsBulkResource) createPrivateApp(ctx context.Context, name string, app PrivateAppsBulkApp) (string, error) {
+	var diags diag.Diagnostics
+	input := cato_models.CreatePrivateApplicationInput{
+		AllowICMPProtocol:  app.AllowIcmpProtocol.ValueBool(),
+		Description:        parse.KnownStringPointer(app.Description),
+		InternalAppAddress: app.InternalAppAddress.ValueString(),
+		Name:               name,
+		ProtocolPorts:      (&privateAppResource{}).prepareProtocolPorts(ctx, app.ProtocolPorts, &diags),
+		Published:          utils.HasValue(app.PublishedAppDomain),
+		PublishedAppDomain: preparePrivateAppsBulkDomain(app),
+	}
+	if diags.HasError() {
+		return "", ErrConvertError
+	}
+
+	tflog.Debug(ctx, "PrivateAppCreatePrivateApp", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.PrivateAppCreatePrivateApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "PrivateAppCreatePrivateApp", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return "", err
+	}
+	return result.GetPrivateApplication().GetCreatePrivateApplication().GetApplication().GetID(), nil
+}
+
+func (r *privateAppsBulkResource) updatePrivateApp(ctx context.Context, id, name string, app PrivateAppsBulkApp) error {
+	var diags diag.Diagnostics
+	input := cato_models.UpdatePrivateApplicationInput{
+		AllowICMPProtocol:  parse.KnownBoolPointer(app.AllowIcmpProtocol),
+		Description:        parse.KnownStringPointer(app.Description),
+		ID:                 id,
+		InternalAppAddress: parse.KnownStringPointer(app.InternalAppAddress),
+		Name:               &name,
+		ProtocolPorts:      (&privateAppResource{}).prepareProtocolPorts(ctx, app.ProtocolPorts, &diags),
+		Published:          ptr(utils.HasValue(app.PublishedAppDomain)),
+		PublishedAppDomain: preparePrivateAppsBulkDomain(app),
+	}
+	if diags.HasError() {
+		return ErrConvertError
+	}
+
+	tflog.Debug(ctx, "PrivateAppUpdatePrivateApp", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.PrivateAppUpdatePrivateApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "PrivateAppUpdatePrivateApp", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	return err
+}
+
+func (r *privateAppsBulkResource) deletePrivateApp(ctx context.Context, id string) error {
+	input := cato_models.DeletePrivateApplicationInput{
+		PrivateApplication: &cato_models.PrivateApplicationRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: id,
+		},
+	}
+
+	tflog.Debug(ctx, "PrivateAppDeletePrivateApp", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.PrivateAppDeletePrivateApp(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "PrivateAppDeletePrivateApp", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	return err
+}
+
+func preparePrivateAppsBulkDomain(app PrivateAppsBulkApp) *cato_models.PublishedAppDomainInput {
+	if !utils.HasValue(app.PublishedAppDomain) {
+		return nil
+	}
+	return &cato_models.PublishedAppDomainInput{
+		ConnectorGroupName: parse.KnownStringPointer(app.ConnectorGroupName),
+		PublishedAppDomain: parse.KnownStringPointer(app.PublishedAppDomain),
+	}
+}
+
+// privateAppsBulkAppFromModel converts a private app read from the API to a bulk app
+func privateAppsBulkAppFromModel(ctx context.Context, app *PrivateAppModel, diags *diag.Diagnostics) PrivateAppsBulkApp {
+	out := PrivateAppsBulkApp{
+		AllowIcmpProtocol:  app.AllowIcmpProtocol,
+		ConnectorGroupName: types.StringNull(),
+		Description:        app.Description,
+		ID:                 app.ID,
+		InternalAppAddress: app.InternalAppAddress,
+		ProtocolPorts:      app.ProtocolPorts,
+		PublishedAppDomain: types.StringNull(),
+	}
+	if app.Published.ValueBool() && utils.HasValue(app.PublishedAppDomain) {
+		var domain PublishedAppDomain
+		diags.Append(app.PublishedAppDomain.As(ctx, &domain, basetypes.ObjectAsOptions{})...)
+		out.ConnectorGroupName = domain.ConnectorGroupName
+		out.PublishedAppDomain = domain.PublishedAppDomain
+	}
+	return out
+}
+
+// privateAppsBulkPlannedApp is the state of an app that was not applied
+func privateAppsBulkPlannedApp(app PrivateAppsBulkApp, id string, exists bool) PrivateAppsBulkApp {
+	app.ID = types.StringNull()
+	if exists {
+		app.ID = types.StringValue(id)
+	}
+	if app.ProtocolPorts.IsUnknown() {
+		app.ProtocolPorts = types.SetNull(types.ObjectType{AttrTypes: ProtocolPortTypes})
+	}
+	return app
+}
+
+// privateAppsBulkAppEqual compares the configurable attributes of two apps
+func privateAppsBulkAppEqual(a, b PrivateAppsBulkApp) bool {
+	return a.AllowIcmpProtocol.Equal(b.AllowIcmpProtocol) &&
+		a.ConnectorGroupName.Equal(b.ConnectorGroupName) &&
+		a.Description.Equal(b.Description) &&
+		a.InternalAppAddress.Equal(b.InternalAppAddress) &&
+		(a.ProtocolPorts.IsUnknown() || a.ProtocolPorts.Equal(b.ProtocolPorts)) &&
+		a.PublishedAppDomain.Equal(b.PublishedAppDomain)
+}
+
+// privateAppsBulkManaged returns the names of the apps managed by the bulk: its apps and the apps that failed
+func privateAppsBulkManaged(ctx context.Context, state PrivateAppsBulkModel, diags *diag.Diagnostics) []string {
+	var names []string
+	if utils.HasValue(state.Apps) {
+		for name := range state.Apps.Elements() {
+			names = append(names, name)
+		}
+	}
+	for name := range privateAppsBulkErrors(ctx, state, diags) {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+func privateAppsBulkErrors(ctx context.Context, state PrivateAppsBulkModel, diags *diag.Diagnostics) map[string]string {
+	appErrors := make(map[string]string)
+	if utils.HasValue(state.Errors) {
+		diags.Append(state.Errors.ElementsAs(ctx, &appErrors, false)...)
+	}
+	return appErrors
+}
+
+// privateAppsBulkOps are the names of the apps to delete, update and create, sorted
+type privateAppsBulkOps struct {
+	remove []string
+	update []string
+	create []string
+}
+
+// planPrivateAppsBulk matches the desired apps to the existing apps by name. Managed apps that are no
+// longer desired are removed when they exist, desired apps are updated when they exist (unless
+// unchanged) and created otherwise.
+func planPrivateAppsBulk(desired, managed []string, existing map[string]string, unchanged map[string]bool) privateAppsBulkOps {
+	var ops privateAppsBulkOps
+	for _, name := range managed {
+		if _, ok := existing[name]; ok && !slices.Contains(desired, name) {
+			ops.remove = append(ops.remove, name)
+		}
+	}
+	for _, name := range desired {
+		switch _, ok := existing[name]; {
+		case !ok:
+			ops.create = append(ops.create, name)
+		case !unchanged[name]:
+			ops.update = append(ops.update, name)
+		}
+	}
+	slices.Sort(ops.remove)
+	slices.Sort(ops.update)
+	slices.Sort(ops.create)
+	return ops
+}
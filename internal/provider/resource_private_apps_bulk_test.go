@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanPrivateAppsBulk(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]string{
+		"crm":      "1",
+		"intranet": "2",
+		"legacy":   "3",
+		"wiki":     "4",
+		"other":    "5", // not managed by the bulk
+	}
+	desired := []string{"wiki", "crm", "intranet", "billing"}
+	managed := []string{"crm", "intranet", "legacy", "wiki", "failed-create"}
+	unchanged := map[string]bool{"intranet": true}
+
+	ops := planPrivateAppsBulk(desired, managed, existing, unchanged)
+	require.Equal(t, []string{"legacy"}, ops.remove)
+	require.Equal(t, []string{"crm", "wiki"}, ops.update)
+	require.Equal(t, []string{"billing"}, ops.create)
+}
+
+func TestPlanPrivateAppsBulkAdoptsExistingAppsByName(t *testing.T) {
+	t.Parallel()
+
+	ops := planPrivateAppsBulk([]string{"crm"}, nil, map[string]string{"crm": "1"}, nil)
+	require.Empty(t, ops.remove)
+	require.Equal(t, []string{"crm"}, ops.update)
+	require.Empty(t, ops.create)
+}
+
+func TestPlanPrivateAppsBulkDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	ops := planPrivateAppsBulk(nil, []string{"b", "a", "gone"}, map[string]string{"a": "1", "b": "2"}, nil)
+	require.Equal(t, []string{"a", "b"}, ops.remove)
+	require.Empty(t, ops.update)
+	require.Empty(t, ops.create)
+}
+
+func TestPrivateAppsBulkPlannedApp(t *testing.T) {
+	t.Parallel()
+
+	app := PrivateAppsBulkApp{
+		ID:                 types.StringUnknown(),
+		InternalAppAddress: types.StringValue("10.0.0.10"),
+		ProtocolPorts:      types.SetUnknown(types.ObjectType{AttrTypes: ProtocolPortTypes}),
+	}
+
+	notCreated := privateAppsBulkPlannedApp(app, "", false)
+	require.True(t, notCreated.ID.IsNull())
+	require.True(t, notCreated.ProtocolPorts.IsNull())
+	require.Equal(t, "10.0.0.10", notCreated.InternalAppAddress.ValueString())
+
+	notUpdated := privateAppsBulkPlannedApp(app, "42", true)
+	require.Equal(t, "42", notUpdated.ID.ValueString())
+}
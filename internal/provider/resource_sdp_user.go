@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &sdpUserResource{}
+	_ resource.ResourceWithConfigure   = &sdpUserResource{}
+	_ resource.ResourceWithImportState = &sdpUserResource{}
+
+	ErrSdpUserNotFound = errors.New("sdp user not found")
+
+	sdpUserEmailRE = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	sdpUserPhoneRE = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+)
+
+func NewSdpUserResource() resource.Resource {
+	return &sdpUserResource{}
+}
+
+type sdpUserResource struct {
+	client *catoClientData
+}
+
+func (r *sdpUserResource) clientData() **catoClientData { return &r.client }
+
+func (r *sdpUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdp_user"
+}
+
+func (r *sdpUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_sdp_user` resource contains the configuration parameters necessary to manage an SDP (remote) user. " +
+			"The user `id` and `name` can be referenced as users in the firewall and private access rules.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Email address of the user, used as the user login (changing it recreates the user)",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sdpUserEmailRE, "must be a valid email address"),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"first_name": schema.StringAttribute{
+				Description: "First name of the user",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the SDP user",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the user",
+				Required:    true,
+			},
+			"license_id": schema.StringAttribute{
+				Description: "ID of the SDP license assigned to the user; the user is created without a license when not set, and removing it unassigns the license",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the user, as referenced in policy rules",
+				Computed:    true,
+			},
+			"phone_number": schema.StringAttribute{
+				Description: "Phone number of the user in international format (e.g. +14155550100)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sdpUserPhoneRE, "must be in international format, e.g. +14155550100"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the user (e.g. ACTIVE, PENDING)",
+				Computed:    true,
+			},
+			"user_groups": schema.SetNestedAttribute{
+				Description: "User groups the user is a member of",
+				Optional:    true,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes:    parse.SchemaNameID("User group"),
+					PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+				},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *sdpUserResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *sdpUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new SDP user
+func (r *sdpUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan SdpUserModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.AddSdpUserInput{
+		Email:       plan.Email.ValueString(),
+		FirstName:   plan.FirstName.ValueString(),
+		LastName:    plan.LastName.ValueString(),
+		License:     r.prepareLicense(plan.LicenseID),
+		PhoneNumber: parse.KnownStringPointer(plan.PhoneNumber),
+		UsersGroup:  parse.PrepareIDRefSet[cato_models.UsersGroupRefInput](ctx, plan.UserGroups, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new user
+	tflog.Debug(ctx, "SdpUserCreateUser", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.SdpUserCreateUser(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "SdpUserCreateUser", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API SdpUserCreateUser error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetSdpUser().GetAddSdpUser().GetSdpUser().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateSdpUserState(ctx, plan.ID.ValueString(), plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating sdp user state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read SDP user data from Cato API
+func (r *sdpUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state SdpUserModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateSdpUserState(ctx, state.ID.ValueString(), state)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the user was found
+		if errors.Is(hydrateErr, ErrSdpUserNotFound) {
+			tflog.Warn(ctx, "sdp_user not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating sdp user state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update SDP user configuration
+func (r *sdpUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state SdpUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("SdpUserUpdateUser: ID is unknown", "SDP user ID is not set in TF state")
+		return
+	}
+
+	// user groups removed from the config are sent as an empty list so the memberships are cleared
+	userGroups := parse.PrepareIDRefSet[cato_models.UsersGroupRefInput](ctx, plan.UserGroups, &resp.Diagnostics)
+	if userGroups == nil {
+		userGroups = []*cato_models.UsersGroupRefInput{}
+	}
+	// a license or phone number removed from the config is sent empty so the API clears it,
+	// the API keeps the current value when the field is omitted
+	license := r.prepareLicense(plan.LicenseID)
+	if license == nil && utils.HasValue(state.LicenseID) {
+		license = &cato_models.LicenseRefInput{By: cato_models.ObjectRefByID, Input: ""}
+	}
+	phoneNumber := parse.KnownStringPointer(plan.PhoneNumber)
+	if phoneNumber == nil && utils.HasValue(state.PhoneNumber) {
+		phoneNumber = new(string)
+	}
+	input := cato_models.UpdateSdpUserInput{
+		ID:          id,
+		FirstName:   parse.KnownStringPointer(plan.FirstName),
+		LastName:    parse.KnownStringPointer(plan.LastName),
+		License:     license,
+		PhoneNumber: phoneNumber,
+		UsersGroup:  userGroups,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "SdpUserUpdateUser", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.SdpUserUpdateUser(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "SdpUserUpdateUser", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API SdpUserUpdateUser error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateSdpUserState(ctx, id, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating sdp user state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete SDP user
+func (r *sdpUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state SdpUserModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.RemoveSdpUserInput{
+		SdpUser: &cato_models.SdpUserRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the user
+	tflog.Debug(ctx, "SdpUserDeleteUser", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.SdpUserDeleteUser(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "SdpUserDeleteUser", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API SdpUserDeleteUser error", err.Error())
+		return
+	}
+}
+
+func (r *sdpUserResource) prepareLicense(licenseID types.String) *cato_models.LicenseRefInput {
+	if !utils.HasValue(licenseID) {
+		return nil
+	}
+	return &cato_models.LicenseRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: licenseID.ValueString(),
+	}
+}
+
+// hydrateSdpUserState fetches the current state of an SDP user from the API.
+func (r *sdpUserResource) hydrateSdpUserState(
+	ctx context.Context,
+	userID string,
+	plan SdpUserModel,
+) (*SdpUserModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.SdpUserRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: userID,
+	}
+
+	// Call Cato API to get the user
+	tflog.Debug(ctx, "SdpUserReadUser", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.SdpUserReadUser(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "SdpUserReadUser", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to SdpUserModel
+	user := result.GetSdpUser().GetSdpUser()
+	if user == nil {
+		return nil, diags, ErrSdpUserNotFound
+	}
+
+	state := &SdpUserModel{
+		Email:       types.StringValue(user.Email),
+		FirstName:   types.StringValue(user.FirstName),
+		ID:          types.StringValue(user.ID),
+		LastName:    types.StringValue(user.LastName),
+		LicenseID:   types.StringNull(),
+		Name:        types.StringValue(user.Name),
+		PhoneNumber: types.StringPointerValue(user.PhoneNumber),
+		Status:      types.StringValue(user.Status.String()),
+		UserGroups:  parse.IDRefSet(ctx, user.UsersGroup, &diags),
+	}
+	if user.License != nil {
+		state.LicenseID = types.StringValue(user.License.ID)
+	}
+	// keep an unset phone number null when the API returns it empty
+	if plan.PhoneNumber.IsNull() && state.PhoneNumber.ValueString() == "" {
+		state.PhoneNumber = types.StringNull()
+	}
+
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+
+	return state, nil, nil
+}
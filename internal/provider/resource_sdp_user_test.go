@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	cato "github.com/catonetworks/cato-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+)
+
+func TestSdpUserUpdateClearsLicenseAndPhoneNumber(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fixture := &sdpUserTestFixture{t: t}
+	fixture.server = httptest.NewServer(http.HandlerFunc(fixture.handleGraphQL))
+	defer fixture.server.Close()
+
+	client, err := cato.New(fixture.server.URL, "test-token", "3381", nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create cato client: %v", err)
+	}
+	r := &sdpUserResource{client: &catoClientData{AccountId: "3381", catov2: client}}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	user := SdpUserModel{
+		AccountID:   types.StringNull(),
+		Email:       types.StringValue("jane@example.com"),
+		FirstName:   types.StringValue("Jane"),
+		ID:          types.StringValue("user-1"),
+		LastName:    types.StringValue("Doe"),
+		LicenseID:   types.StringValue("lic-1"),
+		Name:        types.StringValue("Jane Doe"),
+		PhoneNumber: types.StringValue("+14155550100"),
+		Status:      types.StringValue("ACTIVE"),
+		UserGroups:  types.SetValueMust(types.ObjectType{AttrTypes: parse.IDNameRefModelTypes}, nil),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, user); diags.HasError() {
+		t.Fatalf("failed to create sdp user state: %+v", diags)
+	}
+	user.LicenseID = types.StringNull()
+	user.PhoneNumber = types.StringNull()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, user); diags.HasError() {
+		t.Fatalf("failed to create sdp user plan: %+v", diags)
+	}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+
+	input := fixture.updateInput()
+	license, ok := input["license"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected the removed license to be sent, got input %v", input)
+	}
+	if license["input"] != "" {
+		t.Fatalf("expected an empty license reference, got %v", license)
+	}
+	if phone, ok := input["phoneNumber"]; !ok || phone != "" {
+		t.Fatalf("expected the removed phone number to be sent empty, got input %v", input)
+	}
+
+	var got SdpUserModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("failed to read sdp user state: %+v", diags)
+	}
+	if !got.LicenseID.IsNull() || !got.PhoneNumber.IsNull() {
+		t.Fatalf("expected license and phone number cleared, got %s and %s", got.LicenseID, got.PhoneNumber)
+	}
+}
+
+type sdpUserTestFixture struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu     sync.Mutex
+	update map[string]any
+}
+
+func (f *sdpUserTestFixture) updateInput() map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.update
+}
+
+func (f *sdpUserTestFixture) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var body struct {
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		f.t.Fatalf("failed to decode request body: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch body.OperationName {
+	case "sdpUserUpdateUser":
+		input, _ := body.Variables["input"].(map[string]any)
+		f.mu.Lock()
+		f.update = input
+		f.mu.Unlock()
+		writeJSON(f.t, w, map[string]any{
+			"data": map[string]any{
+				"sdpUser": map[string]any{"updateUser": map[string]any{"id": "user-1"}},
+			},
+		})
+	case "sdpUserReadUser":
+		writeJSON(f.t, w, map[string]any{
+			"data": map[string]any{
+				"sdpUser": map[string]any{
+					"sdpUser": map[string]any{
+						"id":          "user-1",
+						"name":        "Jane Doe",
+						"email":       "jane@example.com",
+						"firstName":   "Jane",
+						"lastName":    "Doe",
+						"phoneNumber": "",
+						"status":      "ACTIVE",
+						"license":     nil,
+						"usersGroup":  []any{},
+					},
+				},
+			},
+		})
+	default:
+		f.t.Fatalf("unexpected operationName: %q", body.OperationName)
+	}
+}
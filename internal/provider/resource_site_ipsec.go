@@ -6,10 +6,12 @@ import (
 
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/catonetworks/terraform-provider-cato/internal/provider/planmodifiers"
 	tf "github.com/catonetworks/terraform-provider-cato/internal/provider/tfmodel"
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
 	"github.com/catonetworks/terraform-provider-cato/internal/utils"
 )
 
@@ -37,6 +40,8 @@ type siteIpsecResource struct {
 	client *catoClientData
 }
 
+func (r *siteIpsecResource) clientData() **catoClientData { return &r.client }
+
 func (r *siteIpsecResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ipsec_site"
 }
@@ -114,6 +119,9 @@ func (r *siteIpsecResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"ipsec": schema.SingleNestedAttribute{
 				Description: "IPSec Configuration",
 				Required:    true,
+				Validators: []validator.Object{
+					validators.GetIpsecValidator(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"site_id": schema.StringAttribute{
 						Description: "Site Identifier for Ipsec Site",
@@ -122,6 +130,40 @@ func (r *siteIpsecResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"ike_version": schema.StringAttribute{
+						Description: "IKE version used by the site tunnels. IKEV1 sites support a single tunnel per group, " +
+							"POLICY_BASED routing only, no AES_GCM cipher in init_message and no PRF selection. " +
+							"Valid values: IKEV1, IKEV2 (default)",
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(validators.IpsecIkeV2),
+						Validators: []validator.String{
+							stringvalidator.OneOf(validators.IpsecIkeV1, validators.IpsecIkeV2),
+						},
+					},
+					"dpd": schema.SingleNestedAttribute{
+						Description: "Dead peer detection settings",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Description: "Send DPD keepalive messages to the peer (default true)",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(true),
+							},
+							"interval": schema.Int64Attribute{
+								Description: "Interval between DPD keepalive messages, in seconds",
+								Optional:    true,
+								Validators:  []validator.Int64{int64validator.Between(1, 60)},
+							},
+							"timeout": schema.Int64Attribute{
+								Description: "Time without a DPD response after which the peer is declared dead, in seconds. " +
+									"Must be greater than interval",
+								Optional:   true,
+								Validators: []validator.Int64{int64validator.Between(2, 300)},
+							},
+						},
+					},
 					"primary": schema.SingleNestedAttribute{
 						Description: "primary",
 						Required:    true,
@@ -172,6 +214,15 @@ func (r *siteIpsecResource) Schema(_ context.Context, _ resource.SchemaRequest,
 											Required:    true,
 											Sensitive:   true,
 										},
+										"routing_type": schema.StringAttribute{
+											Description: "Tunnel routing type. ROUTE_BASED tunnels exchange routes with BGP over " +
+												"private_cato_ip / private_site_ip and require IKEV2. Valid values: POLICY_BASED, ROUTE_BASED",
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.OneOf(validators.IpsecRoutingPolicyBased, validators.IpsecRoutingRouteBased),
+											},
+										},
+										"monitoring": ipsecTunnelMonitoringSchema(),
 										"last_mile_bw": schema.SingleNestedAttribute{
 											Description: "lastmilebw",
 											Required:    false,
@@ -252,6 +303,15 @@ func (r *siteIpsecResource) Schema(_ context.Context, _ resource.SchemaRequest,
 											Required:    true,
 											Sensitive:   true,
 										},
+										"routing_type": schema.StringAttribute{
+											Description: "Tunnel routing type. ROUTE_BASED tunnels exchange routes with BGP over " +
+												"private_cato_ip / private_site_ip and require IKEV2. Valid values: POLICY_BASED, ROUTE_BASED",
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.OneOf(validators.IpsecRoutingPolicyBased, validators.IpsecRoutingRouteBased),
+											},
+										},
+										"monitoring": ipsecTunnelMonitoringSchema(),
 										"last_mile_bw": schema.SingleNestedAttribute{
 											Description: "lastmilebw",
 											Required:    false,
@@ -417,6 +477,41 @@ func (r *siteIpsecResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func ipsecTunnelMonitoringSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Tunnel network monitoring. The tunnel is considered down after failure_threshold consecutive failed probes",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Probe type. Valid values: ICMP, HTTP",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validators.IpsecMonitoringICMP, validators.IpsecMonitoringHTTP),
+				},
+			},
+			"destination_ip": schema.StringAttribute{
+				Description: "IP address probed behind the tunnel (ICMP monitoring)",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL probed behind the tunnel (HTTP monitoring)",
+				Optional:    true,
+			},
+			"interval": schema.Int64Attribute{
+				Description: "Interval between probes, in seconds",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 60)},
+			},
+			"failure_threshold": schema.Int64Attribute{
+				Description: "Number of consecutive failed probes before the tunnel is marked as down",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 10)},
+			},
 		},
 	}
 }
@@ -430,12 +525,17 @@ func (r *siteIpsecResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 func (r *siteIpsecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 //nolint:gocyclo,funlen // Existing create flow follows several API calls that must remain ordered.
 func (r *siteIpsecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan SiteIpsecIkeV2
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -598,6 +698,10 @@ func (r *siteIpsecResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *siteIpsecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state SiteIpsecIkeV2
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -631,6 +735,10 @@ func (r *siteIpsecResource) Read(ctx context.Context, req resource.ReadRequest,
 
 //nolint:gocyclo,funlen // Existing update flow follows several API calls that must remain ordered.
 func (r *siteIpsecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan SiteIpsecIkeV2
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -816,6 +924,9 @@ func prepareIpsecNetworkRangeUpdate(plan, state SiteIpsecIkeV2) (*cato_models.Up
 }
 
 func (r *siteIpsecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state SiteIpsecIkeV2
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
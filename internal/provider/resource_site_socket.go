@@ -63,6 +63,8 @@ type socketSiteResource struct {
 	socketSiteClient SocketSiteClient
 }
 
+func (r *socketSiteResource) clientData() **catoClientData { return &r.client }
+
 type SocketSiteClient interface {
 	SiteAddSocketSite(ctx context.Context, addSocketSiteInput cato_models.AddSocketSiteInput, accountID string,
 		interceptors ...clientv2.RequestInterceptor) (*cato_go_sdk.SiteAddSocketSite, error)
@@ -131,6 +133,7 @@ func (r *socketSiteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"native_range":  r.schemaNativeRange(),
 			"site_location": r.schemaSiteLocation(),
 			"sockets":       r.schemaSockets(),
+			"account_id":    accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -325,6 +328,7 @@ func (r *socketSiteResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *socketSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
@@ -343,6 +347,10 @@ func (r *socketSiteResource) ImportState(ctx context.Context, req resource.Impor
 //
 //nolint:funlen // create flow composes multiple API calls and hydration checks in sequence.
 func (r *socketSiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan, cfg tf.SocketSite
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -429,6 +437,10 @@ func (r *socketSiteResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read cato_socket_site resource
 func (r *socketSiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state tf.SocketSite
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -458,6 +470,10 @@ func (r *socketSiteResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update cato_socket_site resource
 func (r *socketSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var cfg, plan, state tf.SocketSite
 	var planNativeRange, stateNativeRange tf.NativeRange
 
@@ -528,6 +544,9 @@ func (r *socketSiteResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete cato_socket_site resource
 func (r *socketSiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state tf.SocketSite
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
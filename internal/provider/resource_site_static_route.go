@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spf13/cast"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &siteStaticRouteResource{}
+	_ resource.ResourceWithConfigure   = &siteStaticRouteResource{}
+	_ resource.ResourceWithImportState = &siteStaticRouteResource{}
+	_ resource.ResourceWithModifyPlan  = &siteStaticRouteResource{}
+)
+
+const (
+	siteStaticRouteDescription = "The `cato_site_static_route` resource contains the configuration parameters necessary to " +
+		"add a static route (next hop routing) to a cato site. The next hop must be reachable through one of the site " +
+		"LAN interfaces. Documentation for the underlying API used in this resource can be found at " +
+		"[mutation.addStaticRoute()](https://api.catonetworks.com/documentation/#mutation-site.addStaticRoute)."
+	siteStaticRouteNotFoundMsg   = "Invalid static route id: "
+	siteStaticRouteDefaultMetric = 1
+)
+
+func NewSiteStaticRouteResource() resource.Resource {
+	return &siteStaticRouteResource{}
+}
+
+type siteStaticRouteResource struct {
+	client *catoClientData
+}
+
+func (r *siteStaticRouteResource) clientData() **catoClientData { return &r.client }
+
+// staticRouteInterface is the site LAN interface a static route is bound to
+type staticRouteInterface struct {
+	id       string
+	index    string
+	subnet   string
+	destType string
+}
+
+func (r *siteStaticRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_static_route"
+}
+
+func (r *siteStaticRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: siteStaticRouteDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Static route ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Description:   "Site ID",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Static route name",
+				Optional:    true,
+			},
+			"subnet": schema.StringAttribute{
+				Description: "Destination subnet (CIDR)",
+				Required:    true,
+			},
+			"next_hop": schema.StringAttribute{
+				Description: "Next hop IP address, must be within the subnet of the selected LAN interface",
+				Required:    true,
+			},
+			"interface_id": schema.StringAttribute{
+				Description: "Network Interface ID of the LAN interface the next hop is reachable through. " +
+					"Either interface_id or interface_index must be set",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface_index": schema.StringAttribute{
+				Description:   "Network Interface Index of the LAN interface the next hop is reachable through (e.g. INT_5, LAN1)",
+				Optional:      true,
+				Computed:      true,
+				Validators:    []validator.String{validators.SocketInterfaceIndexValidator{}},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"metric": schema.Int64Attribute{
+				Description: "Route metric, lower values are preferred (default 1)",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(siteStaticRouteDefaultMetric),
+				Validators:  []validator.Int64{int64validator.Between(1, 255)},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *siteStaticRouteResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+// ImportState imports a static route using the "<site_id>/<static_route_id>" format
+func (r *siteStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	siteID, routeID, err := parseSiteStaticRouteImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), routeID)...)
+}
+
+// ModifyPlan plans the unconfigured one of interface_id and interface_index
+// unknown when the configured one changes, as it is resolved from the other on
+// apply; UseStateForUnknown would otherwise keep the stale interface.
+func (r *siteStaticRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var cfg, state SiteStaticRoute
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if counterpart, changed := staticRouteInterfaceCounterpart(cfg, state); changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, counterpart, types.StringUnknown())...)
+	}
+}
+
+func (r *siteStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var cfg, plan SiteStaticRoute
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := r.resolveStaticRouteInterface(ctx, cfg, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.AddStaticRouteInput{
+		Name:        plan.Name.ValueStringPointer(),
+		Subnet:      plan.Subnet.ValueString(),
+		NextHop:     plan.NextHop.ValueString(),
+		InterfaceID: iface.id,
+		Metric:      plan.Metric.ValueInt64Pointer(),
+	}
+
+	tflog.Debug(ctx, "Create.SiteAddStaticRoute.request", map[string]interface{}{
+		"request": utils.InterfaceToJSONString(input),
+	})
+	body, err := r.client.catov2.SiteAddStaticRoute(ctx, plan.SiteID.ValueString(), input, r.client.AccountId)
+	tflog.Debug(ctx, "Create.SiteAddStaticRoute.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(body),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API SiteAddStaticRoute error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(body.Site.AddStaticRoute.StaticRouteID)
+	state, exists := r.hydrateSiteStaticRouteState(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		tflog.Warn(ctx, "static route not found after create, static route resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *siteStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state SiteStaticRoute
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, exists := r.hydrateSiteStaticRouteState(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		tflog.Warn(ctx, "static route not found, static route resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *siteStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var cfg, plan SiteStaticRoute
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := r.resolveStaticRouteInterface(ctx, cfg, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.UpdateStaticRouteInput{
+		Name:        plan.Name.ValueStringPointer(),
+		Subnet:      plan.Subnet.ValueStringPointer(),
+		NextHop:     plan.NextHop.ValueStringPointer(),
+		InterfaceID: &iface.id,
+		Metric:      plan.Metric.ValueInt64Pointer(),
+	}
+
+	tflog.Debug(ctx, "Update.SiteUpdateStaticRoute.request", map[string]interface{}{
+		"request": utils.InterfaceToJSONString(input),
+	})
+	body, err := r.client.catov2.SiteUpdateStaticRoute(ctx, plan.ID.ValueString(), input, r.client.AccountId)
+	tflog.Debug(ctx, "Update.SiteUpdateStaticRoute.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(body),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API SiteUpdateStaticRoute error", err.Error())
+		return
+	}
+
+	state, exists := r.hydrateSiteStaticRouteState(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		tflog.Warn(ctx, "static route not found after update, static route resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *siteStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state SiteStaticRoute
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.catov2.SiteRemoveStaticRoute(ctx, state.ID.ValueString(), r.client.AccountId)
+	tflog.Debug(ctx, "Delete.SiteRemoveStaticRoute.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(body),
+	})
+	if err != nil && !isSiteStaticRouteNotFound(err) {
+		resp.Diagnostics.AddError("Catov2 API SiteRemoveStaticRoute error", err.Error())
+		return
+	}
+}
+
+// hydrateSiteStaticRouteState reads the static route from the API, returns false if the route does not exist
+func (r *siteStaticRouteResource) hydrateSiteStaticRouteState(ctx context.Context, state SiteStaticRoute,
+	diags *diag.Diagnostics,
+) (SiteStaticRoute, bool) {
+	queryResult, err := r.client.catov2.StaticRoute(ctx, r.client.AccountId, state.ID.ValueString())
+	tflog.Debug(ctx, "Read.StaticRoute.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(queryResult),
+	})
+	if err != nil {
+		if isSiteStaticRouteNotFound(err) {
+			return state, false
+		}
+		diags.AddError("Catov2 StaticRoute API error",
+			fmt.Sprintf("error fetching static route '%s': %v", state.ID.ValueString(), err))
+		return state, false
+	}
+	if queryResult == nil || queryResult.Site.StaticRoute == nil {
+		return state, false
+	}
+	route := queryResult.Site.StaticRoute
+
+	iface := r.lookupStaticRouteInterface(ctx, state.SiteID.ValueString(), route.InterfaceID, "", diags)
+	if diags.HasError() {
+		return state, true
+	}
+
+	newState := SiteStaticRoute{
+		ID:             types.StringValue(route.ID),
+		SiteID:         state.SiteID,
+		Name:           types.StringPointerValue(route.Name),
+		Subnet:         types.StringValue(route.Subnet),
+		NextHop:        types.StringValue(route.NextHop),
+		InterfaceID:    types.StringValue(iface.id),
+		InterfaceIndex: types.StringValue(iface.index),
+		Metric:         types.Int64Value(route.Metric),
+	}
+	if route.Name != nil && *route.Name == "" && state.Name.IsNull() {
+		newState.Name = types.StringNull()
+	}
+
+	return newState, true
+}
+
+// resolveStaticRouteInterface returns the LAN interface selected in the config (by ID or index)
+// and validates the next hop is reachable through it
+func (r *siteStaticRouteResource) resolveStaticRouteInterface(ctx context.Context, cfg SiteStaticRoute,
+	diags *diag.Diagnostics,
+) staticRouteInterface {
+	if !utils.HasValue(cfg.InterfaceID) && !utils.HasValue(cfg.InterfaceIndex) {
+		diags.AddAttributeError(path.Root("interface_id"), "Invalid Configuration",
+			"either interface_id or interface_index must be set")
+		return staticRouteInterface{}
+	}
+
+	iface := r.lookupStaticRouteInterface(ctx, cfg.SiteID.ValueString(), cfg.InterfaceID.ValueString(),
+		cfg.InterfaceIndex.ValueString(), diags)
+	if diags.HasError() {
+		return iface
+	}
+
+	if err := checkStaticRouteInterface(iface, cfg.NextHop.ValueString(), cfg.Subnet.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("next_hop"), "Invalid Configuration", err.Error())
+	}
+	return iface
+}
+
+// lookupStaticRouteInterface looks up the site network interface by ID, or by index when the ID is empty
+func (r *siteStaticRouteResource) lookupStaticRouteInterface(ctx context.Context, siteID, interfaceID, interfaceIndex string,
+	diags *diag.Diagnostics,
+) staticRouteInterface {
+	site := &cato_models.EntityInput{Type: cato_models.EntityTypeSite, ID: siteID}
+	networkInterfaceResponse, err := r.client.catov2.EntityLookup(ctx, r.client.AccountId,
+		cato_models.EntityTypeNetworkInterface, ptr(int64(0)), nil, site, nil, nil, nil, nil, nil)
+	tflog.Debug(ctx, "EntityLookup.networkInterface.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(networkInterfaceResponse),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving network interfaces of site "+siteID, err.Error())
+		return staticRouteInterface{}
+	}
+
+	for _, item := range networkInterfaceResponse.GetEntityLookup().GetItems() {
+		helperFields := item.GetHelperFields()
+		curIfaceIndex := cast.ToString(helperFields["interfaceId"])
+		if numberRE.MatchString(curIfaceIndex) {
+			curIfaceIndex = "INT_" + curIfaceIndex
+		}
+		if (interfaceID != "" && item.GetEntity().GetID() == interfaceID) ||
+			(interfaceID == "" && curIfaceIndex == interfaceIndex) {
+			return staticRouteInterface{
+				id:       item.GetEntity().GetID(),
+				index:    curIfaceIndex,
+				subnet:   cast.ToString(helperFields["subnet"]),
+				destType: cast.ToString(helperFields["destType"]),
+			}
+		}
+	}
+
+	ref := interfaceID
+	if ref == "" {
+		ref = interfaceIndex
+	}
+	diags.AddError("Error retrieving network interface",
+		"network interface '"+ref+"' not found in site '"+siteID+"'")
+	return staticRouteInterface{}
+}
+
+// checkStaticRouteInterface validates the route can be installed through the given interface:
+// it must be a LAN interface and the next hop must be within the interface subnet
+func checkStaticRouteInterface(iface staticRouteInterface, nextHop, subnet string) error {
+	if iface.destType != "" && !strings.HasPrefix(iface.destType, string(cato_models.SocketInterfaceDestTypeLan)) {
+		return fmt.Errorf("interface '%s' is a %s interface, static routes require a LAN interface", iface.index, iface.destType)
+	}
+
+	if _, _, err := net.ParseCIDR(subnet); err != nil {
+		return fmt.Errorf("subnet '%s' is not a valid CIDR notation", subnet)
+	}
+
+	ip := net.ParseIP(nextHop)
+	if ip == nil {
+		return fmt.Errorf("next_hop '%s' is not a valid IP address", nextHop)
+	}
+
+	if iface.subnet == "" {
+		return nil
+	}
+	_, ifaceNet, err := net.ParseCIDR(iface.subnet)
+	if err != nil {
+		return nil //nolint:nilerr // interfaces without a parsable subnet are validated by the API
+	}
+	if !ifaceNet.Contains(ip) {
+		return fmt.Errorf("next_hop '%s' is not within the subnet '%s' of interface '%s'", nextHop, iface.subnet, iface.index)
+	}
+	return nil
+}
+
+// staticRouteInterfaceCounterpart returns the path of the interface attribute
+// that is not configured, and whether the configured one differs from state.
+func staticRouteInterfaceCounterpart(cfg, state SiteStaticRoute) (path.Path, bool) {
+	switch {
+	case !cfg.InterfaceID.IsNull() && cfg.InterfaceIndex.IsNull():
+		return path.Root("interface_index"), !cfg.InterfaceID.Equal(state.InterfaceID)
+	case !cfg.InterfaceIndex.IsNull() && cfg.InterfaceID.IsNull():
+		return path.Root("interface_id"), !cfg.InterfaceIndex.Equal(state.InterfaceIndex)
+	}
+	return path.Empty(), false
+}
+
+// parseSiteStaticRouteImportID splits the "<site_id>/<static_route_id>" import ID
+func parseSiteStaticRouteImportID(importID string) (siteID, routeID string, err error) {
+	parts := strings.Split(importID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected import ID in the format '<site_id>/<static_route_id>', got %q", importID)
+	}
+	return parts[0], parts[1], nil
+}
+
+func isSiteStaticRouteNotFound(err error) bool {
+	if gqlError, ok := errors.AsType[*clientv2.ErrorResponse](err); ok {
+		if gqlError.GqlErrors != nil && len(*gqlError.GqlErrors) > 0 &&
+			strings.Contains((*gqlError.GqlErrors)[0].Message, siteStaticRouteNotFoundMsg) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteStaticRouteMetadataAndSchema(t *testing.T) {
+	t.Parallel()
+
+	r := NewSiteStaticRouteResource()
+	metaResp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "cato"}, metaResp)
+	require.Equal(t, "cato_site_static_route", metaResp.TypeName)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	for _, attr := range []string{"id", "site_id", "name", "subnet", "next_hop", "interface_id", "interface_index", "metric"} {
+		require.Contains(t, schemaResp.Schema.Attributes, attr)
+	}
+}
+
+func TestParseSiteStaticRouteImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		importID    string
+		wantSiteID  string
+		wantRouteID string
+		wantErr     bool
+	}{
+		"valid":           {importID: "12345/678", wantSiteID: "12345", wantRouteID: "678"},
+		"missing route":   {importID: "12345/", wantErr: true},
+		"missing site":    {importID: "/678", wantErr: true},
+		"no separator":    {importID: "678", wantErr: true},
+		"too many fields": {importID: "1/2/3", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			siteID, routeID, err := parseSiteStaticRouteImportID(tt.importID)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSiteID, siteID)
+			require.Equal(t, tt.wantRouteID, routeID)
+		})
+	}
+}
+
+func TestCheckStaticRouteInterface(t *testing.T) {
+	t.Parallel()
+
+	lan := staticRouteInterface{id: "111", index: "INT_5", subnet: "10.10.0.0/24", destType: "LAN"}
+
+	tests := map[string]struct {
+		iface   staticRouteInterface
+		nextHop string
+		subnet  string
+		wantErr string
+	}{
+		"next hop within LAN interface subnet": {iface: lan, nextHop: "10.10.0.254", subnet: "192.168.50.0/24"},
+		"LAN LAG master interface": {
+			iface:   staticRouteInterface{index: "INT_6", subnet: "10.20.0.0/24", destType: "LAN_LAG_MASTER"},
+			nextHop: "10.20.0.1",
+			subnet:  "192.168.50.0/24",
+		},
+		"interface without subnet is left to the API": {
+			iface:   staticRouteInterface{index: "INT_7", destType: "LAN"},
+			nextHop: "10.30.0.1",
+			subnet:  "192.168.50.0/24",
+		},
+		"next hop outside interface subnet": {
+			iface: lan, nextHop: "10.11.0.1", subnet: "192.168.50.0/24",
+			wantErr: "is not within the subnet",
+		},
+		"WAN interface": {
+			iface:   staticRouteInterface{index: "WAN1", subnet: "10.10.0.0/24", destType: "CATO"},
+			nextHop: "10.10.0.1", subnet: "192.168.50.0/24",
+			wantErr: "require a LAN interface",
+		},
+		"invalid next hop": {iface: lan, nextHop: "10.10.0", subnet: "192.168.50.0/24", wantErr: "not a valid IP address"},
+		"invalid subnet":   {iface: lan, nextHop: "10.10.0.1", subnet: "192.168.50.0", wantErr: "not a valid CIDR"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkStaticRouteInterface(tt.iface, tt.nextHop, tt.subnet)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSiteStaticRouteModifyPlanUnknownsInterfaceCounterpart(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := &siteStaticRouteResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	route := func(interfaceID, interfaceIndex types.String) SiteStaticRoute {
+		return SiteStaticRoute{
+			ID:             types.StringValue("678"),
+			SiteID:         types.StringValue("12345"),
+			Name:           types.StringNull(),
+			Subnet:         types.StringValue("192.168.50.0/24"),
+			NextHop:        types.StringValue("10.10.0.254"),
+			InterfaceID:    interfaceID,
+			InterfaceIndex: interfaceIndex,
+			Metric:         types.Int64Value(siteStaticRouteDefaultMetric),
+			AccountID:      types.StringNull(),
+		}
+	}
+	modifyPlan := func(cfg, planned SiteStaticRoute) SiteStaticRoute {
+		state := tfsdk.State{Schema: s}
+		require.False(t, state.Set(ctx, route(types.StringValue("111"), types.StringValue("INT_5"))).HasError())
+		config := tfsdk.Plan{Schema: s}
+		require.False(t, config.Set(ctx, cfg).HasError())
+		plan := tfsdk.Plan{Schema: s}
+		require.False(t, plan.Set(ctx, planned).HasError())
+
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: s, Raw: config.Raw},
+			Plan:   plan,
+			State:  state,
+		}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var got SiteStaticRoute
+		require.False(t, resp.Plan.Get(ctx, &got).HasError())
+		return got
+	}
+
+	got := modifyPlan(route(types.StringValue("222"), types.StringNull()), route(types.StringValue("222"), types.StringValue("INT_5")))
+	require.True(t, got.InterfaceIndex.IsUnknown())
+
+	got = modifyPlan(route(types.StringNull(), types.StringValue("INT_6")), route(types.StringValue("111"), types.StringValue("INT_6")))
+	require.True(t, got.InterfaceID.IsUnknown())
+
+	got = modifyPlan(route(types.StringValue("111"), types.StringNull()), route(types.StringValue("111"), types.StringValue("INT_5")))
+	require.Equal(t, types.StringValue("INT_5"), got.InterfaceIndex)
+}
+
+func TestStaticRouteInterfaceCounterpart(t *testing.T) {
+	t.Parallel()
+
+	state := SiteStaticRoute{InterfaceID: types.StringValue("111"), InterfaceIndex: types.StringValue("INT_5")}
+
+	counterpart, changed := staticRouteInterfaceCounterpart(SiteStaticRoute{
+		InterfaceID: types.StringValue("111"), InterfaceIndex: types.StringValue("INT_5"),
+	}, state)
+	require.False(t, changed)
+	require.Equal(t, path.Empty(), counterpart)
+}
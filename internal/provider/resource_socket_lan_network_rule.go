@@ -44,6 +44,8 @@ type socketLanNetworkRuleResource struct {
 	client *catoClientData
 }
 
+func (r *socketLanNetworkRuleResource) clientData() **catoClientData { return &r.client }
+
 func (r *socketLanNetworkRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_socket_lan_network_rule"
 }
@@ -75,7 +77,8 @@ func (r *socketLanNetworkRuleResource) Schema(_ context.Context, _ resource.Sche
 					},
 				},
 			},
-			"rule": r.lanRuleSchema(),
+			"rule":       r.lanRuleSchema(),
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -381,10 +384,15 @@ func (r *socketLanNetworkRuleResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("rule").AtName("id"), req, resp)
 }
 
 func (r *socketLanNetworkRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan SocketLanNetworkRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -478,6 +486,10 @@ func (r *socketLanNetworkRuleResource) Create(ctx context.Context, req resource.
 }
 
 func (r *socketLanNetworkRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state SocketLanNetworkRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -554,6 +566,10 @@ func (r *socketLanNetworkRuleResource) Read(ctx context.Context, req resource.Re
 
 //nolint:funlen
 func (r *socketLanNetworkRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan SocketLanNetworkRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -686,6 +702,9 @@ func (r *socketLanNetworkRuleResource) Update(ctx context.Context, req resource.
 }
 
 func (r *socketLanNetworkRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state SocketLanNetworkRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -35,6 +35,8 @@ type socketLanSectionResource struct {
 	client *catoClientData
 }
 
+func (r *socketLanSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *socketLanSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_socket_lan_section"
 }
@@ -94,6 +96,7 @@ func (r *socketLanSectionResource) Schema(_ context.Context, _ resource.SchemaRe
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -107,10 +110,15 @@ func (r *socketLanSectionResource) Configure(_ context.Context, req resource.Con
 }
 
 func (r *socketLanSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 func (r *socketLanSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan SocketLanSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -193,6 +201,10 @@ func (r *socketLanSectionResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *socketLanSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state SocketLanSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -277,6 +289,10 @@ func (r *socketLanSectionResource) Read(ctx context.Context, req resource.ReadRe
 
 //nolint:funlen
 func (r *socketLanSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan SocketLanSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -385,6 +401,9 @@ func (r *socketLanSectionResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *socketLanSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state SocketLanSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
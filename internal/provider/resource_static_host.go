@@ -28,6 +28,8 @@ type staticHostResource struct {
 	client *catoClientData
 }
 
+func (r *staticHostResource) clientData() **catoClientData { return &r.client }
+
 func (r *staticHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_static_host"
 }
@@ -64,6 +66,7 @@ func (r *staticHostResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Host MAC address (for DHCP reservervation)",
 				Optional:    true,
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -77,11 +80,16 @@ func (r *staticHostResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *staticHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 func (r *staticHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan StaticHost
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -126,6 +134,10 @@ func (r *staticHostResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *staticHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state StaticHost
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -214,6 +226,10 @@ func (r *staticHostResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *staticHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan StaticHost
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -256,6 +272,9 @@ func (r *staticHostResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *staticHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state StaticHost
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
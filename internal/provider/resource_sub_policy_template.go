@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Policy types a sub-policy template can be instantiated into.
+const (
+	subPolicyTemplateTypeIfw = "INTERNET_FIREWALL"
+	subPolicyTemplateTypeWan = "WAN_FIREWALL"
+)
+
+var (
+	_ resource.Resource = &subPolicyTemplateResource{}
+)
+
+func NewSubPolicyTemplateResource() resource.Resource {
+	return &subPolicyTemplateResource{}
+}
+
+// subPolicyTemplateResource is provider-side only: the Cato API has no
+// template object, so CRUD never calls the API. Instances sync their child
+// rules from the computed ref attribute.
+type subPolicyTemplateResource struct{}
+
+func (r *subPolicyTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sub_policy_template"
+}
+
+// templateRulesAttribute turns a rule resource's "rule" attribute into a list
+// of template rules. The rule id is dropped because template rules have no API
+// identity; each instance owns its own child rule ids.
+func templateRulesAttribute(ruleAttr schema.SingleNestedAttribute, description string, validators ...validator.List) schema.ListNestedAttribute {
+	attrs := maps.Clone(ruleAttr.Attributes)
+	delete(attrs, "id")
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attrs,
+		},
+		Validators: validators,
+	}
+}
+
+// templateRuleAttrTypes returns the element attribute types of a template rule
+// list, i.e. the rule attribute types without the id.
+func templateRuleAttrTypes(ruleAttrTypes map[string]attr.Type) map[string]attr.Type {
+	attrs := maps.Clone(ruleAttrTypes)
+	delete(attrs, "id")
+	return attrs
+}
+
+func (r *subPolicyTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var ifRuleSchema resource.SchemaResponse
+	(&internetFwRuleResource{}).Schema(ctx, resource.SchemaRequest{}, &ifRuleSchema)
+	var wfRuleSchema resource.SchemaResponse
+	(&wanFwRuleResource{}).Schema(ctx, resource.SchemaRequest{}, &wfRuleSchema)
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_sub_policy_template` resource defines a rule list once so it can be instantiated " +
+			"as the child rules of many `cato_if_sub_policy` or `cato_wf_sub_policy` resources. The template " +
+			"exists only in Terraform state; each sub-policy that sets `template = cato_sub_policy_template.<name>.ref` " +
+			"adds, updates, removes and reorders its child rules to match the template and publishes the result " +
+			"in its own revision, so a template used by N sub-policies of a policy publishes N revisions. The syncs " +
+			"of one policy run one at a time within a Terraform run, so that no sub-policy publishes the draft of " +
+			"another, and a failed sync discards its draft. Other resources publishing the same policy in the " +
+			"same run are not serialized with the syncs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Template ID (the template name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Template name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Template description.",
+				Optional:    true,
+			},
+			"if_rules": templateRulesAttribute(
+				ifRuleSchema.Schema.Attributes["rule"].(schema.SingleNestedAttribute),
+				"Internet Firewall rules of the template, in sub-policy order. Uses the same parameters as a "+
+					"cato_if_rule rule. Rule names must be unique; they identify child rules across instances. "+
+					"Exactly one of if_rules or wf_rules must be set.",
+				listvalidator.ExactlyOneOf(path.MatchRoot("wf_rules")),
+			),
+			"wf_rules": templateRulesAttribute(
+				wfRuleSchema.Schema.Attributes["rule"].(schema.SingleNestedAttribute),
+				"WAN Firewall rules of the template, in sub-policy order. Uses the same parameters as a "+
+					"cato_wf_rule rule. Rule names must be unique; they identify child rules across instances. "+
+					"Exactly one of if_rules or wf_rules must be set.",
+			),
+			"ref": schema.SingleNestedAttribute{
+				Description: "Reference to pass to the template attribute of a cato_if_sub_policy or cato_wf_sub_policy.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Template ID.",
+						Computed:    true,
+					},
+					"policy_type": schema.StringAttribute{
+						Description: "Policy the template applies to (INTERNET_FIREWALL or WAN_FIREWALL).",
+						Computed:    true,
+					},
+					"revision": schema.StringAttribute{
+						Description: "Hash of the template rules. Changes whenever a rule changes.",
+						Computed:    true,
+					},
+					"rules": schema.StringAttribute{
+						Description: "Template rules as JSON-encoded API inputs.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// subPolicyTemplateRefAttribute is the template attribute shared by the
+// sub-policy resources that can instantiate a cato_sub_policy_template.
+func subPolicyTemplateRefAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Template whose rules are kept in sync as the child rules of this sub-policy. Set to " +
+			"cato_sub_policy_template.<name>.ref. Child rules are matched by name; child rules not in the " +
+			"template are removed. Removing the attribute stops the sync and leaves the child rules in place.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Template ID.",
+				Required:    true,
+			},
+			"policy_type": schema.StringAttribute{
+				Description: "Policy the template applies to.",
+				Required:    true,
+			},
+			"revision": schema.StringAttribute{
+				Description: "Template revision. Set to an empty string by refresh when the child rules drift, i.e. are added, removed, reordered, renamed or edited outside Terraform.",
+				Required:    true,
+			},
+			"rules": schema.StringAttribute{
+				Description: "Template rules as JSON-encoded API inputs.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *subPolicyTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SubPolicyTemplate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(r.expand(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *subPolicyTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Nothing to refresh: the template only exists in state.
+	var state SubPolicyTemplate
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *subPolicyTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SubPolicyTemplate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(r.expand(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *subPolicyTemplateResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Instances keep their child rules; removing the template only drops state.
+}
+
+// expand resolves computed rule attributes the API would normally fill in,
+// hydrates every rule into API inputs and sets the ref attribute.
+func (r *subPolicyTemplateResource) expand(ctx context.Context, plan *SubPolicyTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var (
+		policyType string
+		rules      any
+	)
+	if !plan.IfRules.IsNull() {
+		list, d := nullUnknownValues(ctx, plan.IfRules)
+		diags.Append(d...)
+		plan.IfRules = list.(types.List)
+		plan.WfRules = types.ListNull(types.ObjectType{AttrTypes: templateRuleAttrTypes(WanFirewallRuleRuleAttrTypes)})
+		policyType = subPolicyTemplateTypeIfw
+		ifwRules, d := ifwTemplateRules(ctx, plan.IfRules)
+		diags.Append(d...)
+		rules = ifwRules
+	} else {
+		list, d := nullUnknownValues(ctx, plan.WfRules)
+		diags.Append(d...)
+		plan.WfRules = list.(types.List)
+		plan.IfRules = types.ListNull(types.ObjectType{AttrTypes: templateRuleAttrTypes(InternetFirewallRuleRuleAttrTypes)})
+		policyType = subPolicyTemplateTypeWan
+		wanRules, d := wanTemplateRules(ctx, plan.WfRules)
+		diags.Append(d...)
+		rules = wanRules
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		diags.AddError("Sub-Policy Template Encoding Error", err.Error())
+		return diags
+	}
+	sum := sha256.Sum256(encoded)
+
+	ref, d := types.ObjectValueFrom(ctx, SubPolicyTemplateRefAttrTypes, SubPolicyTemplateRef{
+		ID:         plan.ID,
+		PolicyType: types.StringValue(policyType),
+		Revision:   types.StringValue(hex.EncodeToString(sum[:])),
+		Rules:      types.StringValue(string(encoded)),
+	})
+	diags.Append(d...)
+	plan.Ref = ref
+	return diags
+}
+
+// ifwTemplateRules hydrates the template if_rules into API inputs.
+func ifwTemplateRules(ctx context.Context, list types.List) ([]ifwTemplateRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := make([]ifwTemplateRule, 0, len(list.Elements()))
+	seen := map[string]struct{}{}
+	for _, el := range list.Elements() {
+		attrs := maps.Clone(el.(types.Object).Attributes())
+		attrs["id"] = types.StringNull()
+		name := attrs["name"].(types.String).ValueString()
+		if _, dup := seen[name]; dup {
+			diags.AddError("Duplicate Template Rule Name", fmt.Sprintf("Rule name %q is used more than once in the template.", name))
+			continue
+		}
+		seen[name] = struct{}{}
+
+		ruleObj, d := types.ObjectValue(InternetFirewallRuleRuleAttrTypes, attrs)
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+		hydrated, d := hydrateIfwRuleAPI(ctx, InternetFirewallRule{Rule: ruleObj, At: types.ObjectNull(PositionAttrTypes)})
+		diags.Append(d...)
+		out = append(out, ifwTemplateRule{Name: name, Add: hydrated.create.Rule, Update: hydrated.update.Rule})
+	}
+	return out, diags
+}
+
+// wanTemplateRules hydrates the template wf_rules into API inputs.
+func wanTemplateRules(ctx context.Context, list types.List) ([]wanTemplateRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := make([]wanTemplateRule, 0, len(list.Elements()))
+	seen := map[string]struct{}{}
+	for _, el := range list.Elements() {
+		attrs := maps.Clone(el.(types.Object).Attributes())
+		attrs["id"] = types.StringNull()
+		name := attrs["name"].(types.String).ValueString()
+		if _, dup := seen[name]; dup {
+			diags.AddError("Duplicate Template Rule Name", fmt.Sprintf("Rule name %q is used more than once in the template.", name))
+			continue
+		}
+		seen[name] = struct{}{}
+
+		ruleObj, d := types.ObjectValue(WanFirewallRuleRuleAttrTypes, attrs)
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+		hydrated, d := hydrateWanRuleAPI(ctx, WanFirewallRule{Rule: ruleObj, At: types.ObjectNull(PositionAttrTypes)})
+		diags.Append(d...)
+		out = append(out, wanTemplateRule{Name: name, Add: hydrated.create.Rule, Update: hydrated.update.Rule})
+	}
+	return out, diags
+}
+
+// nullUnknownValues replaces every unknown value nested in v with a null of the
+// same type. Template rules are never sent through the API on apply, so
+// computed rule attributes have nothing to resolve them and must not stay
+// unknown in state.
+func nullUnknownValues(ctx context.Context, v attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v.IsUnknown() {
+		t := v.Type(ctx)
+		null, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("Unable to Null Unknown Value", err.Error())
+			return v, diags
+		}
+		return null, diags
+	}
+	if v.IsNull() {
+		return v, diags
+	}
+
+	switch val := v.(type) {
+	case basetypes.ObjectValue:
+		attrs := make(map[string]attr.Value, len(val.Attributes()))
+		for k, a := range val.Attributes() {
+			nv, d := nullUnknownValues(ctx, a)
+			diags.Append(d...)
+			attrs[k] = nv
+		}
+		obj, d := types.ObjectValue(val.AttributeTypes(ctx), attrs)
+		diags.Append(d...)
+		return obj, diags
+	case basetypes.ListValue:
+		elems := make([]attr.Value, 0, len(val.Elements()))
+		for _, e := range val.Elements() {
+			nv, d := nullUnknownValues(ctx, e)
+			diags.Append(d...)
+			elems = append(elems, nv)
+		}
+		list, d := types.ListValue(val.ElementType(ctx), elems)
+		diags.Append(d...)
+		return list, diags
+	case basetypes.SetValue:
+		elems := make([]attr.Value, 0, len(val.Elements()))
+		for _, e := range val.Elements() {
+			nv, d := nullUnknownValues(ctx, e)
+			diags.Append(d...)
+			elems = append(elems, nv)
+		}
+		set, d := types.SetValue(val.ElementType(ctx), elems)
+		diags.Append(d...)
+		return set, diags
+	}
+	return v, diags
+}
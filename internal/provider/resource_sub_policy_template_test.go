@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/mocks"
+)
+
+func TestNewSubPolicyTemplateResource(t *testing.T) {
+	if r := NewSubPolicyTemplateResource(); r == nil {
+		t.Fatal("expected resource instance, got nil")
+	} else if _, ok := r.(*subPolicyTemplateResource); !ok {
+		t.Fatalf("expected *subPolicyTemplateResource, got %T", r)
+	}
+}
+
+func TestSubPolicyTemplateMetadata(t *testing.T) {
+	r := &subPolicyTemplateResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "cato"}, resp)
+	if resp.TypeName != "cato_sub_policy_template" {
+		t.Fatalf("expected cato_sub_policy_template, got %q", resp.TypeName)
+	}
+}
+
+func TestSubPolicyTemplateSchemaDropsRuleID(t *testing.T) {
+	r := &subPolicyTemplateResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	for _, name := range []string{"if_rules", "wf_rules"} {
+		a, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Fatalf("expected %s attribute", name)
+		}
+		elem := a.GetType().(types.ListType).ElemType.(types.ObjectType)
+		if _, ok := elem.AttrTypes["id"]; ok {
+			t.Fatalf("expected %s rules without id", name)
+		}
+		if _, ok := elem.AttrTypes["name"]; !ok {
+			t.Fatalf("expected %s rules with name", name)
+		}
+	}
+}
+
+func TestNullUnknownValues(t *testing.T) {
+	ctx := context.Background()
+	objType := map[string]attr.Type{"a": types.StringType, "b": types.SetType{ElemType: types.StringType}}
+	in := types.ListValueMust(types.ObjectType{AttrTypes: objType}, []attr.Value{
+		types.ObjectValueMust(objType, map[string]attr.Value{
+			"a": types.StringUnknown(),
+			"b": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("x")}),
+		}),
+	})
+	out, diags := nullUnknownValues(ctx, in)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	obj := out.(types.List).Elements()[0].(types.Object)
+	if !obj.Attributes()["a"].IsNull() {
+		t.Fatalf("expected unknown to become null, got %s", obj.Attributes()["a"])
+	}
+	if obj.Attributes()["b"].IsNull() {
+		t.Fatal("expected known set to be kept")
+	}
+}
+
+func TestSubPolicyTemplateDrifted(t *testing.T) {
+	ctx := context.Background()
+	ref := newSubPolicyTemplateRef(t, subPolicyTemplateTypeIfw, "allow-dns", "block-all")
+	inSync := []subPolicyChildRule{{id: "r1", name: "allow-dns"}, {id: "r2", name: "block-all"}}
+	if subPolicyTemplateDrifted(ctx, ref, subPolicyTemplateTypeIfw, inSync, nil) {
+		t.Fatal("expected no drift")
+	}
+	reordered := []subPolicyChildRule{inSync[1], inSync[0]}
+	if !subPolicyTemplateDrifted(ctx, ref, subPolicyTemplateTypeIfw, reordered, nil) {
+		t.Fatal("expected drift for reordered rules")
+	}
+	if !subPolicyTemplateDrifted(ctx, ref, subPolicyTemplateTypeIfw, inSync[:1], nil) {
+		t.Fatal("expected drift for missing rule")
+	}
+	drifted, diags := markSubPolicyTemplateDrifted(ref)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if drifted.Attributes()["revision"].(types.String).ValueString() != "" {
+		t.Fatal("expected revision to be cleared")
+	}
+}
+
+func TestSyncIfwSubPolicyTemplate(t *testing.T) {
+	ctx := context.Background()
+	body := ifSubPolicyResponse("sub-1", "test-sub", "a sub", "scope-1", "test-sub")
+	rules := &body.Policy.InternetFirewall.Policy.Rules
+	*rules = append(*rules,
+		ifChildRule("sub-1", "keep-1", "keep"),
+		ifChildRule("sub-1", "stale-1", "stale"),
+		ifChildRule("sub-1", "cleanup-1", "test-sub - Cleanup Rule"),
+	)
+
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyInternetFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(body, nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallAddRule(mock.Anything, mock.MatchedBy(
+		func(in cato_models.InternetFirewallAddRuleInput) bool {
+			return in.At != nil && derefStr(in.At.Ref) == "cleanup-1"
+		}), "account-123").
+		Return(successfulAddRuleResponse("new-1"), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallUpdateRule(mock.Anything, mock.Anything, mock.MatchedBy(
+		func(in cato_models.InternetFirewallUpdateRuleInput) bool { return in.ID == "keep-1" }), "account-123").
+		Return(successfulUpdateRuleResponse("keep-1"), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallRemoveRule(mock.Anything, mock.Anything, mock.MatchedBy(
+		func(in cato_models.InternetFirewallRemoveRuleInput) bool { return in.ID == "stale-1" }), "account-123").
+		Return(ifRemoveRuleResponse(), nil).Once()
+	// Template order is new, keep while the API appends new after keep.
+	var moved []string
+	mockClient.EXPECT().PolicyInternetFirewallMoveRule(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		RunAndReturn(func(_ context.Context, _ *cato_models.InternetFirewallPolicyMutationInput, in cato_models.PolicyMoveRuleInput, _ string, _ ...clientv2.RequestInterceptor) (*cato_go_sdk.PolicyInternetFirewallMoveRule, error) {
+			moved = append(moved, in.ID)
+			return successfulMoveRuleResponse(in.ID), nil
+		}).Times(2)
+
+	changed, diags := syncIfwSubPolicyTemplate(ctx, mockClient, "account-123", "sub-1",
+		newSubPolicyTemplateRef(t, subPolicyTemplateTypeIfw, "new", "keep"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if !changed {
+		t.Fatal("expected changes to be reported")
+	}
+	if len(moved) != 2 || moved[0] != "new-1" || moved[1] != "keep-1" {
+		t.Fatalf("expected rules moved in template order, got %v", moved)
+	}
+}
+
+func TestSyncIfwSubPolicyTemplateWrongPolicyType(t *testing.T) {
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	_, diags := syncIfwSubPolicyTemplate(context.Background(), mockClient, "account-123", "sub-1",
+		newSubPolicyTemplateRef(t, subPolicyTemplateTypeWan, "a"))
+	if !diags.HasError() {
+		t.Fatal("expected diagnostics for a WAN template on an Internet Firewall sub-policy")
+	}
+}
+
+func TestSyncIfwSubPolicyTemplateNoTemplate(t *testing.T) {
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	changed, diags := syncIfwSubPolicyTemplate(context.Background(), mockClient, "account-123", "sub-1",
+		types.ObjectNull(SubPolicyTemplateRefAttrTypes))
+	if diags.HasError() || changed {
+		t.Fatalf("expected no-op without template, got changed=%v diags=%+v", changed, diags)
+	}
+}
+
+func TestWfSubPolicyUpdateSyncsTemplateInOneRevision(t *testing.T) {
+	ctx := context.Background()
+	body := wanSubPolicyResponse("sub-1", "test-sub", "a sub", "scope-1", "test-sub")
+	body.Policy.WanFirewall.Policy.Rules = append(body.Policy.WanFirewall.Policy.Rules,
+		&cato_go_sdk.Policy_Policy_WanFirewall_Policy_Rules{
+			RuleType:  cato_models.PolicyRuleTypeEnumPolicyRule,
+			SubPolicy: &cato_go_sdk.Policy_Policy_WanFirewall_Policy_Rules_SubPolicy{ID: "sub-1", Name: "test-sub"},
+			Rule:      cato_go_sdk.Policy_Policy_WanFirewall_Policy_Rules_Rule{ID: "cleanup-1", Name: "test-sub - Cleanup Rule"},
+		},
+	)
+
+	mockClient := mocks.NewWanFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyWanFirewallUpdateRule(mock.Anything, mock.Anything, "account-123").
+		Return(wanUpdateRuleResponse(cato_models.PolicyMutationStatusSuccess), nil).Once()
+	mockClient.EXPECT().PolicyWanFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(body, nil).Twice()
+	mockClient.EXPECT().PolicyWanFirewallAddRule(mock.Anything, mock.Anything, "account-123").
+		Return(wanAddRuleResponse("rule-1"), nil).Once()
+	mockClient.EXPECT().PolicyWanFirewallPublishPolicyRevision(mock.Anything, mock.Anything, "account-123").
+		Return(nil, nil).Once()
+
+	m := newWfSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	m.Template = newSubPolicyTemplateRef(t, subPolicyTemplateTypeWan, "allow-branches")
+	plan := tfsdk.Plan{Schema: getWfSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+
+	r := &wfSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getWfSubPolicySchema(ctx, t)}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: newWfSubPolicyStateWithID(ctx, t)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+}
+
+func TestSubPolicyTemplateDriftedContent(t *testing.T) {
+	ctx := context.Background()
+	ref := newSubPolicyTemplateRef(t, subPolicyTemplateTypeIfw, "allow-dns", "block-all")
+
+	moved := minimalAPIRule("allow-dns", 7)
+	moved.ID = "r9"
+	if subPolicyRuleContent(minimalAPIRule("allow-dns", 1)) != subPolicyRuleContent(moved) {
+		t.Fatal("expected id and index to be left out of the rule content")
+	}
+	edited := minimalAPIRule("allow-dns", 1)
+	edited.Enabled = false
+	if subPolicyRuleContent(minimalAPIRule("allow-dns", 1)) == subPolicyRuleContent(edited) {
+		t.Fatal("expected an edited rule to change the rule content")
+	}
+
+	children := []subPolicyChildRule{
+		{id: "r1", name: "allow-dns", content: subPolicyRuleContent(minimalAPIRule("allow-dns", 1))},
+		{id: "r2", name: "block-all", content: subPolicyRuleContent(minimalAPIRule("block-all", 2))},
+	}
+	synced := subPolicyTemplateSyncedState(ctx, ref, subPolicyTemplateTypeIfw, children)
+	if subPolicyTemplateDrifted(ctx, ref, subPolicyTemplateTypeIfw, children, synced) {
+		t.Fatal("expected no drift for the synced rules")
+	}
+	changed := []subPolicyChildRule{{id: "r1", name: "allow-dns", content: subPolicyRuleContent(edited)}, children[1]}
+	if !subPolicyTemplateDrifted(ctx, ref, subPolicyTemplateTypeIfw, changed, synced) {
+		t.Fatal("expected drift for a rule edited outside Terraform")
+	}
+
+	resynced, diags := markSubPolicyTemplateDrifted(ref)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if subPolicyTemplateDrifted(ctx, resynced, subPolicyTemplateTypeIfw, changed, synced) {
+		t.Fatal("expected content of another template revision not to be compared")
+	}
+}
+
+func newSubPolicyTemplateRef(t *testing.T, policyType string, names ...string) types.Object {
+	t.Helper()
+	rules := make([]map[string]string, 0, len(names))
+	for _, n := range names {
+		rules = append(rules, map[string]string{"name": n})
+	}
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	return types.ObjectValueMust(SubPolicyTemplateRefAttrTypes, map[string]attr.Value{
+		"id":          types.StringValue("standard"),
+		"policy_type": types.StringValue(policyType),
+		"revision":    types.StringValue("rev-1"),
+		"rules":       types.StringValue(string(encoded)),
+	})
+}
+
+func ifChildRule(subID, id, name string) *cato_go_sdk.Policy_Policy_InternetFirewall_Policy_Rules {
+	rule := minimalAPIRule(name, 0)
+	rule.ID = id
+	return &cato_go_sdk.Policy_Policy_InternetFirewall_Policy_Rules{
+		RuleType:  cato_models.PolicyRuleTypeEnumPolicyRule,
+		SubPolicy: &cato_go_sdk.Policy_Policy_InternetFirewall_Policy_Rules_SubPolicy{ID: subID},
+		Rule:      rule,
+	}
+}
+
+func ifRemoveRuleResponse() *cato_go_sdk.PolicyInternetFirewallRemoveRule {
+	return &cato_go_sdk.PolicyInternetFirewallRemoveRule{
+		Policy: &cato_go_sdk.PolicyInternetFirewallRemoveRule_Policy{
+			InternetFirewall: &cato_go_sdk.PolicyInternetFirewallRemoveRule_Policy_InternetFirewall{
+				RemoveRule: cato_go_sdk.PolicyInternetFirewallRemoveRule_Policy_InternetFirewall_RemoveRule{Status: "SUCCESS"},
+			},
+		},
+	}
+}
+
+func wanAddRuleResponse(ruleID string) *cato_go_sdk.PolicyWanFirewallAddRule {
+	return &cato_go_sdk.PolicyWanFirewallAddRule{
+		Policy: &cato_go_sdk.PolicyWanFirewallAddRule_Policy{
+			WanFirewall: &cato_go_sdk.PolicyWanFirewallAddRule_Policy_WanFirewall{
+				AddRule: cato_go_sdk.PolicyWanFirewallAddRule_Policy_WanFirewall_AddRule{
+					Status: "SUCCESS",
+					Rule: &cato_go_sdk.PolicyWanFirewallAddRule_Policy_WanFirewall_AddRule_Rule{
+						Rule: cato_go_sdk.PolicyWanFirewallAddRule_Policy_WanFirewall_AddRule_Rule_Rule{ID: ruleID},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIfSubPolicyCreateTemplateSyncErrorKeepsSubPolicy(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewInternetFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyInternetFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(emptyInternetFirewallPolicyResponse(), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallAddSubPolicy(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(ifAddSubPolicyResponse(cato_models.PolicyMutationStatusSuccess, ""), nil).Once()
+	mockClient.EXPECT().PolicyInternetFirewallPublishPolicyRevision(mock.Anything, mock.Anything, mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	// No cleanup rule to anchor the template rules to, the sync fails.
+	mockClient.EXPECT().PolicyInternetFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(ifSubPolicyResponse("sub-1", "test-sub", "a sub", "scope-1", "test-sub"), nil).Twice()
+
+	m := newIfSubPolicyModel("")
+	m.Template = newSubPolicyTemplateRef(t, subPolicyTemplateTypeIfw, "allow-branches")
+	plan := tfsdk.Plan{Schema: getIfSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+
+	r := &ifSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: getIfSubPolicySchema(ctx, t)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for the template sync error")
+	}
+	var state InternetFirewallSubPolicy
+	resp.State.Get(ctx, &state)
+	if state.ID.ValueString() != "sub-1" {
+		t.Fatalf("expected the published sub-policy sub-1 in state, got %q", state.ID.ValueString())
+	}
+	if !state.Template.IsNull() {
+		t.Fatal("expected the template left out of state so the next apply syncs it")
+	}
+}
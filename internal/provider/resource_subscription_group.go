@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &subscriptionGroupResource{}
+	_ resource.ResourceWithConfigure   = &subscriptionGroupResource{}
+	_ resource.ResourceWithImportState = &subscriptionGroupResource{}
+)
+
+var ErrSubscriptionGroupNotFound = errors.New("subscription group not found")
+
+func NewSubscriptionGroupResource() resource.Resource {
+	return &subscriptionGroupResource{}
+}
+
+type subscriptionGroupResource struct {
+	client *catoClientData
+}
+
+func (r *subscriptionGroupResource) clientData() **catoClientData { return &r.client }
+
+func (r *subscriptionGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_group"
+}
+
+func (r *subscriptionGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_subscription_group` resource contains the configuration parameters necessary to manage " +
+			"a notification subscription group. A subscription group bundles mailing lists, webhooks and email addresses, " +
+			"and can be referenced by `id` or `name` in the `subscription_group` attributes of the rule tracking alerts.",
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Email addresses subscribed to the group",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(sdpUserEmailRE, "must be a valid email address")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the subscription group",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mailing_lists": schema.SetNestedAttribute{
+				Description: "Mailing lists subscribed to the group",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AtLeastOneOf(path.MatchRoot("emails"), path.MatchRoot("webhooks")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes:    parse.SchemaNameID("Mailing list"),
+					PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the subscription group",
+				Required:    true,
+			},
+			"webhooks": schema.SetNestedAttribute{
+				Description: "Webhooks subscribed to the group",
+				Optional:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes:    parse.SchemaNameID("Webhook"),
+					PlanModifiers: []planmodifier.Object{parse.IDNameModifier()},
+				},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *subscriptionGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *subscriptionGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new subscription group
+func (r *subscriptionGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan SubscriptionGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := cato_models.CreateSubscriptionGroupInput{
+		Emails:       parse.PrepareStrings[string](ctx, plan.Emails, &resp.Diagnostics),
+		MailingLists: parse.PrepareIDRefSet[cato_models.MailingListRefInput](ctx, plan.MailingLists, &resp.Diagnostics),
+		Name:         plan.Name.ValueString(),
+		Webhooks:     parse.PrepareIDRefSet[cato_models.WebhookRefInput](ctx, plan.Webhooks, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call Cato API to create a new subscription group
+	tflog.Debug(ctx, "NotificationCreateSubscriptionGroup", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationCreateSubscriptionGroup(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationCreateSubscriptionGroup", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationCreateSubscriptionGroup error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetNotification().GetCreateSubscriptionGroup().GetSubscriptionGroup().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateSubscriptionGroupState(ctx, plan.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating subscription group state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the subscription group
+func (r *subscriptionGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state SubscriptionGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateSubscriptionGroupState(ctx, state.ID.ValueString())
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the subscription group was found
+		if errors.Is(hydrateErr, ErrSubscriptionGroupNotFound) {
+			tflog.Warn(ctx, "subscription group not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating subscription group state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the subscription group
+func (r *subscriptionGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state SubscriptionGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("NotificationUpdateSubscriptionGroup: ID is unknown", "Subscription group ID is not set in TF state")
+		return
+	}
+
+	// the subscribers are replaced as a whole
+	input := cato_models.UpdateSubscriptionGroupInput{
+		Emails:       parse.PrepareStrings[string](ctx, plan.Emails, &resp.Diagnostics),
+		ID:           id,
+		MailingLists: parse.PrepareIDRefSet[cato_models.MailingListRefInput](ctx, plan.MailingLists, &resp.Diagnostics),
+		Name:         parse.KnownStringPointer(plan.Name),
+		Webhooks:     parse.PrepareIDRefSet[cato_models.WebhookRefInput](ctx, plan.Webhooks, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "NotificationUpdateSubscriptionGroup", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationUpdateSubscriptionGroup(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationUpdateSubscriptionGroup", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationUpdateSubscriptionGroup error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateSubscriptionGroupState(ctx, id)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating subscription group state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the subscription group
+func (r *subscriptionGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state SubscriptionGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteSubscriptionGroupInput{
+		SubscriptionGroup: &cato_models.SubscriptionGroupRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the subscription group
+	tflog.Debug(ctx, "NotificationDeleteSubscriptionGroup", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationDeleteSubscriptionGroup(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationDeleteSubscriptionGroup", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationDeleteSubscriptionGroup error", err.Error())
+		return
+	}
+}
+
+// hydrateSubscriptionGroupState fetches the current state of a subscription group from the API
+func (r *subscriptionGroupResource) hydrateSubscriptionGroupState(ctx context.Context, subscriptionGroupID string,
+) (*SubscriptionGroupModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.SubscriptionGroupRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: subscriptionGroupID,
+	}
+
+	// Call Cato API to get the subscription group
+	tflog.Debug(ctx, "NotificationReadSubscriptionGroup", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationReadSubscriptionGroup(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationReadSubscriptionGroup", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to SubscriptionGroupModel
+	group := result.GetNotification().GetSubscriptionGroup()
+	if group == nil {
+		return nil, diags, ErrSubscriptionGroupNotFound
+	}
+
+	state := &SubscriptionGroupModel{
+		Emails:       parse.StringSetFunc(ctx, emptyToNil(group.Emails), func(s string) string { return s }, &diags),
+		ID:           types.StringValue(group.ID),
+		MailingLists: parse.IDRefSet(ctx, emptyToNil(group.MailingLists), &diags),
+		Name:         types.StringValue(group.Name),
+		Webhooks:     parse.IDRefSet(ctx, emptyToNil(group.Webhooks), &diags),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
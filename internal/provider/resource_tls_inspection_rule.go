@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &tlsInspectionRuleResource{}
 	_ resource.ResourceWithConfigure   = &tlsInspectionRuleResource{}
 	_ resource.ResourceWithImportState = &tlsInspectionRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &tlsInspectionRuleResource{}
 )
 
 func NewTLSInspectionRuleResource() resource.Resource {
@@ -40,6 +42,8 @@ type tlsInspectionRuleResource struct {
 	client *catoClientData
 }
 
+func (r *tlsInspectionRuleResource) clientData() **catoClientData { return &r.client }
+
 func (r *tlsInspectionRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tls_rule"
 }
@@ -51,6 +55,13 @@ func (r *tlsInspectionRuleResource) Schema(_ context.Context, _ resource.SchemaR
 			"Inspection Policy. Documentation for the underlying API used in this resource can be found at " +
 			"[mutation.policy.tlsInspect.addRule()](https://api.catonetworks.com/documentation/#mutation-policy.tlsInspect.addRule).",
 		Attributes: map[string]schema.Attribute{
+			"sub_policy_id": schema.StringAttribute{
+				Description: "Optional ID of a cato_tls_sub_policy that should own this rule. When set, the rule is created inside the sub-policy (positioned before the sub-policy cleanup rule) and is not moved on update. Immutable: changing it forces replacement.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Description: "Identifier of the TLS Inspection Rule",
 				Computed:    true,
@@ -930,6 +941,8 @@ func (r *tlsInspectionRuleResource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"account_id":       accountIDOverrideAttribute(),
+			"lifecycle_window": lifecycleWindowAttribute(),
 		},
 	}
 }
@@ -944,12 +957,28 @@ func (r *tlsInspectionRuleResource) Configure(_ context.Context, req resource.Co
 
 //nolint:funlen
 func (r *tlsInspectionRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan TLSInspectionRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	input, diags := hydrateTLSRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -957,6 +986,30 @@ func (r *tlsInspectionRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// When the rule is owned by a sub-policy, anchor its position before the
+	// sub-policy cleanup rule so the API places it inside the sub-policy.
+	if !plan.SubPolicyID.IsNull() && !plan.SubPolicyID.IsUnknown() {
+		subID := plan.SubPolicyID.ValueString()
+		anchorBody, err := r.client.catov2.Tlsinspectpolicy(ctx, r.client.AccountId)
+		if err != nil {
+			resp.Diagnostics.AddError("Catov2 API PolicyTlsInspect error", err.Error())
+			return
+		}
+		cleanupID := tlsSubPolicyCleanupRuleID(anchorBody, subID)
+		if cleanupID == "" {
+			resp.Diagnostics.AddError(
+				"Sub-policy not found",
+				fmt.Sprintf("could not locate cleanup rule for sub_policy_id %q; verify the sub-policy exists", subID),
+			)
+			return
+		}
+		beforeRule := cato_models.PolicyRulePositionEnumBeforeRule
+		input.create.At = &cato_models.PolicyRulePositionInput{
+			Position: &beforeRule,
+			Ref:      &cleanupID,
+		}
+	}
+
 	tflog.Warn(ctx, "TFLOG_WARN_TLS_input.create", map[string]interface{}{
 		"OUTPUT": utils.InterfaceToJSONString(input.create),
 	})
@@ -1045,16 +1098,25 @@ func (r *tlsInspectionRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	resp.Diagnostics.Append(diags...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 //nolint:gocyclo,funlen
 func (r *tlsInspectionRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state TLSInspectionRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
+	enabled := ruleEnabled(state.Rule)
 
 	body, err := r.client.catov2.Tlsinspectpolicy(ctx, r.client.AccountId)
 	if err != nil {
@@ -1098,10 +1160,14 @@ func (r *tlsInspectionRuleResource) Read(ctx context.Context, req resource.ReadR
 	ruleList := body.GetPolicy().TLSInspect.Policy.GetRules()
 	ruleExist := false
 	currentRule := &cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_Rules_Rule{}
+	currentSubPolicyID := types.StringNull()
 	for _, ruleListItem := range ruleList {
 		if ruleListItem.GetRule().ID == ruleID {
 			ruleExist = true
 			currentRule = ruleListItem.GetRule()
+			if sp := ruleListItem.GetSubPolicy(); sp != nil && sp.GetID() != "" {
+				currentSubPolicyID = types.StringValue(sp.GetID())
+			}
 
 			resp.State.SetAttribute(
 				ctx,
@@ -1130,6 +1196,11 @@ func (r *tlsInspectionRuleResource) Read(ctx context.Context, req resource.ReadR
 	}
 	resp.Diagnostics.Append(diags...)
 
+	// Reflect the sub-policy that currently owns the rule so config drift forces
+	// replacement (sub_policy_id is immutable).
+	diags = resp.State.SetAttribute(ctx, path.Root("sub_policy_id"), currentSubPolicyID)
+	resp.Diagnostics.Append(diags...)
+
 	// Check if position is set in state, if not default to LAST_IN_POLICY
 	positionValue := ifwLastInPolicyPosition
 	refValue := types.StringNull()
@@ -1166,16 +1237,33 @@ func (r *tlsInspectionRuleResource) Read(ctx context.Context, req resource.ReadR
 	// Set ID at root level
 	diags = resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(ruleID))
 	resp.Diagnostics.Append(diags...)
+	keepLifecycleWindowEnabled(ctx, state.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 //nolint:gocyclo,funlen
 func (r *tlsInspectionRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan TLSInspectionRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		removeLifecycleWindowExpiredRule(ctx, req, resp, r.Delete)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	input, diags := hydrateTLSRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -1205,25 +1293,27 @@ func (r *tlsInspectionRuleResource) Update(ctx context.Context, req resource.Upd
 	inputMoveRule.ID = *ruleInput.ID.ValueStringPointer()
 	input.update.ID = *ruleInput.ID.ValueStringPointer()
 
-	// Move rule
-	moveRule, err := r.client.catov2.PolicyTLSInspectMoveRule(ctx, inputMoveRule, r.client.AccountId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Catov2 API PolicyTlsInspectMoveRule error",
-			err.Error(),
-		)
-		return
-	}
-
-	// Check for errors
-	if moveRule.Policy.TLSInspect.MoveRule.Status != ifwMutationStatusSuccess {
-		for _, item := range moveRule.Policy.TLSInspect.MoveRule.GetErrors() {
+	// Move rule, a rule owned by a sub-policy keeps its place in the sub-policy
+	if plan.SubPolicyID.IsNull() {
+		moveRule, err := r.client.catov2.PolicyTLSInspectMoveRule(ctx, inputMoveRule, r.client.AccountId)
+		if err != nil {
 			resp.Diagnostics.AddError(
-				"API Error Moving Rule Resource",
-				fmt.Sprintf("%s : %s", *item.ErrorCode, *item.ErrorMessage),
+				"Catov2 API PolicyTlsInspectMoveRule error",
+				err.Error(),
 			)
+			return
+		}
+
+		// Check for errors
+		if moveRule.Policy.TLSInspect.MoveRule.Status != ifwMutationStatusSuccess {
+			for _, item := range moveRule.Policy.TLSInspect.MoveRule.GetErrors() {
+				resp.Diagnostics.AddError(
+					"API Error Moving Rule Resource",
+					fmt.Sprintf("%s : %s", *item.ErrorCode, *item.ErrorMessage),
+				)
+			}
+			return
 		}
-		return
 	}
 
 	tflog.Warn(ctx, "TFLOG_WARN_TLS_input.update", map[string]interface{}{
@@ -1308,15 +1398,22 @@ func (r *tlsInspectionRuleResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 	resp.Diagnostics.Append(diags...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 func (r *tlsInspectionRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state TLSInspectionRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 
 	ruleInput := PolicyPolicyTLSInspectPolicyRulesRule{}
 	diags = state.Rule.As(ctx, &ruleInput, basetypes.ObjectAsOptions{})
@@ -1359,8 +1456,28 @@ func (r *tlsInspectionRuleResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *tlsInspectionRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Accept either "<rule-id>" (main-policy rule) or "<sub-policy-id>/<rule-id>"
+	// (sub-policy rule).
+	if subID, ruleID, ok := strings.Cut(req.ID, "/"); ok {
+		if subID == "" || ruleID == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"expected \"<rule-id>\" or \"<sub-policy-id>/<rule-id>\"",
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sub_policy_id"), subID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule").AtName("id"), ruleID)...)
+		return
+	}
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
 	// Set rule.id to the imported ID
 	resp.State.SetAttribute(ctx, path.Root("rule").AtName("id"), req.ID)
 }
+
+func (r *tlsInspectionRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLifecycleWindowPlan(ctx, req, resp)
+}
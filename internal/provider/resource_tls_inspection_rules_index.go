@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
+	"strings"
 
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -21,8 +24,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &tlsRulesIndexResource{}
-	_ resource.ResourceWithConfigure = &tlsRulesIndexResource{}
+	_ resource.Resource               = &tlsRulesIndexResource{}
+	_ resource.ResourceWithConfigure  = &tlsRulesIndexResource{}
+	_ resource.ResourceWithModifyPlan = &tlsRulesIndexResource{}
 )
 
 func NewTLSRulesIndexResource() resource.Resource {
@@ -33,6 +37,8 @@ type tlsRulesIndexResource struct {
 	client *catoClientData
 }
 
+func (r *tlsRulesIndexResource) clientData() **catoClientData { return &r.client }
+
 func (r *tlsRulesIndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bulk_tls_move_rule"
 }
@@ -46,6 +52,11 @@ func (r *tlsRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 				Required:    false,
 				Optional:    true,
 			},
+			"dry_run": schema.BoolAttribute{
+				Description: "When true, the sections and rules are not moved and the policy is not published; the rule moves that would be applied are reported as a warning. " +
+					"On refresh the state takes the current order of the policy, so the moves remain planned until applied",
+				Optional: true,
+			},
 			"rule_data": schema.MapNestedAttribute{
 				Description: "Map of TLS Rule Policy Indexes keyed by rule_name",
 				Required:    false,
@@ -117,6 +128,9 @@ func (r *tlsRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 				},
 			},
+			"account_id":           accountIDOverrideAttribute(),
+			"unmanaged_rules":      bulkUnmanagedRulesAttribute("rule_data"),
+			"unmanaged_rule_names": bulkUnmanagedRuleNamesAttribute(),
 		},
 	}
 }
@@ -146,7 +160,112 @@ func (r *tlsRulesIndexResource) Configure(_ context.Context, req resource.Config
 	r.client = req.ProviderData.(*catoClientData)
 }
 
+// ModifyPlan plans an update while unmanaged rules are left to disable or delete
+func (r *tlsRulesIndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyBulkUnmanagedRules(ctx, req, resp)
+}
+
+// tlsBulkRuleRows lists the TLS Inspection rules, system rules and sub-policy scope rules are flagged as such
+func tlsBulkRuleRows(policyAPIData *cato_go_sdk.Tlsinspectpolicy) []BulkPolicyRuleRow {
+	rules := make([]BulkPolicyRuleRow, 0, len(policyAPIData.Policy.TLSInspect.Policy.Rules))
+	for _, item := range policyAPIData.Policy.TLSInspect.Policy.Rules {
+		rules = append(rules, BulkPolicyRuleRow{
+			SectionID:   item.Rule.Section.ID,
+			SectionName: item.Rule.Section.Name,
+			RuleID:      item.Rule.ID,
+			RuleName:    item.Rule.Name,
+			Index:       item.Rule.Index,
+			Enabled:     item.Rule.Enabled,
+			IsSystem: policyElementHasProperty(
+				item.Properties,
+				cato_models.PolicyElementPropertiesEnumSystem,
+			),
+			IsSubPolicyScope: item.GetRuleType() != nil && *item.GetRuleType() == subPolicyScopeRuleType,
+		})
+	}
+	return rules
+}
+
+// handleUnmanagedRules applies unmanaged_rules to the TLS Inspection rules not in rule_data, the
+// publish of the rule moves that follow also publishes the disabled and deleted rules. With
+// dry_run the rules are only listed.
+func (r *tlsRulesIndexResource) handleUnmanagedRules(ctx context.Context, plan TLSRulesIndex, diags *diag.Diagnostics) types.List {
+	if plan.UnmanagedRules.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	policyAPIData, err := r.client.catov2.Tlsinspectpolicy(ctx, r.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API Tlsinspectpolicy error", err.Error())
+		return types.ListNull(types.StringType)
+	}
+	unmanaged := unmanagedPolicyRules(tlsBulkRuleRows(policyAPIData), bulkManagedRuleNames(plan.RuleData))
+
+	if plan.DryRun.ValueBool() {
+		mode := plan.UnmanagedRules.ValueString()
+		if pending := pendingUnmanagedRules(mode, unmanaged); len(pending) > 0 && mode != unmanagedRulesIgnore {
+			diags.AddWarning("Dry run, no unmanaged TLS inspection rules were handled", formatUnmanagedRules(pending))
+		}
+		return unmanagedRuleNamesValue(plan.UnmanagedRules, unmanaged)
+	}
+
+	disable := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.TLSInspectUpdateRuleInput{
+			ID:   rule.RuleID,
+			Rule: &cato_models.TLSInspectUpdateRuleDataInput{Enabled: ptr(false)},
+		}
+		tflog.Debug(ctx, "PolicyTLSInspectUpdateRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.client.catov2.PolicyTLSInspectUpdateRule(ctx, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyTLSInspectUpdateRule error", err.Error())
+			return
+		}
+		if result.Policy.TLSInspect.UpdateRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.TLSInspect.UpdateRule.GetErrors() {
+				diags.AddError("API Error Disabling Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	remove := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.TLSInspectRemoveRuleInput{ID: rule.RuleID}
+		tflog.Debug(ctx, "PolicyTLSInspectRemoveRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.client.catov2.PolicyTLSInspectRemoveRule(ctx, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyTLSInspectRemoveRule error", err.Error())
+			return
+		}
+		if result.Policy.TLSInspect.RemoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.TLSInspect.RemoveRule.GetErrors() {
+				diags.AddError("API Error Deleting Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	return applyUnmanagedRules(ctx, plan.UnmanagedRules, "TLS inspection", unmanaged, disable, remove, func(diags *diag.Diagnostics) { r.discard(ctx, diags) }, diags)
+}
+
+// discard discards the policy draft after unmanaged_rules failed part way, so that no later publish
+// applies the rules disabled or deleted so far. A failed discard is only reported as a warning.
+func (r *tlsRulesIndexResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.client.catov2.PolicyTLSInspectDiscardPolicyRevision(ctx, r.client.AccountId)
+	if err == nil {
+		var msgs []string
+		for _, e := range result.GetPolicy().GetTLSInspect().GetDiscardPolicyRevision().GetErrors() {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", gqlOptionalStr(e.GetErrorCode()), gqlOptionalStr(e.GetErrorMessage())))
+		}
+		if len(msgs) > 0 {
+			err = errors.New(strings.Join(msgs, "; "))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Catov2 API PolicyTLSInspectDiscardPolicyRevision error",
+			"The TLS Inspection policy draft of the failed unmanaged_rules was not discarded, discard it before the next publish: "+err.Error())
+	}
+}
+
 func (r *tlsRulesIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan TLSRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -154,6 +273,11 @@ func (r *tlsRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveTLSRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -175,6 +299,10 @@ func (r *tlsRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *tlsRulesIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state TLSRulesIndex
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -187,13 +315,44 @@ func (r *tlsRulesIndexResource) Read(ctx context.Context, req resource.ReadReque
 	// The state is already properly set during Create/Update operations.
 	// Only refresh IDs if needed, but preserve planned values.
 
-	// No changes needed - preserve existing state
+	// Only the unmanaged rules are refreshed, when unmanaged_rules is set, and after a dry run the
+	// order of the rules and sections, which was not applied
+	if !state.UnmanagedRules.IsNull() || state.DryRun.ValueBool() {
+		policyAPIData, err := r.client.catov2.Tlsinspectpolicy(ctx, r.client.AccountId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Catov2 API Tlsinspectpolicy error",
+				err.Error(),
+			)
+			return
+		}
+		policyRules := tlsBulkRuleRows(policyAPIData)
+		if !state.UnmanagedRules.IsNull() {
+			state.UnmanagedRuleNames = unmanagedRuleNamesValue(
+				state.UnmanagedRules,
+				unmanagedPolicyRules(policyRules, bulkManagedRuleNames(state.RuleData)),
+			)
+		}
+		if state.DryRun.ValueBool() {
+			policySections := make([]BulkPolicySectionRef, 0, len(policyAPIData.Policy.TLSInspect.Policy.Sections))
+			for _, item := range policyAPIData.Policy.TLSInspect.Policy.Sections {
+				policySections = append(policySections, BulkPolicySectionRef{ID: item.Section.ID, Name: item.Section.Name})
+			}
+			state.RuleData, state.SectionData = dryRunBulkRuleOrder(ctx, state.RuleData, state.SectionData,
+				policySections, policyRules, &resp.Diagnostics)
+		}
+	}
+
 	if diags := resp.State.Set(ctx, &state); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
 }
 
 func (r *tlsRulesIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan TLSRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -201,6 +360,11 @@ func (r *tlsRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveTLSRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -265,9 +429,6 @@ func (r *tlsRulesIndexResource) moveTLSRulesAndSections(
 		}
 	}
 
-	// as the name indicates, a slice of string containing TLS sections names
-	listOfSectionNames := make([]string, 0)
-
 	// maps section_name -> section_id
 	sectionIDList := make(map[string]string)
 	sectionIndexAPIData, err := r.client.catov2.Tlsinspectpolicy(ctx, r.client.AccountId)
@@ -318,7 +479,6 @@ func (r *tlsRulesIndexResource) moveTLSRulesAndSections(
 
 	// create the sections from the list provided following the section ID provided in firstSectionID
 	for _, workingSectionName := range sectionListFromPlan {
-		listOfSectionNames = append(listOfSectionNames, workingSectionName.SectionName)
 		policyMoveSectionInputInt := cato_models.PolicyMoveSectionInput{
 			ID: sectionIDList[workingSectionName.SectionName],
 		}
@@ -351,13 +511,26 @@ func (r *tlsRulesIndexResource) moveTLSRulesAndSections(
 			"sectionIDList[workingSectionName.SectionName]": sectionIDList[workingSectionName.SectionName],
 			"response": utils.InterfaceToJSONString(policyMoveSectionInputInt),
 		})
-		sectionMoveAPIData, err := r.client.catov2.PolicyTLSInspectMoveSection(ctx, policyMoveSectionInputInt, r.client.AccountId)
-		// Check for API errors safely with nil checks
-		if sectionMoveAPIData != nil && sectionMoveAPIData.GetPolicy() != nil &&
-			sectionMoveAPIData.GetPolicy().TLSInspect != nil &&
-			sectionMoveAPIData.GetPolicy().TLSInspect.GetMoveSection() != nil &&
-			len(sectionMoveAPIData.GetPolicy().TLSInspect.GetMoveSection().Errors) != 0 {
-			tflog.Warn(ctx, "Write.PolicyTLSInspectMoveSectionMoveSection.response", map[string]interface{}{
+		// a dry run only reports the moves, see the rule moves below
+		if !plan.DryRun.ValueBool() {
+			sectionMoveAPIData, err := r.client.catov2.PolicyTLSInspectMoveSection(ctx, policyMoveSectionInputInt, r.client.AccountId)
+			// Check for API errors safely with nil checks
+			if sectionMoveAPIData != nil && sectionMoveAPIData.GetPolicy() != nil &&
+				sectionMoveAPIData.GetPolicy().TLSInspect != nil &&
+				sectionMoveAPIData.GetPolicy().TLSInspect.GetMoveSection() != nil &&
+				len(sectionMoveAPIData.GetPolicy().TLSInspect.GetMoveSection().Errors) != 0 {
+				tflog.Warn(ctx, "Write.PolicyTLSInspectMoveSectionMoveSection.response", map[string]interface{}{
+					"response": utils.InterfaceToJSONString(sectionMoveAPIData),
+				})
+				if err != nil {
+					diags = append(diags, diag.NewErrorDiagnostic(
+						"Catov2 API PolicyTLSInspectMoveSection error",
+						err.Error(),
+					))
+					return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+				}
+			}
+			tflog.Warn(ctx, "Write.PolicyTLSInspectMoveSection.response", map[string]interface{}{
 				"response": utils.InterfaceToJSONString(sectionMoveAPIData),
 			})
 			if err != nil {
@@ -368,16 +541,6 @@ func (r *tlsRulesIndexResource) moveTLSRulesAndSections(
 				return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
 			}
 		}
-		tflog.Warn(ctx, "Write.PolicyTLSInspectMoveSection.response", map[string]interface{}{
-			"response": utils.InterfaceToJSONString(sectionMoveAPIData),
-		})
-		if err != nil {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Catov2 API PolicyTLSInspectMoveSection error",
-				err.Error(),
-			))
-			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
-		}
 
 		sectionIndexStateData, diagsSection := types.ObjectValue(
 			TLSSectionIndexResourceAttrTypes,
@@ -458,73 +621,40 @@ func (r *tlsRulesIndexResource) moveTLSRulesAndSections(
 			"ruleNameIDMap": utils.InterfaceToJSONString(ruleNameIDMap),
 		})
 
-		// loop through the ordered list of section names
-		for _, sectionNameItem := range listOfSectionNames {
-			tflog.Warn(ctx, "Read.ProcessingSectionFromList.response", map[string]interface{}{
-				"sectionNameItem": sectionNameItem,
-				"ruleNameIDMap":   utils.InterfaceToJSONString(listOfSectionNames),
-			})
-
-			// for easier processing and visualization, we are creating two maps
-			// 1 - mapRuleIndexToRuleName
-			//   this maps the rule index in section to the rule name
-			// 2 - mapRuleIndexToSectionName
-			//  this maps the rule index in section to the section name
-			mapRuleIndexToRuleName := make(map[int64]string)
-			mapRuleIndexToSectionName := make(map[int64]string)
-
-			for _, ruleItemFromPlan := range ruleListFromPlan {
-				tflog.Warn(ctx, "Read.CompareruleItemFromPlanAndruleListFromPlan", map[string]interface{}{
-					"ruleItemFromPlan.SectionName": ruleItemFromPlan.SectionName,
-					"sectionNameItem":              sectionNameItem,
-				})
-				if ruleItemFromPlan.SectionName == sectionNameItem {
-					// section name -> rule index order -> rule name
-					mapRuleIndexToRuleName[ruleItemFromPlan.IndexInSection] = ruleItemFromPlan.RuleName
-					mapRuleIndexToSectionName[ruleItemFromPlan.IndexInSection] = ruleItemFromPlan.SectionName
-					tflog.Warn(ctx, "Read.mapRuleIndexToRuleName.response", map[string]interface{}{
-						"ruleItemFromPlan.IndexInSection":   ruleItemFromPlan.IndexInSection,
-						"ruleItemFromPlan.RuleName":         ruleItemFromPlan.RuleName,
-						"mapInternalRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
-					})
-				}
-			}
-
-			tflog.Warn(ctx, "Read.mapRuleIndexToSectionName.response", map[string]interface{}{
-				"mapExternalRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
+		// plan the fewest rule moves reaching the rule_data order, rules already in order are left in place
+		policySections := make([]BulkPolicySectionRef, 0, len(ruleNameIDData.Policy.TLSInspect.Policy.Sections))
+		for _, item := range ruleNameIDData.Policy.TLSInspect.Policy.Sections {
+			policySections = append(policySections, BulkPolicySectionRef{ID: item.Section.ID, Name: item.Section.Name})
+		}
+		policyRules := tlsBulkRuleRows(ruleNameIDData)
+		plannedRules := make([]BulkPlannedRuleIndex, 0, len(ruleListFromPlan))
+		for _, ruleItemFromPlan := range ruleListFromPlan {
+			plannedRules = append(plannedRules, BulkPlannedRuleIndex{
+				SectionName:    ruleItemFromPlan.SectionName,
+				RuleName:       ruleItemFromPlan.RuleName,
+				IndexInSection: ruleItemFromPlan.IndexInSection,
 			})
+		}
+		ruleMoves, err := planBulkRuleMoves(policySections, policyRules, plannedRules)
+		if err != nil {
+			diags = append(diags, diag.NewErrorDiagnostic("Unable to plan TLS inspection rule moves", err.Error()))
+			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+		}
+		tflog.Info(ctx, "Planned TLS inspection rule moves", map[string]interface{}{
+			"moves": formatBulkRuleMoves(ruleMoves),
+		})
 
-			currentRuleID := ""
-			for x := 1; x < len(mapRuleIndexToRuleName)+1; x++ {
-				toPosition := &cato_models.PolicyRulePositionInput{}
-				if x == 1 {
-					pos := ifwRulePositionFirstInSection
-					toPosition.Position = (*cato_models.PolicyRulePositionEnum)(&pos)
-					firstSectionID := sectionIDList[mapRuleIndexToSectionName[1]]
-					toPosition.Ref = &firstSectionID
-				} else {
-					pos := ifwRulePositionAfterRule
-					toPosition.Position = (*cato_models.PolicyRulePositionEnum)(&pos)
-					currentRuleID = ruleNameIDMap[mapRuleIndexToRuleName[int64(x)-1]]
-					toPosition.Ref = &currentRuleID
-					tflog.Warn(ctx, "Read.sectionIDList[mapRuleIndexToSectionName[1]].response", map[string]interface{}{
-						"mapRuleIndexToSectionName":         mapRuleIndexToRuleName,
-						"currentRuleID":                     currentRuleID,
-						"mapExternalRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
-						"ruleNameIDMap":                     utils.InterfaceToJSONString(ruleNameIDMap),
-					})
-				}
-
-				moveRuleConfig := cato_models.PolicyMoveRuleInput{
-					ID: ruleNameIDMap[mapRuleIndexToRuleName[int64(x)]],
-					To: toPosition,
-				}
-				ruleMoveAPIData, err := r.client.catov2.PolicyTLSInspectMoveRule(ctx, moveRuleConfig, r.client.AccountId)
+		if plan.DryRun.ValueBool() {
+			diags = append(diags, diag.NewWarningDiagnostic(
+				"Dry run, no TLS inspection rules or sections were moved",
+				formatBulkRuleMoves(ruleMoves),
+			))
+		} else {
+			for _, move := range ruleMoves {
+				ruleMoveAPIData, err := r.client.catov2.PolicyTLSInspectMoveRule(ctx, move.input(), r.client.AccountId)
 				tflog.Warn(ctx, "Write.PolicyTLSInspectMoveRule.response", map[string]interface{}{
-					"ruleNameIDMap":             utils.InterfaceToJSONString(ruleNameIDMap),
-					"mapRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
-					"moveRuleConfig":            utils.InterfaceToJSONString(moveRuleConfig),
-					"response":                  utils.InterfaceToJSONString(ruleMoveAPIData),
+					"move":     move.String(),
+					"response": utils.InterfaceToJSONString(ruleMoveAPIData),
 				})
 				if err != nil {
 					diags = append(diags, diag.NewErrorDiagnostic(
@@ -549,13 +679,15 @@ func (r *tlsRulesIndexResource) moveTLSRulesAndSections(
 		}
 	}
 
-	_, err = r.client.catov2.PolicyTLSInspectPublishPolicyRevision(ctx, r.client.AccountId)
-	if err != nil {
-		diags = append(diags, diag.NewErrorDiagnostic(
-			"Catov2 API PolicyTLSInspectPublishPolicyRevision error",
-			err.Error(),
-		))
-		return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+	if !plan.DryRun.ValueBool() {
+		_, err = r.client.catov2.PolicyTLSInspectPublishPolicyRevision(ctx, r.client.AccountId)
+		if err != nil {
+			diags = append(diags, diag.NewErrorDiagnostic(
+				"Catov2 API PolicyTLSInspectPublishPolicyRevision error",
+				err.Error(),
+			))
+			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+		}
 	}
 
 	sectionObjectsMap, sectionMapDiags := types.MapValue(
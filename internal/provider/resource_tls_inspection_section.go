@@ -34,6 +34,8 @@ type tlsInspectionSectionResource struct {
 	client *catoClientData
 }
 
+func (r *tlsInspectionSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *tlsInspectionSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tls_section"
 }
@@ -94,6 +96,7 @@ func (r *tlsInspectionSectionResource) Schema(_ context.Context, _ resource.Sche
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -111,11 +114,16 @@ func (r *tlsInspectionSectionResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 func (r *tlsInspectionSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan TLSInspectionSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -191,6 +199,10 @@ func (r *tlsInspectionSectionResource) Create(ctx context.Context, req resource.
 }
 
 func (r *tlsInspectionSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state TLSInspectionSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -274,6 +286,10 @@ func (r *tlsInspectionSectionResource) Read(ctx context.Context, req resource.Re
 
 //nolint:funlen
 func (r *tlsInspectionSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan TLSInspectionSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -378,6 +394,9 @@ func (r *tlsInspectionSectionResource) Update(ctx context.Context, req resource.
 }
 
 func (r *tlsInspectionSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state TLSInspectionSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
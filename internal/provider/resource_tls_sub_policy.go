@@ -0,0 +1,427 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/planmodifiers"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &tlsSubPolicyResource{}
+	_ resource.ResourceWithConfigure   = &tlsSubPolicyResource{}
+	_ resource.ResourceWithImportState = &tlsSubPolicyResource{}
+)
+
+func NewTLSSubPolicyResource() resource.Resource {
+	return &tlsSubPolicyResource{}
+}
+
+type tlsSubPolicyResource struct {
+	client        *catoClientData
+	subPolyClient TLSInspectSubPolicyClient
+}
+
+func (r *tlsSubPolicyResource) clientData() **catoClientData { return &r.client }
+
+func (r *tlsSubPolicyResource) getClient() TLSInspectSubPolicyClient {
+	if r.subPolyClient != nil {
+		return r.subPolyClient
+	}
+	if r.client == nil {
+		return nil
+	}
+	return r.client.catov2
+}
+
+func (r *tlsSubPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tls_sub_policy"
+}
+
+func (r *tlsSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var ruleSchema resource.SchemaResponse
+	(&tlsInspectionRuleResource{}).Schema(ctx, resource.SchemaRequest{}, &ruleSchema)
+	scopeAttr := ruleSchema.Schema.Attributes["rule"].(schema.SingleNestedAttribute)
+	scopeAttr.Description = "Scope of the sub-policy. This is the SUB_POLICY_SCOPE rule that defines when the " +
+		"sub-policy applies. Uses the same parameters as a cato_tls_rule rule."
+	scopeAttr.Required = true
+	scopeAttr.Attributes["action"] = schema.StringAttribute{
+		Description: "API-managed action for the SUB_POLICY_SCOPE rule.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	scopeAttr.Attributes["name"] = schema.StringAttribute{
+		Description: "API-managed scope name, synchronized with the sub-policy name.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("name"),
+		},
+	}
+	scopeAttr.Attributes["description"] = schema.StringAttribute{
+		Description: "API-managed scope description, synchronized with the sub-policy description.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("description"),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_tls_sub_policy` resource manages a TLS Inspection sub-policy " +
+			"(a nested policy scoped by a SUB_POLICY_SCOPE rule). The Cato API has no updateSubPolicy " +
+			"mutation, so `name` and `description` are updated in place through the SUB_POLICY_SCOPE rule, " +
+			"which the API keeps in sync with the sub-policy. Documentation for the " +
+			"underlying API can be found at mutation.policy.tlsInspect.addSubPolicy().",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Sub-policy ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Sub-policy name. Updated in place through the SUB_POLICY_SCOPE rule name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Sub-policy description. Updated in place through the SUB_POLICY_SCOPE rule description.",
+				Required:    true,
+			},
+			"scope_rule_id": schema.StringAttribute{
+				Description: "ID of the underlying SUB_POLICY_SCOPE rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"at": schema.SingleNestedAttribute{
+				Description: "Position of the sub-policy scope within the TLS Inspection policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"position": schema.StringAttribute{
+						Description: "Position relative to a policy, a section or another rule.",
+						Required:    true,
+					},
+					"ref": schema.StringAttribute{
+						Description: "Identifier of the object relative to which the position is defined.",
+						Optional:    true,
+					},
+				},
+			},
+			"scope":      scopeAttr,
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *tlsSubPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *tlsSubPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *tlsSubPolicyResource) publish(ctx context.Context) error {
+	_, err := r.getClient().PolicyTLSInspectPublishPolicyRevision(ctx, r.client.AccountId)
+	return err
+}
+
+//nolint:funlen
+func (r *tlsSubPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan TLSInspectSubPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API requires a normal action while creating a scope rule, then returns
+	// the API-owned SUB_POLICY action. Keep that bootstrap value out of config.
+	scopeAttrs := maps.Clone(plan.Scope.Attributes())
+	scopeAttrs["action"] = types.StringValue(string(cato_models.TLSInspectActionEnumBypass))
+	scopeAttrs["name"] = plan.Name
+	scopeAttrs["description"] = plan.Description
+	createScope, diags := types.ObjectValue(TLSInspectionRuleRuleAttrTypes, scopeAttrs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeRule := TLSInspectionRule{Rule: createScope, At: plan.At}
+	hydrated, diags := hydrateTLSRuleAPI(ctx, scopeRule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	before, err := r.getClient().Tlsinspectpolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API Tlsinspectpolicy error", err.Error())
+		return
+	}
+	existing := tlsSubPolicyIDs(before)
+
+	addInput := cato_models.TLSInspectAddSubPolicyInput{
+		At: hydrated.create.At,
+		Policy: &cato_models.TLSInspectAddSubPolicyDataInput{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		},
+		Scope: hydrated.create.Rule,
+	}
+
+	addResp, err := r.getClient().PolicyTLSInspectAddSubPolicy(ctx, addInput, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyTLSInspectAddSubPolicy error", err.Error())
+		return
+	}
+	addPayload := addResp.GetPolicy().GetTLSInspect().GetAddSubPolicy()
+	if addPayload.GetStatus() == nil || *addPayload.GetStatus() != cato_models.PolicyMutationStatusSuccess {
+		for _, e := range addPayload.GetErrors() {
+			resp.Diagnostics.AddError(
+				"API Error Creating Sub-Policy",
+				fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)),
+			)
+		}
+		return
+	}
+
+	if err := r.publish(ctx); err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyTLSInspectPublishPolicyRevision error", err.Error())
+		return
+	}
+
+	after, err := r.getClient().Tlsinspectpolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API Tlsinspectpolicy error", err.Error())
+		return
+	}
+	subID := tlsSubPolicyIDByName(after, plan.Name.ValueString(), existing)
+	if subID == "" {
+		resp.Diagnostics.AddError("Sub-Policy Not Found", "Created TLS Inspection sub-policy could not be located after publish.")
+		return
+	}
+	scope := tlsScopeRule(after, subID)
+	if scope == nil {
+		resp.Diagnostics.AddError("Scope Rule Not Found", fmt.Sprintf("No SUB_POLICY_SCOPE rule found for sub-policy %s.", subID))
+		return
+	}
+
+	plan.ID = types.StringValue(subID)
+	plan.ScopeRuleID = types.StringValue(scope.GetID())
+	scopeState, diags := hydrateTLSRuleState(ctx, scopeRule, scope)
+	resp.Diagnostics.Append(diags...)
+	scopeState.ID = types.StringValue(scope.GetID())
+	scopeObj, diags := types.ObjectValueFrom(ctx, TLSInspectionRuleRuleAttrTypes, scopeState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Scope = scopeObj
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *tlsSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state TLSInspectSubPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.getClient().Tlsinspectpolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API Tlsinspectpolicy error", err.Error())
+		return
+	}
+
+	info := tlsSubPolicyInfo(body, state.ID.ValueString())
+	if info == nil {
+		tflog.Warn(ctx, "tls inspection sub-policy not found, resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	scope := tlsScopeRule(body, state.ID.ValueString())
+	if scope == nil {
+		tflog.Warn(ctx, "tls inspection sub-policy scope rule not found, resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(info.GetName())
+	if info.GetDescription() == "" {
+		state.Description = types.StringNull()
+	} else {
+		state.Description = types.StringValue(info.GetDescription())
+	}
+	state.ScopeRuleID = types.StringValue(scope.GetID())
+
+	scopeRule := TLSInspectionRule{Rule: state.Scope, At: state.At}
+	scopeState, diags := hydrateTLSRuleState(ctx, scopeRule, scope)
+	resp.Diagnostics.Append(diags...)
+	scopeState.ID = types.StringValue(scope.GetID())
+	scopeObj, diags := types.ObjectValueFrom(ctx, TLSInspectionRuleRuleAttrTypes, scopeState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Scope = scopeObj
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *tlsSubPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan TLSInspectSubPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state TLSInspectSubPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeRuleID := state.ScopeRuleID.ValueString()
+	plan.ID = state.ID
+	plan.ScopeRuleID = state.ScopeRuleID
+
+	scopeRule := TLSInspectionRule{Rule: plan.Scope, At: plan.At}
+	hydrated, diags := hydrateTLSRuleAPI(ctx, scopeRule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hydrated.update.ID = scopeRuleID
+	// The API synchronizes scope name/description back to sub-policy metadata,
+	// which renames the sub-policy in place and keeps its child rules.
+	hydrated.update.Rule.Name = plan.Name.ValueStringPointer()
+	hydrated.update.Rule.Description = plan.Description.ValueStringPointer()
+
+	updateResp, err := r.getClient().PolicyTLSInspectUpdateRule(ctx, hydrated.update, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyTLSInspectUpdateRule error", err.Error())
+		return
+	}
+	if updateResp.Policy.TLSInspect.UpdateRule.Status != ifwMutationStatusSuccess {
+		for _, e := range updateResp.Policy.TLSInspect.UpdateRule.GetErrors() {
+			resp.Diagnostics.AddError("API Error Updating Sub-Policy Scope", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+		}
+		return
+	}
+
+	if err := r.publish(ctx); err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyTLSInspectPublishPolicyRevision error", err.Error())
+		// The scope rule is already renamed in the policy revision, track the sub-policy under its new name.
+		state.Name = plan.Name
+		state.Description = plan.Description
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	body, err := r.getClient().Tlsinspectpolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API Tlsinspectpolicy error", err.Error())
+		return
+	}
+	info := tlsSubPolicyInfo(body, plan.ID.ValueString())
+	if info == nil {
+		resp.Diagnostics.AddError("Sub-Policy Not Found", "Sub-policy not found after update.")
+		return
+	}
+	renamed := !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description)
+	if renamed && (info.GetName() != plan.Name.ValueString() || info.GetDescription() != plan.Description.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Sub-Policy Not Renamed",
+			fmt.Sprintf("The API did not synchronize the name and description of sub-policy %s from its scope rule.", plan.ID.ValueString()),
+		)
+		return
+	}
+	scope := tlsScopeRule(body, plan.ID.ValueString())
+	if scope == nil {
+		resp.Diagnostics.AddError("Scope Rule Not Found", "Sub-policy scope rule not found after update.")
+		return
+	}
+	scopeState, diags := hydrateTLSRuleState(ctx, scopeRule, scope)
+	resp.Diagnostics.Append(diags...)
+	scopeState.ID = types.StringValue(scope.GetID())
+	scopeObj, diags := types.ObjectValueFrom(ctx, TLSInspectionRuleRuleAttrTypes, scopeState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Scope = scopeObj
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *tlsSubPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state TLSInspectSubPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removeInput := cato_models.TLSInspectRemoveSubPolicyInput{Ref: tlsObjectRefByID(state.ID.ValueString())}
+	removeResp, err := r.getClient().PolicyTLSInspectRemoveSubPolicy(ctx, removeInput, r.client.AccountId)
+	tflog.Debug(ctx, "Delete.PolicyTLSInspectRemoveSubPolicy.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(removeResp),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyTLSInspectRemoveSubPolicy error", err.Error())
+		return
+	}
+	removePayload := removeResp.GetPolicy().GetTLSInspect().GetRemoveSubPolicy()
+	if removePayload.GetStatus() != nil && *removePayload.GetStatus() != cato_models.PolicyMutationStatusSuccess {
+		for _, e := range removePayload.GetErrors() {
+			resp.Diagnostics.AddError(
+				"API Error Removing Sub-Policy",
+				fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)),
+			)
+		}
+		return
+	}
+
+	if err := r.publish(ctx); err != nil {
+		resp.Diagnostics.AddError("Catov2 API Delete/PolicyTLSInspectPublishPolicyRevision error", err.Error())
+		return
+	}
+}
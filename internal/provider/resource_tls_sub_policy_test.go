@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/mocks"
+)
+
+func TestNewTLSSubPolicyResource(t *testing.T) {
+	if r := NewTLSSubPolicyResource(); r == nil {
+		t.Fatal("expected resource instance, got nil")
+	} else if _, ok := r.(*tlsSubPolicyResource); !ok {
+		t.Fatalf("expected *tlsSubPolicyResource, got %T", r)
+	}
+}
+
+func TestTLSSubPolicyMetadata(t *testing.T) {
+	r := &tlsSubPolicyResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "cato"}, resp)
+	if resp.TypeName != "cato_tls_sub_policy" {
+		t.Fatalf("expected cato_tls_sub_policy, got %q", resp.TypeName)
+	}
+}
+
+func TestTLSSubPolicyImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &tlsSubPolicyResource{}
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: getTLSSubPolicySchema(ctx, t)}}
+	resp.State.Set(ctx, newTLSSubPolicyModel(""))
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "sub-123"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var imported TLSInspectSubPolicy
+	resp.State.Get(ctx, &imported)
+	if imported.ID.ValueString() != "sub-123" {
+		t.Fatalf("expected imported id sub-123, got %q", imported.ID.ValueString())
+	}
+}
+
+func TestTLSSubPolicyCreateSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().Tlsinspectpolicy(mock.Anything, "account-123").
+		Return(emptyTLSInspectPolicyResponse(), nil).Once()
+	mockClient.EXPECT().PolicyTLSInspectAddSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(tlsAddSubPolicyResponse(cato_models.PolicyMutationStatusSuccess, ""), nil).Once()
+	mockClient.EXPECT().PolicyTLSInspectPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().Tlsinspectpolicy(mock.Anything, "account-123").
+		Return(tlsSubPolicyResponse("sub-1", "test-sub", "a sub", "scope-1", "scope"), nil).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: getTLSSubPolicySchema(ctx, t)}}
+	r.Create(ctx, resource.CreateRequest{Plan: newTLSSubPolicyPlan(ctx, t, "")}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var state TLSInspectSubPolicy
+	resp.State.Get(ctx, &state)
+	if state.ID.ValueString() != "sub-1" {
+		t.Fatalf("expected sub id sub-1, got %q", state.ID.ValueString())
+	}
+	if state.ScopeRuleID.ValueString() != "scope-1" {
+		t.Fatalf("expected scope rule id scope-1, got %q", state.ScopeRuleID.ValueString())
+	}
+}
+
+func TestTLSSubPolicyCreateAddError(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().Tlsinspectpolicy(mock.Anything, "account-123").
+		Return(emptyTLSInspectPolicyResponse(), nil).Once()
+	mockClient.EXPECT().PolicyTLSInspectAddSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(nil, assertErr("add failed")).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: getTLSSubPolicySchema(ctx, t)}}
+	r.Create(ctx, resource.CreateRequest{Plan: newTLSSubPolicyPlan(ctx, t, "")}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for add error")
+	}
+}
+
+func TestTLSSubPolicyReadSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().Tlsinspectpolicy(mock.Anything, "account-123").
+		Return(tlsSubPolicyResponse("sub-1", "renamed", "desc", "scope-1", "scope-name"), nil).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	state := newTLSSubPolicyStateWithID(ctx, t)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var got TLSInspectSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.Name.ValueString() != "renamed" {
+		t.Fatalf("expected name renamed, got %q", got.Name.ValueString())
+	}
+}
+
+func TestTLSSubPolicyReadRemovesMissing(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().Tlsinspectpolicy(mock.Anything, "account-123").
+		Return(emptyTLSInspectPolicyResponse(), nil).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	state := newTLSSubPolicyStateWithID(ctx, t)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected state removed when sub-policy missing")
+	}
+}
+
+func TestTLSSubPolicyUpdateRenameInPlace(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().PolicyTLSInspectUpdateRule(mock.Anything, mock.MatchedBy(
+		func(in cato_models.TLSInspectUpdateRuleInput) bool {
+			return in.ID == "scope-1" && derefStr(in.Rule.Name) == "renamed-sub" && derefStr(in.Rule.Description) == "renamed desc"
+		}), "account-123").
+		Return(tlsUpdateRuleResponse(cato_models.PolicyMutationStatusSuccess), nil).Once()
+	mockClient.EXPECT().PolicyTLSInspectPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().Tlsinspectpolicy(mock.Anything, "account-123").
+		Return(tlsSubPolicyResponse("sub-1", "renamed-sub", "renamed desc", "scope-1", "renamed-sub"), nil).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getTLSSubPolicySchema(ctx, t)}}
+	r.Update(ctx, resource.UpdateRequest{Plan: newTLSSubPolicyRenamePlan(ctx, t), State: newTLSSubPolicyStateWithID(ctx, t)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var got TLSInspectSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" {
+		t.Fatalf("expected sub-1 renamed in place, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
+func TestTLSSubPolicyUpdatePublishErrorKeepsRename(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().PolicyTLSInspectUpdateRule(mock.Anything, mock.Anything, "account-123").
+		Return(tlsUpdateRuleResponse(cato_models.PolicyMutationStatusSuccess), nil).Once()
+	mockClient.EXPECT().PolicyTLSInspectPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, errors.New("publish failed")).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getTLSSubPolicySchema(ctx, t)}}
+	r.Update(ctx, resource.UpdateRequest{Plan: newTLSSubPolicyRenamePlan(ctx, t), State: newTLSSubPolicyStateWithID(ctx, t)}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for the publish error")
+	}
+	var got TLSInspectSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" || got.Description.ValueString() != "renamed desc" {
+		t.Fatalf("expected sub-1 tracked under its new name, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
+func TestTLSSubPolicyDeleteSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().PolicyTLSInspectRemoveSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(tlsRemoveSubPolicyResponse(cato_models.PolicyMutationStatusSuccess, ""), nil).Once()
+	mockClient.EXPECT().PolicyTLSInspectPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, nil).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: newTLSSubPolicyStateWithID(ctx, t)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+}
+
+func TestTLSSubPolicyDeleteStatusFailure(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewTLSInspectSubPolicyClient(t)
+	mockClient.EXPECT().PolicyTLSInspectRemoveSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(tlsRemoveSubPolicyResponse(cato_models.PolicyMutationStatusFailure, "cannot"), nil).Once()
+
+	r := &tlsSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: newTLSSubPolicyStateWithID(ctx, t)}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for remove failure status")
+	}
+}
+
+// ---- helpers ----
+
+func getTLSSubPolicySchema(ctx context.Context, t *testing.T) schema.Schema {
+	t.Helper()
+	r := &tlsSubPolicyResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+func emptyTLSScopeObject(id string) types.Object {
+	return types.ObjectValueMust(TLSInspectionRuleRuleAttrTypes, map[string]attr.Value{
+		"id":                           nullableString(id),
+		"name":                         types.StringValue("test-tls-sub-scope"),
+		"description":                  types.StringNull(),
+		"enabled":                      types.BoolValue(true),
+		"action":                       types.StringValue("BYPASS"),
+		"untrusted_certificate_action": types.StringNull(),
+		"connection_origin":            types.StringValue("ANY"),
+		"source":                       types.ObjectNull(TLSSourceAttrTypes),
+		"country":                      types.SetNull(NameIDObjectType),
+		"device_posture_profile":       types.SetNull(NameIDObjectType),
+		"platform":                     types.StringNull(),
+		"application":                  types.ObjectNull(TLSApplicationAttrTypes),
+	})
+}
+
+func newTLSSubPolicyModel(id string) TLSInspectSubPolicy {
+	return TLSInspectSubPolicy{
+		ID:          nullableString(id),
+		Name:        types.StringValue("test-sub"),
+		Description: types.StringValue("a sub"),
+		ScopeRuleID: types.StringNull(),
+		At: types.ObjectValueMust(PositionAttrTypes, map[string]attr.Value{
+			"position": types.StringValue("LAST_IN_POLICY"),
+			"ref":      types.StringNull(),
+		}),
+		Scope: emptyTLSScopeObject(id),
+	}
+}
+
+func newTLSSubPolicyPlan(ctx context.Context, t *testing.T, id string) tfsdk.Plan {
+	t.Helper()
+	plan := tfsdk.Plan{Schema: getTLSSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, newTLSSubPolicyModel(id)); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+	return plan
+}
+
+func newTLSSubPolicyStateWithID(ctx context.Context, t *testing.T) tfsdk.State {
+	t.Helper()
+	m := newTLSSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	state := tfsdk.State{Schema: getTLSSubPolicySchema(ctx, t)}
+	if diags := state.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %+v", diags)
+	}
+	return state
+}
+
+func emptyTLSInspectPolicyResponse() *cato_go_sdk.Tlsinspectpolicy {
+	return &cato_go_sdk.Tlsinspectpolicy{
+		Policy: &cato_go_sdk.Tlsinspectpolicy_Policy{
+			TLSInspect: &cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect{
+				Policy: cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy{
+					Rules: []*cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_Rules{},
+				},
+			},
+		},
+	}
+}
+
+func tlsAddSubPolicyResponse(status cato_models.PolicyMutationStatus, errMsg string) *cato_go_sdk.PolicyTLSInspectAddSubPolicy {
+	var errs []*cato_go_sdk.PolicyTLSInspectAddSubPolicy_Policy_TLSInspect_AddSubPolicy_Errors
+	if errMsg != "" {
+		code := "ERR"
+		errs = append(errs, &cato_go_sdk.PolicyTLSInspectAddSubPolicy_Policy_TLSInspect_AddSubPolicy_Errors{ErrorCode: &code, ErrorMessage: &errMsg})
+	}
+	return &cato_go_sdk.PolicyTLSInspectAddSubPolicy{
+		Policy: &cato_go_sdk.PolicyTLSInspectAddSubPolicy_Policy{
+			TLSInspect: &cato_go_sdk.PolicyTLSInspectAddSubPolicy_Policy_TLSInspect{
+				AddSubPolicy: cato_go_sdk.PolicyTLSInspectAddSubPolicy_Policy_TLSInspect_AddSubPolicy{Status: status, Errors: errs},
+			},
+		},
+	}
+}
+
+func tlsRemoveSubPolicyResponse(status cato_models.PolicyMutationStatus, errMsg string) *cato_go_sdk.PolicyTLSInspectRemoveSubPolicy {
+	var errs []*cato_go_sdk.PolicyTLSInspectRemoveSubPolicy_Policy_TLSInspect_RemoveSubPolicy_Errors
+	if errMsg != "" {
+		code := "ERR"
+		errs = append(errs, &cato_go_sdk.PolicyTLSInspectRemoveSubPolicy_Policy_TLSInspect_RemoveSubPolicy_Errors{ErrorCode: &code, ErrorMessage: &errMsg})
+	}
+	return &cato_go_sdk.PolicyTLSInspectRemoveSubPolicy{
+		Policy: &cato_go_sdk.PolicyTLSInspectRemoveSubPolicy_Policy{
+			TLSInspect: &cato_go_sdk.PolicyTLSInspectRemoveSubPolicy_Policy_TLSInspect{
+				RemoveSubPolicy: cato_go_sdk.PolicyTLSInspectRemoveSubPolicy_Policy_TLSInspect_RemoveSubPolicy{Status: status, Errors: errs},
+			},
+		},
+	}
+}
+
+func tlsSubPolicyResponse(subID, subName, subDesc, scopeID, scopeName string) *cato_go_sdk.Tlsinspectpolicy {
+	scopeRule := cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_Rules_Rule{
+		ID:      scopeID,
+		Name:    scopeName,
+		Enabled: true,
+	}
+	return &cato_go_sdk.Tlsinspectpolicy{
+		Policy: &cato_go_sdk.Tlsinspectpolicy_Policy{
+			TLSInspect: &cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect{
+				Policy: cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy{
+					SubPolicies: []*cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_SubPolicies{
+						{
+							Policy: cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_SubPolicies_Policy{
+								ID: subID, Name: subName, Description: subDesc,
+								Enabled: true, PolicyLevel: cato_models.PolicyLevelEnumSubPolicy,
+							},
+						},
+					},
+					Rules: []*cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_Rules{
+						{
+							RuleType:  cato_models.PolicyRuleTypeEnumSubPolicyScope,
+							SubPolicy: &cato_go_sdk.Tlsinspectpolicy_Policy_TLSInspect_Policy_Rules_SubPolicy{ID: subID, Name: subName},
+							Rule:      scopeRule,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTLSSubPolicyRenamePlan(ctx context.Context, t *testing.T) tfsdk.Plan {
+	t.Helper()
+	m := newTLSSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	m.Name = types.StringValue("renamed-sub")
+	m.Description = types.StringValue("renamed desc")
+	plan := tfsdk.Plan{Schema: getTLSSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+	return plan
+}
+
+func tlsUpdateRuleResponse(status cato_models.PolicyMutationStatus) *cato_go_sdk.PolicyTLSInspectUpdateRule {
+	return &cato_go_sdk.PolicyTLSInspectUpdateRule{
+		Policy: &cato_go_sdk.PolicyTLSInspectUpdateRule_Policy{
+			TLSInspect: &cato_go_sdk.PolicyTLSInspectUpdateRule_Policy_TLSInspect{
+				UpdateRule: cato_go_sdk.PolicyTLSInspectUpdateRule_Policy_TLSInspect_UpdateRule{Status: status},
+			},
+		},
+	}
+}
@@ -37,6 +37,7 @@ var (
 	_ resource.Resource                = &wanFwRuleResource{}
 	_ resource.ResourceWithConfigure   = &wanFwRuleResource{}
 	_ resource.ResourceWithImportState = &wanFwRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &wanFwRuleResource{}
 )
 
 func NewWanFwRuleResource() resource.Resource {
@@ -47,6 +48,8 @@ type wanFwRuleResource struct {
 	client *catoClientData
 }
 
+func (r *wanFwRuleResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanFwRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wf_rule"
 }
@@ -3276,6 +3279,8 @@ func (r *wanFwRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"account_id":       accountIDOverrideAttribute(),
+			"lifecycle_window": lifecycleWindowActivePeriodAttribute(),
 		},
 	}
 }
@@ -3289,6 +3294,7 @@ func (r *wanFwRuleResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 func (r *wanFwRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Accept either "<rule-id>" (main-policy rule) or "<sub-policy-id>/<rule-id>"
 	// (sub-policy rule).
 	if subID, ruleID, ok := strings.Cut(req.ID, "/"); ok {
@@ -3306,14 +3312,31 @@ func (r *wanFwRuleResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("rule").AtName("id"), req, resp)
 }
 
+func (r *wanFwRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLifecycleWindowPlan(ctx, req, resp)
+	planLifecycleWindowActivePeriod(ctx, req, resp)
+}
+
 //nolint:gocyclo,funlen
 func (r *wanFwRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanFirewallRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+		return
+	}
 
 	input, diags := hydrateWanRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -3453,12 +3476,19 @@ func (r *wanFwRuleResource) Create(ctx context.Context, req resource.CreateReque
 
 //nolint:gocyclo,funlen
 func (r *wanFwRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanFirewallRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 
 	queryWanPolicy := &cato_models.WanFirewallPolicyInput{}
 	body, err := r.client.catov2.PolicyWanFirewall(ctx, queryWanPolicy, r.client.AccountId)
@@ -3584,12 +3614,24 @@ func (r *wanFwRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 //nolint:gocyclo,funlen
 func (r *wanFwRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanFirewallRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		removeLifecycleWindowExpiredRule(ctx, req, resp, r.Delete)
+		return
+	}
 
 	input, diags := hydrateWanRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -3743,12 +3785,18 @@ func (r *wanFwRuleResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *wanFwRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state WanFirewallRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 
 	// retrieve rule ID
 	rule := PolicyPolicyWanFirewallPolicyRulesRule{}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
@@ -22,8 +23,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &wanRulesIndexResource{}
-	_ resource.ResourceWithConfigure = &wanRulesIndexResource{}
+	_ resource.Resource               = &wanRulesIndexResource{}
+	_ resource.ResourceWithConfigure  = &wanRulesIndexResource{}
+	_ resource.ResourceWithModifyPlan = &wanRulesIndexResource{}
 	// _ resource.ResourceWithImportState = &wanRulesIndexResource{}
 )
 
@@ -36,6 +38,8 @@ type wanRulesIndexResource struct {
 	wanBulk WanFirewallBulkPolicyClient // optional override for tests
 }
 
+func (r *wanRulesIndexResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanRulesIndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bulk_wf_move_rule"
 }
@@ -50,6 +54,7 @@ func (r *wanRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 				// Computed:    true,
 			},
+			"planned_moves": bulkPlannedReorderAttribute("section"),
 			"rule_data": schema.MapNestedAttribute{
 				Description: "Map of WAN Rule Policy Indexes keyed by rule_name",
 				Required:    false,
@@ -121,6 +126,9 @@ func (r *wanRulesIndexResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 				},
 			},
+			"account_id":           accountIDOverrideAttribute(),
+			"unmanaged_rules":      bulkUnmanagedRulesAttribute("rule_data"),
+			"unmanaged_rule_names": bulkUnmanagedRuleNamesAttribute(),
 		},
 	}
 }
@@ -160,12 +168,161 @@ func (r *wanRulesIndexResource) wanBulkPolicy() WanFirewallBulkPolicyClient {
 	return r.client.catov2
 }
 
+// ModifyPlan previews the rule placements of the policy reorder of the plan in planned_moves
+func (r *wanRulesIndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil { // resource destruction, or provider not configured yet
+		return
+	}
+	modifyBulkUnmanagedRules(ctx, req, resp)
+
+	var plan WanRulesIndex
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.forAccount(ctx, plan.AccountID)
+
+	modifyBulkPlannedMoves(ctx, req, resp, func() ([]BulkRuleMovePreview, bool, error) {
+		planned, known := bulkPlannedRuleIndexes(plan.RuleData)
+		if !known || plan.AccountID.IsUnknown() {
+			return nil, false, nil
+		}
+		sectionIndexAPIData, err := r.wanBulkPolicy().PolicyWanFirewallSectionsIndex(ctx, client.AccountId)
+		if err != nil {
+			return nil, false, err
+		}
+		ruleIndexAPIData, err := r.wanBulkPolicy().PolicyWanFirewallRulesIndex(ctx, client.AccountId)
+		if err != nil {
+			return nil, false, err
+		}
+		previews, err := previewBulkPolicyReorder(wanBulkSectionRefs(sectionIndexAPIData), wanBulkRuleRows(ruleIndexAPIData), planned)
+		return previews, err == nil, err
+	})
+}
+
+// wanBulkSectionRefs lists the WAN Firewall sections in policy order
+func wanBulkSectionRefs(sectionIndexAPIData *cato_go_sdk.WanSectionsIndexPolicy) []BulkPolicySectionRef {
+	sections := make([]BulkPolicySectionRef, 0, len(sectionIndexAPIData.Policy.WanFirewall.Policy.Sections))
+	for _, item := range sectionIndexAPIData.Policy.WanFirewall.Policy.Sections {
+		sections = append(sections, BulkPolicySectionRef{
+			ID:   item.Section.ID,
+			Name: item.Section.Name,
+		})
+	}
+	return sections
+}
+
+// wanBulkRuleRows lists the WAN Firewall rules, system rules are flagged as such
+func wanBulkRuleRows(ruleIndexAPIData *cato_go_sdk.WanRulesIndexPolicy) []BulkPolicyRuleRow {
+	rules := make([]BulkPolicyRuleRow, 0, len(ruleIndexAPIData.Policy.WanFirewall.Policy.Rules))
+	for _, item := range ruleIndexAPIData.Policy.WanFirewall.Policy.Rules {
+		rules = append(rules, BulkPolicyRuleRow{
+			SectionID:   item.Rule.Section.ID,
+			SectionName: item.Rule.Section.Name,
+			RuleID:      item.Rule.ID,
+			RuleName:    item.Rule.Name,
+			Index:       item.Rule.Index,
+			Enabled:     item.Rule.Enabled,
+			IsSystem: policyElementHasProperty(
+				item.Properties,
+				cato_models.PolicyElementPropertiesEnumSystem,
+			),
+		})
+	}
+	return rules
+}
+
+// unmanagedRules returns the WAN Firewall rules of the rules index not in ruleData, the
+// SUB_POLICY_SCOPE rules the index does not tell apart are read from the policy and left out
+func (r *wanRulesIndexResource) unmanagedRules(ctx context.Context, ruleIndexAPIData *cato_go_sdk.WanRulesIndexPolicy, ruleData types.Map) ([]BulkPolicyRuleRow, error) {
+	policy, err := r.wanBulkPolicy().PolicyWanFirewall(ctx, &cato_models.WanFirewallPolicyInput{}, r.client.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	rules := flagSubPolicyScopeRules(wanBulkRuleRows(ruleIndexAPIData), wanSubPolicyScopeRuleIDs(policy))
+	return unmanagedPolicyRules(rules, bulkManagedRuleNames(ruleData)), nil
+}
+
+// handleUnmanagedRules applies unmanaged_rules to the WAN Firewall rules not in rule_data,
+// the publish of the rule moves that follow also publishes the disabled and deleted rules
+func (r *wanRulesIndexResource) handleUnmanagedRules(ctx context.Context, plan WanRulesIndex, diags *diag.Diagnostics) types.List {
+	if plan.UnmanagedRules.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	ruleIndexAPIData, err := r.wanBulkPolicy().PolicyWanFirewallRulesIndex(ctx, r.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyWanFirewallRulesIndex error", err.Error())
+		return types.ListNull(types.StringType)
+	}
+	unmanaged, err := r.unmanagedRules(ctx, ruleIndexAPIData, plan.RuleData)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyWanFirewall error", err.Error())
+		return types.ListNull(types.StringType)
+	}
+
+	disable := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.WanFirewallUpdateRuleInput{
+			ID:   rule.RuleID,
+			Rule: &cato_models.WanFirewallUpdateRuleDataInput{Enabled: ptr(false)},
+		}
+		tflog.Debug(ctx, "PolicyWanFirewallUpdateRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.wanBulkPolicy().PolicyWanFirewallUpdateRule(ctx, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanFirewallUpdateRule error", err.Error())
+			return
+		}
+		if result.Policy.WanFirewall.UpdateRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.WanFirewall.UpdateRule.GetErrors() {
+				diags.AddError("API Error Disabling Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	remove := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.WanFirewallRemoveRuleInput{ID: rule.RuleID}
+		tflog.Debug(ctx, "PolicyWanFirewallRemoveRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.wanBulkPolicy().PolicyWanFirewallRemoveRule(ctx, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanFirewallRemoveRule error", err.Error())
+			return
+		}
+		if result.Policy.WanFirewall.RemoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.WanFirewall.RemoveRule.GetErrors() {
+				diags.AddError("API Error Deleting Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	return applyUnmanagedRules(ctx, plan.UnmanagedRules, "WAN firewall", unmanaged, disable, remove, func(diags *diag.Diagnostics) { r.discard(ctx, diags) }, diags)
+}
+
+// discard discards the policy draft after unmanaged_rules failed part way, so that no later publish
+// applies the rules disabled or deleted so far. A failed discard is only reported as a warning.
+func (r *wanRulesIndexResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.wanBulkPolicy().PolicyWanFirewallDiscardPolicyRevision(
+		ctx,
+		&cato_models.PolicyDiscardRevisionInput{},
+		r.client.AccountId,
+	)
+	if err == nil {
+		if errs := result.GetPolicy().GetWanFirewall().GetDiscardPolicyRevision().GetErrors(); len(errs) > 0 {
+			err = errors.New(formatWanFirewallDiscardErrors(errs))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Catov2 API PolicyWanFirewallDiscardPolicyRevision error",
+			"The WAN Firewall policy draft of the failed unmanaged_rules was not discarded, discard it before the next publish: "+err.Error())
+	}
+}
+
 // func (r *wanRulesIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 // 	// Retrieve import ID and save to id attribute
 // 	// resource.ImportStatePassthroughID(ctx, path.Root("Id"), req, resp)
 // }
 
 func (r *wanRulesIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -173,6 +330,11 @@ func (r *wanRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveWanRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -185,6 +347,7 @@ func (r *wanRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 	resp.Diagnostics.Append(diags...)
 	plan.SectionData = sectionObjectsList
 	plan.RuleData = rulesObjectsList
+	plan.PlannedMoves = bulkPlannedMovesApplied(ctx, plan.PlannedMoves, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -194,6 +357,10 @@ func (r *wanRulesIndexResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *wanRulesIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanRulesIndex
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -206,13 +373,37 @@ func (r *wanRulesIndexResource) Read(ctx context.Context, req resource.ReadReque
 	// The state is already properly set during Create/Update operations.
 	// Only refresh IDs if needed, but preserve planned values.
 
-	// No changes needed - preserve existing state
+	// Only the unmanaged rules are refreshed, when unmanaged_rules is set
+	if !state.UnmanagedRules.IsNull() {
+		ruleIndexAPIData, err := r.wanBulkPolicy().PolicyWanFirewallRulesIndex(ctx, r.client.AccountId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Catov2 API PolicyWanFirewallRulesIndex error",
+				err.Error(),
+			)
+			return
+		}
+		unmanaged, err := r.unmanagedRules(ctx, ruleIndexAPIData, state.RuleData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Catov2 API PolicyWanFirewall error",
+				err.Error(),
+			)
+			return
+		}
+		state.UnmanagedRuleNames = unmanagedRuleNamesValue(state.UnmanagedRules, unmanaged)
+	}
+
 	if diags := resp.State.Set(ctx, &state); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
 }
 
 func (r *wanRulesIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -220,6 +411,11 @@ func (r *wanRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveWanRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -231,6 +427,7 @@ func (r *wanRulesIndexResource) Update(ctx context.Context, req resource.UpdateR
 	resp.Diagnostics.Append(diags...)
 	plan.SectionData = sectionObjectsList
 	plan.RuleData = rulesObjectsList
+	plan.PlannedMoves = bulkPlannedMovesApplied(ctx, plan.PlannedMoves, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -472,28 +669,8 @@ func (r *wanRulesIndexResource) moveWanRulesAndSections(
 			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
 		}
 
-		sections := make([]BulkPolicySectionRef, 0, len(sectionIdxAfter.Policy.WanFirewall.Policy.Sections))
-		for _, item := range sectionIdxAfter.Policy.WanFirewall.Policy.Sections {
-			sections = append(sections, BulkPolicySectionRef{
-				ID:   item.Section.ID,
-				Name: item.Section.Name,
-			})
-		}
-
-		rules := make([]BulkPolicyRuleRow, 0, len(ruleNameIDData.Policy.WanFirewall.Policy.Rules))
-		for _, item := range ruleNameIDData.Policy.WanFirewall.Policy.Rules {
-			rules = append(rules, BulkPolicyRuleRow{
-				SectionID:   item.Rule.Section.ID,
-				SectionName: item.Rule.Section.Name,
-				RuleID:      item.Rule.ID,
-				RuleName:    item.Rule.Name,
-				Index:       item.Rule.Index,
-				IsSystem: policyElementHasProperty(
-					item.Properties,
-					cato_models.PolicyElementPropertiesEnumSystem,
-				),
-			})
-		}
+		sections := wanBulkSectionRefs(sectionIdxAfter)
+		rules := wanBulkRuleRows(ruleNameIDData)
 
 		planned := make([]BulkPlannedRuleIndex, 0, len(ruleListFromPlan))
 		for _, r := range ruleListFromPlan {
@@ -34,6 +34,8 @@ type wanFwSectionResource struct {
 	client *catoClientData
 }
 
+func (r *wanFwSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanFwSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wf_section"
 }
@@ -94,6 +96,7 @@ func (r *wanFwSectionResource) Schema(_ context.Context, _ resource.SchemaReques
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -107,11 +110,16 @@ func (r *wanFwSectionResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *wanFwSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 func (r *wanFwSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanFirewallSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -188,6 +196,10 @@ func (r *wanFwSectionResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *wanFwSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanFirewallSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -265,6 +277,10 @@ func (r *wanFwSectionResource) Read(ctx context.Context, req resource.ReadReques
 
 //nolint:funlen
 func (r *wanFwSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanFirewallSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -370,6 +386,9 @@ func (r *wanFwSectionResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *wanFwSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state WanFirewallSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -38,6 +38,8 @@ type wanInterfaceResource struct {
 	client *catoClientData
 }
 
+func (r *wanInterfaceResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wan_interface"
 }
@@ -105,6 +107,7 @@ func (r *wanInterfaceResource) Schema(_ context.Context, _ resource.SchemaReques
 			// 		},
 			// 	},
 			// },
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -118,6 +121,7 @@ func (r *wanInterfaceResource) Configure(_ context.Context, req resource.Configu
 }
 
 func (r *wanInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
@@ -132,6 +136,10 @@ func (r *wanInterfaceResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 func (r *wanInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanInterface
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -197,6 +205,10 @@ func (r *wanInterfaceResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *wanInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanInterface
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -242,6 +254,10 @@ func (r *wanInterfaceResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *wanInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanInterface
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -307,6 +323,9 @@ func (r *wanInterfaceResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *wanInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state WanInterface
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -4,6 +4,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
@@ -32,6 +33,7 @@ var (
 	_ resource.Resource                = &wanNetworkRuleResource{}
 	_ resource.ResourceWithConfigure   = &wanNetworkRuleResource{}
 	_ resource.ResourceWithImportState = &wanNetworkRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &wanNetworkRuleResource{}
 )
 
 func NewWanNetworkRuleResource() resource.Resource {
@@ -42,6 +44,8 @@ type wanNetworkRuleResource struct {
 	client *catoClientData
 }
 
+func (r *wanNetworkRuleResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanNetworkRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wnw_rule"
 }
@@ -51,6 +55,13 @@ func (r *wanNetworkRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "The `cato_wan_nw_rule` resource contains the configuration parameters necessary to add a rule to the WAN Network policy.",
 		Attributes: map[string]schema.Attribute{
+			"sub_policy_id": schema.StringAttribute{
+				Description: "Optional ID of a cato_wnw_sub_policy that should own this rule. When set, the rule is created inside the sub-policy (positioned before the sub-policy cleanup rule). Immutable: changing it forces replacement.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"at": schema.SingleNestedAttribute{
 				Description: "Position of the rule in the policy",
 				Required:    true,
@@ -2523,6 +2534,8 @@ func (r *wanNetworkRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 					},
 				},
 			},
+			"account_id":       accountIDOverrideAttribute(),
+			"lifecycle_window": lifecycleWindowAttribute(),
 		},
 	}
 }
@@ -2535,17 +2548,52 @@ func (r *wanNetworkRuleResource) Configure(_ context.Context, req resource.Confi
 }
 
 func (r *wanNetworkRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Accept either "<rule-id>" (main-policy rule) or "<sub-policy-id>/<rule-id>"
+	// (sub-policy rule).
+	if subID, ruleID, ok := strings.Cut(req.ID, "/"); ok {
+		if subID == "" || ruleID == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"expected \"<rule-id>\" or \"<sub-policy-id>/<rule-id>\"",
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sub_policy_id"), subID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule").AtName("id"), ruleID)...)
+		return
+	}
 	resource.ImportStatePassthroughID(ctx, path.Root("rule").AtName("id"), req, resp)
 }
 
+func (r *wanNetworkRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyLifecycleWindowPlan(ctx, req, resp)
+}
+
 //nolint:gocyclo,funlen
 func (r *wanNetworkRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanNetworkRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	input, diags := hydrateWanNetworkRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -2553,6 +2601,30 @@ func (r *wanNetworkRuleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// When the rule is owned by a sub-policy, anchor its position before the
+	// sub-policy cleanup rule so the API places it inside the sub-policy.
+	if !plan.SubPolicyID.IsNull() && !plan.SubPolicyID.IsUnknown() {
+		subID := plan.SubPolicyID.ValueString()
+		anchorBody, err := r.client.catov2.WanNetworkPolicy(ctx, r.client.AccountId)
+		if err != nil {
+			resp.Diagnostics.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+			return
+		}
+		cleanupID := wnwSubPolicyCleanupRuleID(anchorBody, subID)
+		if cleanupID == "" {
+			resp.Diagnostics.AddError(
+				"Sub-policy not found",
+				fmt.Sprintf("could not locate cleanup rule for sub_policy_id %q; verify the sub-policy exists", subID),
+			)
+			return
+		}
+		beforeRule := cato_models.PolicyRulePositionEnumBeforeRule
+		input.create.At = &cato_models.PolicyRulePositionInput{
+			Position: &beforeRule,
+			Ref:      &cleanupID,
+		}
+	}
+
 	tflog.Warn(ctx, "TFLOG_WARN_WAN_input.create", map[string]interface{}{
 		"OUTPUT": utils.InterfaceToJSONString(input.create),
 	})
@@ -2654,16 +2726,25 @@ func (r *wanNetworkRuleResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 //nolint:gocyclo,funlen
 func (r *wanNetworkRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanNetworkRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
+	enabled := ruleEnabled(state.Rule)
 
 	// Query WAN Network policy
 	body, err := r.client.catov2.WanNetworkPolicy(ctx, r.client.AccountId)
@@ -2712,11 +2793,15 @@ func (r *wanNetworkRuleResource) Read(ctx context.Context, req resource.ReadRequ
 	ruleList := body.GetPolicy().WanNetwork.Policy.Rules
 	ruleExist := false
 	var currentRule *cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_Rules_Rule
+	currentSubPolicyID := types.StringNull()
 	for _, ruleListItem := range ruleList {
 		rule := ruleListItem.GetRule()
 		if rule.ID == ruleID {
 			ruleExist = true
 			currentRule = rule
+			if sp := ruleListItem.GetSubPolicy(); sp != nil && sp.GetID() != "" {
+				currentSubPolicyID = types.StringValue(sp.GetID())
+			}
 			break
 		}
 	}
@@ -2759,6 +2844,11 @@ func (r *wanNetworkRuleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// Reflect the sub-policy that currently owns the rule so config drift forces
+	// replacement (sub_policy_id is immutable).
+	diags = resp.State.SetAttribute(ctx, path.Root("sub_policy_id"), currentSubPolicyID)
+	resp.Diagnostics.Append(diags...)
+
 	// Hard code LAST_IN_POLICY position as the API does not return any value
 	// This supports the use case of bulk rule import/export
 	positionValue := "LAST_IN_POLICY"
@@ -2786,16 +2876,33 @@ func (r *wanNetworkRuleResource) Read(ctx context.Context, req resource.ReadRequ
 	diags = resp.State.SetAttribute(ctx, path.Root("at"), curAtObj)
 	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(diagstmp...)
+	keepLifecycleWindowEnabled(ctx, state.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 //nolint:gocyclo,funlen
 func (r *wanNetworkRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanNetworkRule
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resolveLifecycleWindow(ctx, &req.Plan, &plan.LifecycleWindow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycleWindowTombstoned(ctx, plan.LifecycleWindow) {
+		removeLifecycleWindowExpiredRule(ctx, req, resp, r.Delete)
+		return
+	}
+	enabled := ruleEnabled(plan.Rule)
+	windowRule, diags := lifecycleWindowRule(ctx, plan.Rule, plan.LifecycleWindow)
+	resp.Diagnostics.Append(diags...)
+	plan.Rule = windowRule
 
 	input, diags := hydrateWanNetworkRuleAPI(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -2908,15 +3015,22 @@ func (r *wanNetworkRuleResource) Update(ctx context.Context, req resource.Update
 	plan.Rule = ruleObject
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	keepLifecycleWindowEnabled(ctx, plan.LifecycleWindow, enabled, &resp.State, &resp.Diagnostics)
 }
 
 func (r *wanNetworkRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state WanNetworkRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if lifecycleWindowTombstoned(ctx, state.LifecycleWindow) {
+		return
+	}
 
 	// Extract rule ID from state
 	var ruleID string
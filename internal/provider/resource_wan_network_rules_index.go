@@ -4,8 +4,10 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,8 +24,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &wanNetworkRulesIndexResource{}
-	_ resource.ResourceWithConfigure = &wanNetworkRulesIndexResource{}
+	_ resource.Resource               = &wanNetworkRulesIndexResource{}
+	_ resource.ResourceWithConfigure  = &wanNetworkRulesIndexResource{}
+	_ resource.ResourceWithModifyPlan = &wanNetworkRulesIndexResource{}
 	// _ resource.ResourceWithImportState = &wanNetworkRulesIndexResource{}
 )
 
@@ -35,6 +38,8 @@ type wanNetworkRulesIndexResource struct {
 	client *catoClientData
 }
 
+func (r *wanNetworkRulesIndexResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanNetworkRulesIndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bulk_wnw_move_rule"
 }
@@ -49,6 +54,11 @@ func (r *wanNetworkRulesIndexResource) Schema(_ context.Context, _ resource.Sche
 				Optional:    true,
 				// Computed:    true,
 			},
+			"dry_run": schema.BoolAttribute{
+				Description: "When true, the sections and rules are not moved and the policy is not published; the rule moves that would be applied are reported as a warning. " +
+					"On refresh the state takes the current order of the policy, so the moves remain planned until applied",
+				Optional: true,
+			},
 			"rule_data": schema.MapNestedAttribute{
 				Description: "Map of WAN Network Rule Policy Indexes keyed by rule_name",
 				Required:    false,
@@ -120,6 +130,9 @@ func (r *wanNetworkRulesIndexResource) Schema(_ context.Context, _ resource.Sche
 					},
 				},
 			},
+			"account_id":           accountIDOverrideAttribute(),
+			"unmanaged_rules":      bulkUnmanagedRulesAttribute("rule_data"),
+			"unmanaged_rule_names": bulkUnmanagedRuleNamesAttribute(),
 		},
 	}
 }
@@ -154,7 +167,108 @@ func (r *wanNetworkRulesIndexResource) Configure(_ context.Context, req resource
 // 	// resource.ImportStatePassthroughID(ctx, path.Root("ID"), req, resp)
 // }
 
+// ModifyPlan plans an update while unmanaged rules are left to disable or delete
+func (r *wanNetworkRulesIndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyBulkUnmanagedRules(ctx, req, resp)
+}
+
+// wanNetworkBulkRuleRows lists the WAN Network rules, system rules and sub-policy scope rules are flagged as such
+func wanNetworkBulkRuleRows(policyAPIData *cato_go_sdk.WanNetworkPolicy) []BulkPolicyRuleRow {
+	rules := make([]BulkPolicyRuleRow, 0, len(policyAPIData.Policy.WanNetwork.Policy.Rules))
+	for _, item := range policyAPIData.Policy.WanNetwork.Policy.Rules {
+		rules = append(rules, BulkPolicyRuleRow{
+			SectionID:   item.Rule.Section.ID,
+			SectionName: item.Rule.Section.Name,
+			RuleID:      item.Rule.ID,
+			RuleName:    item.Rule.Name,
+			Index:       item.Rule.Index,
+			Enabled:     item.Rule.Enabled,
+			IsSystem: policyElementHasProperty(
+				item.Properties,
+				cato_models.PolicyElementPropertiesEnumSystem,
+			),
+			IsSubPolicyScope: item.GetRuleType() != nil && *item.GetRuleType() == subPolicyScopeRuleType,
+		})
+	}
+	return rules
+}
+
+// handleUnmanagedRules applies unmanaged_rules to the WAN Network rules not in rule_data, the
+// publish of the rule moves that follow also publishes the disabled and deleted rules. With
+// dry_run the rules are only listed.
+func (r *wanNetworkRulesIndexResource) handleUnmanagedRules(ctx context.Context, plan WanNetworkRulesIndex, diags *diag.Diagnostics) types.List {
+	if plan.UnmanagedRules.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	policyAPIData, err := r.client.catov2.WanNetworkPolicy(ctx, r.client.AccountId)
+	if err != nil {
+		diags.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+		return types.ListNull(types.StringType)
+	}
+	unmanaged := unmanagedPolicyRules(wanNetworkBulkRuleRows(policyAPIData), bulkManagedRuleNames(plan.RuleData))
+
+	if plan.DryRun.ValueBool() {
+		mode := plan.UnmanagedRules.ValueString()
+		if pending := pendingUnmanagedRules(mode, unmanaged); len(pending) > 0 && mode != unmanagedRulesIgnore {
+			diags.AddWarning("Dry run, no unmanaged WAN network rules were handled", formatUnmanagedRules(pending))
+		}
+		return unmanagedRuleNamesValue(plan.UnmanagedRules, unmanaged)
+	}
+
+	disable := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.WanNetworkUpdateRuleInput{
+			ID:   rule.RuleID,
+			Rule: &cato_models.WanNetworkUpdateRuleDataInput{Enabled: ptr(false)},
+		}
+		tflog.Debug(ctx, "PolicyWanNetworkUpdateRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.client.catov2.PolicyWanNetworkUpdateRule(ctx, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanNetworkUpdateRule error", err.Error())
+			return
+		}
+		if result.Policy.WanNetwork.UpdateRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.WanNetwork.UpdateRule.GetErrors() {
+				diags.AddError("API Error Disabling Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	remove := func(rule BulkPolicyRuleRow, diags *diag.Diagnostics) {
+		input := cato_models.WanNetworkRemoveRuleInput{ID: rule.RuleID}
+		tflog.Debug(ctx, "PolicyWanNetworkRemoveRule", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+		result, err := r.client.catov2.PolicyWanNetworkRemoveRule(ctx, input, r.client.AccountId)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanNetworkRemoveRule error", err.Error())
+			return
+		}
+		if result.Policy.WanNetwork.RemoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range result.Policy.WanNetwork.RemoveRule.GetErrors() {
+				diags.AddError("API Error Deleting Rule "+rule.RuleName, fmt.Sprintf("%s : %s", gqlOptionalStr(e.ErrorCode), gqlOptionalStr(e.ErrorMessage)))
+			}
+		}
+	}
+	return applyUnmanagedRules(ctx, plan.UnmanagedRules, "WAN network", unmanaged, disable, remove, func(diags *diag.Diagnostics) { r.discard(ctx, diags) }, diags)
+}
+
+// discard discards the policy draft after unmanaged_rules failed part way, so that no later publish
+// applies the rules disabled or deleted so far. A failed discard is only reported as a warning.
+func (r *wanNetworkRulesIndexResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.client.catov2.PolicyWanNetworkDiscardPolicyRevision(ctx, r.client.AccountId)
+	if err == nil {
+		if errs := result.GetPolicy().GetWanNetwork().GetDiscardPolicyRevision().GetErrors(); len(errs) > 0 {
+			err = errors.New(formatWanNetworkDiscardErrors(errs))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Catov2 API PolicyWanNetworkDiscardPolicyRevision error",
+			"The WAN Network policy draft of the failed unmanaged_rules was not discarded, discard it before the next publish: "+err.Error())
+	}
+}
+
 func (r *wanNetworkRulesIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanNetworkRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -162,6 +276,11 @@ func (r *wanNetworkRulesIndexResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveWanNetworkRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -183,6 +302,10 @@ func (r *wanNetworkRulesIndexResource) Create(ctx context.Context, req resource.
 }
 
 func (r *wanNetworkRulesIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanNetworkRulesIndex
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -195,13 +318,44 @@ func (r *wanNetworkRulesIndexResource) Read(ctx context.Context, req resource.Re
 	// The state is already properly set during Create/Update operations.
 	// Only refresh IDs if needed, but preserve planned values.
 
-	// No changes needed - preserve existing state
+	// Only the unmanaged rules are refreshed, when unmanaged_rules is set, and after a dry run the
+	// order of the rules and sections, which was not applied
+	if !state.UnmanagedRules.IsNull() || state.DryRun.ValueBool() {
+		policyAPIData, err := r.client.catov2.WanNetworkPolicy(ctx, r.client.AccountId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Catov2 API WanNetworkPolicy error",
+				err.Error(),
+			)
+			return
+		}
+		policyRules := wanNetworkBulkRuleRows(policyAPIData)
+		if !state.UnmanagedRules.IsNull() {
+			state.UnmanagedRuleNames = unmanagedRuleNamesValue(
+				state.UnmanagedRules,
+				unmanagedPolicyRules(policyRules, bulkManagedRuleNames(state.RuleData)),
+			)
+		}
+		if state.DryRun.ValueBool() {
+			policySections := make([]BulkPolicySectionRef, 0, len(policyAPIData.Policy.WanNetwork.Policy.Sections))
+			for _, item := range policyAPIData.Policy.WanNetwork.Policy.Sections {
+				policySections = append(policySections, BulkPolicySectionRef{ID: item.Section.ID, Name: item.Section.Name})
+			}
+			state.RuleData, state.SectionData = dryRunBulkRuleOrder(ctx, state.RuleData, state.SectionData,
+				policySections, policyRules, &resp.Diagnostics)
+		}
+	}
+
 	if diags := resp.State.Set(ctx, &state); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
 }
 
 func (r *wanNetworkRulesIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanNetworkRulesIndex
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -209,6 +363,11 @@ func (r *wanNetworkRulesIndexResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	plan.UnmanagedRuleNames = r.handleUnmanagedRules(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sectionObjectsList, rulesObjectsList, diags, err := r.moveWanNetworkRulesAndSections(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -273,9 +432,6 @@ func (r *wanNetworkRulesIndexResource) moveWanNetworkRulesAndSections(
 		}
 	}
 
-	// as the name indicates, a slice of string containing WAN Network sections names
-	listOfSectionNames := make([]string, 0)
-
 	// maps section_name -> section_id
 	sectionIDList := make(map[string]string)
 	sectionIndexAPIData, err := r.client.catov2.WanNetworkPolicy(ctx, r.client.AccountId)
@@ -326,7 +482,6 @@ func (r *wanNetworkRulesIndexResource) moveWanNetworkRulesAndSections(
 
 	// create the sections from the list provided following the section ID provided in firstSectionId
 	for _, workingSectionName := range sectionListFromPlan {
-		listOfSectionNames = append(listOfSectionNames, workingSectionName.SectionName)
 		policyMoveSectionInputInt := cato_models.PolicyMoveSectionInput{
 			ID: sectionIDList[workingSectionName.SectionName],
 		}
@@ -359,13 +514,26 @@ func (r *wanNetworkRulesIndexResource) moveWanNetworkRulesAndSections(
 			"sectionIdList[workingSectionName.SectionName]": sectionIDList[workingSectionName.SectionName],
 			"response": utils.InterfaceToJSONString(policyMoveSectionInputInt),
 		})
-		sectionMoveAPIData, err := r.client.catov2.PolicyWanNetworkMoveSection(ctx, policyMoveSectionInputInt, r.client.AccountId)
-		// Check for API errors safely with nil checks
-		if sectionMoveAPIData != nil && sectionMoveAPIData.GetPolicy() != nil &&
-			sectionMoveAPIData.GetPolicy().WanNetwork != nil &&
-			sectionMoveAPIData.GetPolicy().WanNetwork.GetMoveSection() != nil &&
-			len(sectionMoveAPIData.GetPolicy().WanNetwork.GetMoveSection().Errors) != 0 {
-			tflog.Warn(ctx, "Write.PolicyWanNetworkMoveSectionMoveSection.response", map[string]interface{}{
+		// a dry run only reports the moves, see the rule moves below
+		if !plan.DryRun.ValueBool() {
+			sectionMoveAPIData, err := r.client.catov2.PolicyWanNetworkMoveSection(ctx, policyMoveSectionInputInt, r.client.AccountId)
+			// Check for API errors safely with nil checks
+			if sectionMoveAPIData != nil && sectionMoveAPIData.GetPolicy() != nil &&
+				sectionMoveAPIData.GetPolicy().WanNetwork != nil &&
+				sectionMoveAPIData.GetPolicy().WanNetwork.GetMoveSection() != nil &&
+				len(sectionMoveAPIData.GetPolicy().WanNetwork.GetMoveSection().Errors) != 0 {
+				tflog.Warn(ctx, "Write.PolicyWanNetworkMoveSectionMoveSection.response", map[string]interface{}{
+					"response": utils.InterfaceToJSONString(sectionMoveAPIData),
+				})
+				if err != nil {
+					diags = append(diags, diag.NewErrorDiagnostic(
+						"Catov2 API EntityLookup error",
+						err.Error(),
+					))
+					return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+				}
+			}
+			tflog.Warn(ctx, "Write.PolicyWanNetworkMoveSection.response", map[string]interface{}{
 				"response": utils.InterfaceToJSONString(sectionMoveAPIData),
 			})
 			if err != nil {
@@ -376,16 +544,6 @@ func (r *wanNetworkRulesIndexResource) moveWanNetworkRulesAndSections(
 				return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
 			}
 		}
-		tflog.Warn(ctx, "Write.PolicyWanNetworkMoveSection.response", map[string]interface{}{
-			"response": utils.InterfaceToJSONString(sectionMoveAPIData),
-		})
-		if err != nil {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Catov2 API EntityLookup error",
-				err.Error(),
-			))
-			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
-		}
 
 		sectionIndexStateData, diagsSection := types.ObjectValue(
 			WanNetworkSectionIndexResourceAttrTypes,
@@ -462,73 +620,40 @@ func (r *wanNetworkRulesIndexResource) moveWanNetworkRulesAndSections(
 			"ruleNameIdMap": utils.InterfaceToJSONString(ruleNameIDMap),
 		})
 
-		// loop through the ordered list of section names
-		for _, sectionNameItem := range listOfSectionNames {
-			tflog.Warn(ctx, "Read.ProcessingSectionFromList.response", map[string]interface{}{
-				"sectionNameItem": sectionNameItem,
-				"ruleNameIdMap":   utils.InterfaceToJSONString(listOfSectionNames),
-			})
-
-			// for easier processing and visualization, we are creating two maps
-			// 1 - mapRuleIndexToRuleName
-			//   this maps the rule index in section to the rule name
-			// 2 - mapRuleIndexToSectionName
-			//  this maps the rule index in section to the section name
-			mapRuleIndexToRuleName := make(map[int64]string)
-			mapRuleIndexToSectionName := make(map[int64]string)
-
-			for _, ruleItemFromPlan := range ruleListFromPlan {
-				tflog.Warn(ctx, "Read.CompareruleItemFromPlanAndruleListFromPlan", map[string]interface{}{
-					"ruleItemFromPlan.SectionName": ruleItemFromPlan.SectionName,
-					"sectionNameItem":              sectionNameItem,
-				})
-				if ruleItemFromPlan.SectionName == sectionNameItem {
-					// section name -> rule index order -> rule name
-					mapRuleIndexToRuleName[ruleItemFromPlan.IndexInSection] = ruleItemFromPlan.RuleName
-					mapRuleIndexToSectionName[ruleItemFromPlan.IndexInSection] = ruleItemFromPlan.SectionName
-					tflog.Warn(ctx, "Read.mapRuleIndexToRuleName.response", map[string]interface{}{
-						"ruleItemFromPlan.IndexInSection":   ruleItemFromPlan.IndexInSection,
-						"ruleItemFromPlan.RuleName":         ruleItemFromPlan.RuleName,
-						"mapInternalRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
-					})
-				}
-			}
-
-			tflog.Warn(ctx, "Read.mapRuleIndexToSectionName.response", map[string]interface{}{
-				"mapExternalRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
+		// plan the fewest rule moves reaching the rule_data order, rules already in order are left in place
+		policySections := make([]BulkPolicySectionRef, 0, len(ruleNameIDData.Policy.WanNetwork.Policy.Sections))
+		for _, item := range ruleNameIDData.Policy.WanNetwork.Policy.Sections {
+			policySections = append(policySections, BulkPolicySectionRef{ID: item.Section.ID, Name: item.Section.Name})
+		}
+		policyRules := wanNetworkBulkRuleRows(ruleNameIDData)
+		plannedRules := make([]BulkPlannedRuleIndex, 0, len(ruleListFromPlan))
+		for _, ruleItemFromPlan := range ruleListFromPlan {
+			plannedRules = append(plannedRules, BulkPlannedRuleIndex{
+				SectionName:    ruleItemFromPlan.SectionName,
+				RuleName:       ruleItemFromPlan.RuleName,
+				IndexInSection: ruleItemFromPlan.IndexInSection,
 			})
+		}
+		ruleMoves, err := planBulkRuleMoves(policySections, policyRules, plannedRules)
+		if err != nil {
+			diags = append(diags, diag.NewErrorDiagnostic("Unable to plan WAN network rule moves", err.Error()))
+			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+		}
+		tflog.Info(ctx, "Planned WAN network rule moves", map[string]interface{}{
+			"moves": formatBulkRuleMoves(ruleMoves),
+		})
 
-			currentRuleID := ""
-			for x := 1; x < len(mapRuleIndexToRuleName)+1; x++ {
-				toPosition := &cato_models.PolicyRulePositionInput{}
-				if x == 1 {
-					pos := "FIRST_IN_SECTION"
-					toPosition.Position = (*cato_models.PolicyRulePositionEnum)(&pos)
-					firstSectionID := sectionIDList[mapRuleIndexToSectionName[1]]
-					toPosition.Ref = &firstSectionID
-				} else {
-					pos := "AFTER_RULE"
-					toPosition.Position = (*cato_models.PolicyRulePositionEnum)(&pos)
-					currentRuleID = ruleNameIDMap[mapRuleIndexToRuleName[int64(x)-1]]
-					toPosition.Ref = &currentRuleID
-					tflog.Warn(ctx, "Read.sectionIdList[mapRuleIndexToSectionName[1]].response", map[string]interface{}{
-						"mapRuleIndexToSectionName":         mapRuleIndexToRuleName,
-						"currentRuleId":                     currentRuleID,
-						"mapExternalRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
-						"ruleNameIdMap":                     utils.InterfaceToJSONString(ruleNameIDMap),
-					})
-				}
-
-				moveRuleConfig := cato_models.PolicyMoveRuleInput{
-					ID: ruleNameIDMap[mapRuleIndexToRuleName[int64(x)]],
-					To: toPosition,
-				}
-				ruleMoveAPIData, err := r.client.catov2.PolicyWanNetworkMoveRule(ctx, moveRuleConfig, r.client.AccountId)
+		if plan.DryRun.ValueBool() {
+			diags = append(diags, diag.NewWarningDiagnostic(
+				"Dry run, no WAN network rules or sections were moved",
+				formatBulkRuleMoves(ruleMoves),
+			))
+		} else {
+			for _, move := range ruleMoves {
+				ruleMoveAPIData, err := r.client.catov2.PolicyWanNetworkMoveRule(ctx, move.input(), r.client.AccountId)
 				tflog.Warn(ctx, "Write.PolicyWanNetworkMoveRule.response", map[string]interface{}{
-					"ruleNameIdMap":             utils.InterfaceToJSONString(ruleNameIDMap),
-					"mapRuleIndexToSectionName": utils.InterfaceToJSONString(mapRuleIndexToRuleName),
-					"moveRuleConfig":            utils.InterfaceToJSONString(moveRuleConfig),
-					"response":                  utils.InterfaceToJSONString(ruleMoveAPIData),
+					"move":     move.String(),
+					"response": utils.InterfaceToJSONString(ruleMoveAPIData),
 				})
 				if err != nil {
 					diags = append(diags, diag.NewErrorDiagnostic(
@@ -559,13 +684,15 @@ func (r *wanNetworkRulesIndexResource) moveWanNetworkRulesAndSections(
 		}
 	}
 
-	_, err = r.client.catov2.PolicyWanNetworkPublishPolicyRevision(ctx, r.client.AccountId)
-	if err != nil {
-		diags = append(diags, diag.NewErrorDiagnostic(
-			"Catov2 API PolicyWanNetworkPublishPolicyRevision error",
-			err.Error(),
-		))
-		return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+	if !plan.DryRun.ValueBool() {
+		_, err = r.client.catov2.PolicyWanNetworkPublishPolicyRevision(ctx, r.client.AccountId)
+		if err != nil {
+			diags = append(diags, diag.NewErrorDiagnostic(
+				"Catov2 API PolicyWanNetworkPublishPolicyRevision error",
+				err.Error(),
+			))
+			return basetypes.MapValue{}, basetypes.MapValue{}, diags, err
+		}
 	}
 
 	var sectionMapDiags diag.Diagnostics
@@ -35,6 +35,8 @@ type wanNetworkSectionResource struct {
 	client *catoClientData
 }
 
+func (r *wanNetworkSectionResource) clientData() **catoClientData { return &r.client }
+
 func (r *wanNetworkSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_wnw_section"
 }
@@ -92,6 +94,7 @@ func (r *wanNetworkSectionResource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -105,11 +108,16 @@ func (r *wanNetworkSectionResource) Configure(_ context.Context, req resource.Co
 }
 
 func (r *wanNetworkSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("section").AtName("id"), req, resp)
 }
 
 func (r *wanNetworkSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanNetworkSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -185,6 +193,10 @@ func (r *wanNetworkSectionResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *wanNetworkSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanNetworkSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -267,6 +279,10 @@ func (r *wanNetworkSectionResource) Read(ctx context.Context, req resource.ReadR
 
 //nolint:funlen
 func (r *wanNetworkSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanNetworkSection
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -371,6 +387,9 @@ func (r *wanNetworkSectionResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *wanNetworkSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
 	var state WanNetworkSection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithConfigure   = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
+)
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+
+	webhookURLRE = regexp.MustCompile(`^https?://[^\s/]+`)
+)
+
+func NewWebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+type webhookResource struct {
+	client *catoClientData
+}
+
+func (r *webhookResource) clientData() **catoClientData { return &r.client }
+
+func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The `cato_webhook` resource contains the configuration parameters necessary to manage " +
+			"a notification webhook. Webhooks can be referenced by `id` or `name` in the `webhook` attributes of the " +
+			"rule tracking alerts and in `cato_subscription_group`. Secret headers (e.g. tokens) are write-only: " +
+			"they are never stored in the Terraform state and are sent to the API on create and whenever " +
+			"`secret_headers_wo_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "HTTP headers sent with the webhook request",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the webhook",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"method": schema.StringAttribute{
+				Description: "HTTP method of the webhook request (POST or PUT, default POST)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(cato_models.WebhookHTTPMethodPost)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(cato_models.WebhookHTTPMethodPost), string(cato_models.WebhookHTTPMethodPut)),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the webhook",
+				Required:    true,
+			},
+			"payload_template": schema.StringAttribute{
+				Description: "Template of the request body, the default Cato payload is sent when not set",
+				Optional:    true,
+			},
+			"secret_headers_wo": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Secret HTTP headers (e.g. Authorization) sent with the webhook request. " +
+					"The values are write-only and are not stored in the Terraform state.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"secret_headers_wo_version": schema.Int64Attribute{
+				Description: "Version of the secret headers; change it to send updated `secret_headers_wo` values to the API",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL the webhook request is sent to",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(webhookURLRE, "must be an http(s) URL"),
+				},
+			},
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Create a new webhook
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, config WebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...) // write-only values are only available in the config
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, secretHeaders := r.prepareHeaders(ctx, plan.Headers, config.SecretHeadersWo, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.CreateWebhookInput{
+		Headers:         headers,
+		Method:          cato_models.WebhookHTTPMethod(plan.Method.ValueString()),
+		Name:            plan.Name.ValueString(),
+		PayloadTemplate: parse.KnownStringPointer(plan.PayloadTemplate),
+		SecretHeaders:   secretHeaders,
+		URL:             plan.URL.ValueString(),
+	}
+
+	// Call Cato API to create a new webhook, the secret headers are not logged
+	tflog.Debug(ctx, "NotificationCreateWebhook", map[string]interface{}{"name": input.Name, "url": input.URL})
+	result, err := r.client.catov2.NotificationCreateWebhook(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationCreateWebhook", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationCreateWebhook error", err.Error())
+		return
+	}
+
+	// Set the ID from the response
+	plan.ID = types.StringValue(result.GetNotification().GetCreateWebhook().GetWebhook().GetID())
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateWebhookState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating webhook state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read the webhook
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state WebhookModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hydratedState, diags, hydrateErr := r.hydrateWebhookState(ctx, state)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		// Check if the webhook was found
+		if errors.Is(hydrateErr, ErrWebhookNotFound) {
+			tflog.Warn(ctx, "webhook not found, resource removed")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error hydrating webhook state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the webhook, the secret headers are sent only when their version changed
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan, state, config WebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if id == "" {
+		resp.Diagnostics.AddError("NotificationUpdateWebhook: ID is unknown", "Webhook ID is not set in TF state")
+		return
+	}
+
+	headers, secretHeaders := r.prepareHeaders(ctx, plan.Headers, config.SecretHeadersWo, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	method := cato_models.WebhookHTTPMethod(plan.Method.ValueString())
+	input := cato_models.UpdateWebhookInput{
+		Headers:         headers,
+		ID:              id,
+		Method:          &method,
+		Name:            parse.KnownStringPointer(plan.Name),
+		PayloadTemplate: parse.KnownStringPointer(plan.PayloadTemplate),
+		URL:             parse.KnownStringPointer(plan.URL),
+	}
+	// nil secret headers keep the current secrets
+	if !plan.SecretHeadersWoVersion.Equal(state.SecretHeadersWoVersion) {
+		input.SecretHeaders = secretHeaders
+		if input.SecretHeaders == nil {
+			input.SecretHeaders = []*cato_models.WebhookHeaderInput{}
+		}
+	}
+
+	tflog.Debug(ctx, "NotificationUpdateWebhook", map[string]interface{}{"id": id, "secrets": input.SecretHeaders != nil})
+	result, err := r.client.catov2.NotificationUpdateWebhook(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationUpdateWebhook", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationUpdateWebhook error", err.Error())
+		return
+	}
+
+	// Hydrate state from API
+	hydratedState, diags, hydrateErr := r.hydrateWebhookState(ctx, plan)
+	if hydrateErr != nil {
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("Error hydrating webhook state", hydrateErr.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &hydratedState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete the webhook
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state WebhookModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cato_models.DeleteWebhookInput{
+		Webhook: &cato_models.WebhookRefInput{
+			By:    cato_models.ObjectRefByID,
+			Input: state.ID.ValueString(),
+		},
+	}
+
+	// Call Cato API to delete the webhook
+	tflog.Debug(ctx, "NotificationDeleteWebhook", map[string]interface{}{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationDeleteWebhook(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationDeleteWebhook", map[string]interface{}{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		resp.Diagnostics.AddError("Cato API NotificationDeleteWebhook error", err.Error())
+		return
+	}
+}
+
+// hydrateWebhookState fetches the current state of a webhook from the API;
+// the secret headers are not returned by the API and the write-only attributes are never stored
+func (r *webhookResource) hydrateWebhookState(ctx context.Context, prior WebhookModel) (*WebhookModel, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	input := cato_models.WebhookRefInput{
+		By:    cato_models.ObjectRefByID,
+		Input: prior.ID.ValueString(),
+	}
+
+	// Call Cato API to get the webhook
+	tflog.Debug(ctx, "NotificationReadWebhook", map[string]any{"request": utils.InterfaceToJSONString(input)})
+	result, err := r.client.catov2.NotificationReadWebhook(ctx, r.client.AccountId, input)
+	tflog.Debug(ctx, "NotificationReadWebhook", map[string]any{"response": utils.InterfaceToJSONString(result)})
+	if err != nil {
+		return nil, diags, err
+	}
+
+	// Map API response to WebhookModel
+	webhook := result.GetNotification().GetWebhook()
+	if webhook == nil {
+		return nil, diags, ErrWebhookNotFound
+	}
+
+	headers := make(map[string]string)
+	for _, h := range webhook.Headers {
+		if h == nil || h.Secret {
+			continue
+		}
+		headers[h.Name] = h.GetValue()
+	}
+	tfHeaders := types.MapNull(types.StringType)
+	if len(headers) > 0 {
+		var mapDiags diag.Diagnostics
+		tfHeaders, mapDiags = types.MapValueFrom(ctx, types.StringType, headers)
+		diags.Append(mapDiags...)
+	}
+
+	state := &WebhookModel{
+		Headers:                tfHeaders,
+		ID:                     types.StringValue(webhook.ID),
+		Method:                 types.StringValue(webhook.Method.String()),
+		Name:                   types.StringValue(webhook.Name),
+		PayloadTemplate:        types.StringPointerValue(webhook.PayloadTemplate),
+		SecretHeadersWo:        types.MapNull(types.StringType),
+		SecretHeadersWoVersion: prior.SecretHeadersWoVersion,
+		URL:                    types.StringValue(webhook.URL),
+	}
+	if diags.HasError() {
+		return nil, diags, ErrAPIResponseParse
+	}
+	return state, diags, nil
+}
+
+// prepareHeaders converts the plain and the secret header maps to the API inputs;
+// a header cannot be both plain and secret
+func (r *webhookResource) prepareHeaders(ctx context.Context, tfHeaders, tfSecretHeaders types.Map, diags *diag.Diagnostics,
+) (headers, secretHeaders []*cato_models.WebhookHeaderInput) {
+	var plain, secret map[string]string
+	if utils.HasValue(tfHeaders) && utils.CheckErr(diags, tfHeaders.ElementsAs(ctx, &plain, false)) {
+		return nil, nil
+	}
+	if utils.HasValue(tfSecretHeaders) && utils.CheckErr(diags, tfSecretHeaders.ElementsAs(ctx, &secret, false)) {
+		return nil, nil
+	}
+
+	if dup := webhookDuplicateHeaders(plain, secret); len(dup) > 0 {
+		diags.AddAttributeError(path.Root("secret_headers_wo"), "Invalid webhook headers",
+			fmt.Sprintf("headers %v are set in both headers and secret_headers_wo", dup))
+		return nil, nil
+	}
+	return webhookHeaderInputs(plain, false), webhookHeaderInputs(secret, true)
+}
+
+// webhookHeaderInputs returns the headers sorted by name, nil for no headers
+func webhookHeaderInputs(headers map[string]string, secret bool) []*cato_models.WebhookHeaderInput {
+	if len(headers) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inputs := make([]*cato_models.WebhookHeaderInput, 0, len(names))
+	for _, name := range names {
+		inputs = append(inputs, &cato_models.WebhookHeaderInput{Name: name, Value: headers[name], Secret: secret})
+	}
+	return inputs
+}
+
+// webhookDuplicateHeaders returns the sorted header names set in both maps; header names are case insensitive
+func webhookDuplicateHeaders(plain, secret map[string]string) []string {
+	seen := make(map[string]struct{}, len(plain))
+	for name := range plain {
+		seen[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+	var dup []string
+	for name := range secret {
+		if _, ok := seen[http.CanonicalHeaderKey(name)]; ok {
+			dup = append(dup, name)
+		}
+	}
+	sort.Strings(dup)
+	return dup
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookHeaderInputs(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, webhookHeaderInputs(nil, false))
+	require.Nil(t, webhookHeaderInputs(map[string]string{}, true))
+
+	inputs := webhookHeaderInputs(map[string]string{"X-Team": "noc", "Content-Type": "application/json"}, false)
+	require.Len(t, inputs, 2)
+	require.Equal(t, "Content-Type", inputs[0].Name)
+	require.Equal(t, "application/json", inputs[0].Value)
+	require.False(t, inputs[0].Secret)
+	require.Equal(t, "X-Team", inputs[1].Name)
+
+	secrets := webhookHeaderInputs(map[string]string{"Authorization": "Bearer token"}, true)
+	require.Len(t, secrets, 1)
+	require.True(t, secrets[0].Secret)
+}
+
+func TestWebhookDuplicateHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		plain  map[string]string
+		secret map[string]string
+		want   []string
+	}{
+		"no headers":       {want: nil},
+		"only plain":       {plain: map[string]string{"X-Team": "noc"}, want: nil},
+		"distinct headers": {plain: map[string]string{"X-Team": "noc"}, secret: map[string]string{"Authorization": "x"}, want: nil},
+		"same header": {
+			plain:  map[string]string{"Authorization": "a", "X-Team": "noc"},
+			secret: map[string]string{"Authorization": "b"},
+			want:   []string{"Authorization"},
+		},
+		"header names are case insensitive": {
+			plain:  map[string]string{"x-api-key": "a"},
+			secret: map[string]string{"X-Api-Key": "b", "authorization": "c"},
+			want:   []string{"X-Api-Key"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, webhookDuplicateHeaders(tt.plain, tt.secret))
+		})
+	}
+}
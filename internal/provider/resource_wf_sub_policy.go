@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 
 	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/planmodifiers"
 	"github.com/catonetworks/terraform-provider-cato/internal/utils"
 )
 
@@ -33,6 +36,8 @@ type wfSubPolicyResource struct {
 	subPolyClient WanFirewallSubPolicyClient
 }
 
+func (r *wfSubPolicyResource) clientData() **catoClientData { return &r.client }
+
 func (r *wfSubPolicyResource) getClient() WanFirewallSubPolicyClient {
 	if r.subPolyClient != nil {
 		return r.subPolyClient
@@ -66,6 +71,7 @@ func (r *wfSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 		Computed:    true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("name"),
 		},
 	}
 	scopeAttr.Attributes["description"] = schema.StringAttribute{
@@ -73,13 +79,15 @@ func (r *wfSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 		Computed:    true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("description"),
 		},
 	}
 
 	resp.Schema = schema.Schema{
 		Description: "The `cato_wf_sub_policy` resource manages a WAN Firewall sub-policy " +
 			"(a nested policy scoped by a SUB_POLICY_SCOPE rule). The Cato API has no updateSubPolicy " +
-			"mutation, so changing `name` or `description` forces resource replacement. Documentation for the " +
+			"mutation, so `name` and `description` are updated in place through the SUB_POLICY_SCOPE rule, " +
+			"which the API keeps in sync with the sub-policy. Documentation for the " +
 			"underlying API can be found at mutation.policy.wanFirewall.addSubPolicy().",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -90,18 +98,12 @@ func (r *wfSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Sub-policy name. Changing this forces replacement (no updateSubPolicy API).",
+				Description: "Sub-policy name. Updated in place through the SUB_POLICY_SCOPE rule name.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Sub-policy description. Changing this forces replacement (no updateSubPolicy API).",
+				Description: "Sub-policy description. Updated in place through the SUB_POLICY_SCOPE rule description.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"scope_rule_id": schema.StringAttribute{
 				Description: "ID of the underlying SUB_POLICY_SCOPE rule.",
@@ -127,7 +129,9 @@ func (r *wfSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaReque
 					},
 				},
 			},
-			"scope": scopeAttr,
+			"scope":      scopeAttr,
+			"template":   subPolicyTemplateRefAttribute(),
+			"account_id": accountIDOverrideAttribute(),
 		},
 	}
 }
@@ -140,6 +144,7 @@ func (r *wfSubPolicyResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *wfSubPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -152,8 +157,33 @@ func (r *wfSubPolicyResource) publish(ctx context.Context) error {
 	return err
 }
 
+// discard discards the policy draft after a failed template sync, so that the
+// next publish, of this or any other resource, does not apply it half-way. A
+// failed discard is only reported as a warning.
+func (r *wfSubPolicyResource) discard(ctx context.Context, diags *diag.Diagnostics) {
+	result, err := r.getClient().PolicyWanFirewallDiscardPolicyRevision(
+		ctx,
+		&cato_models.PolicyDiscardRevisionInput{},
+		r.client.AccountId,
+	)
+	if err == nil {
+		if errs := result.GetPolicy().GetWanFirewall().GetDiscardPolicyRevision().GetErrors(); len(errs) > 0 {
+			err = errors.New(formatWanFirewallDiscardErrors(errs))
+		}
+	}
+	if err != nil {
+		diags.AddWarning("Cato API PolicyWanFirewallDiscardPolicyRevision error",
+			"The WAN Firewall policy draft of the failed template sync was not discarded, discard it before the next publish: "+err.Error())
+	}
+}
+
 //nolint:funlen
 func (r *wfSubPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer r.client.lockPolicy(subPolicyTemplateTypeWan)()
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanFirewallSubPolicy
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -244,10 +274,41 @@ func (r *wfSubPolicyResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	plan.Scope = scopeObj
 
+	// Template child rules go in a second revision: the cleanup rule they are
+	// anchored to only exists once the sub-policy is published.
+	changed, diags := syncWanSubPolicyTemplate(ctx, r.getClient(), r.client.AccountId, subID, plan.Template)
+	resp.Diagnostics.Append(diags...)
+	if changed && !resp.Diagnostics.HasError() {
+		if err := r.publish(ctx); err != nil {
+			resp.Diagnostics.AddError("Catov2 API PolicyWanFirewallPublishPolicyRevision error", err.Error())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		// The sub-policy itself is published: track it without its template,
+		// so that the next apply syncs the template child rules again.
+		if changed {
+			r.discard(ctx, &resp.Diagnostics)
+		}
+		plan.Template = types.ObjectNull(SubPolicyTemplateRefAttrTypes)
+	} else if changed {
+		// Record the synced child rules so that later edits of their content
+		// are detected. Read records them instead if the policy cannot be read.
+		synced, err := r.getClient().PolicyWanFirewall(ctx, &cato_models.WanFirewallPolicyInput{}, r.client.AccountId)
+		if err != nil {
+			tflog.Warn(ctx, "unable to read synced sub-policy child rules", map[string]interface{}{"error": err.Error()})
+		} else {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, subPolicyTemplatePrivateKey, subPolicyTemplateSyncedState(ctx, plan.Template, subPolicyTemplateTypeWan, wanSubPolicyChildRules(synced, subID)))...)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *wfSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var state WanFirewallSubPolicy
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -292,10 +353,28 @@ func (r *wfSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	state.Scope = scopeObj
 
+	children := wanSubPolicyChildRules(body, state.ID.ValueString())
+	synced, diags := req.Private.GetKey(ctx, subPolicyTemplatePrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if subPolicyTemplateDrifted(ctx, state.Template, subPolicyTemplateTypeWan, children, synced) {
+		tflog.Warn(ctx, "sub-policy child rules drifted from template")
+		state.Template, diags = markSubPolicyTemplateDrifted(state.Template)
+		resp.Diagnostics.Append(diags...)
+	} else if !state.Template.IsNull() {
+		// Record the child rules of a template synced before they were tracked,
+		// so that later edits of their content are detected.
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, subPolicyTemplatePrivateKey, subPolicyTemplateSyncedState(ctx, state.Template, subPolicyTemplateTypeWan, children))...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *wfSubPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer r.client.lockPolicy(subPolicyTemplateTypeWan)()
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
 	var plan WanFirewallSubPolicy
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -318,9 +397,10 @@ func (r *wfSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 	hydrated.update.ID = scopeRuleID
-	// The API synchronizes scope name/description back to sub-policy metadata.
-	hydrated.update.Rule.Name = state.Name.ValueStringPointer()
-	hydrated.update.Rule.Description = state.Description.ValueStringPointer()
+	// The API synchronizes scope name/description back to sub-policy metadata,
+	// which renames the sub-policy in place and keeps its child rules.
+	hydrated.update.Rule.Name = plan.Name.ValueStringPointer()
+	hydrated.update.Rule.Description = plan.Description.ValueStringPointer()
 
 	updateResp, err := r.getClient().PolicyWanFirewallUpdateRule(ctx, hydrated.update, r.client.AccountId)
 	if err != nil {
@@ -334,8 +414,23 @@ func (r *wfSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if !plan.Template.Equal(state.Template) {
+		_, diags := syncWanSubPolicyTemplate(ctx, r.getClient(), r.client.AccountId, plan.ID.ValueString(), plan.Template)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			// Drop the scope update and the rules synced so far, state keeps
+			// the prior sub-policy.
+			r.discard(ctx, &resp.Diagnostics)
+			return
+		}
+	}
+
 	if err := r.publish(ctx); err != nil {
 		resp.Diagnostics.AddError("Catov2 API PolicyWanFirewallPublishPolicyRevision error", err.Error())
+		// The scope rule is already renamed in the policy revision, track the sub-policy under its new name.
+		state.Name = plan.Name
+		state.Description = plan.Description
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
 
@@ -344,6 +439,19 @@ func (r *wfSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Catov2 API PolicyWanFirewall error", err.Error())
 		return
 	}
+	info := wanSubPolicyInfo(body, plan.ID.ValueString())
+	if info == nil {
+		resp.Diagnostics.AddError("Sub-Policy Not Found", "Sub-policy not found after update.")
+		return
+	}
+	renamed := !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description)
+	if renamed && (info.GetName() != plan.Name.ValueString() || info.GetDescription() != plan.Description.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Sub-Policy Not Renamed",
+			fmt.Sprintf("The API did not synchronize the name and description of sub-policy %s from its scope rule.", plan.ID.ValueString()),
+		)
+		return
+	}
 	scope := wanScopeRule(body, plan.ID.ValueString())
 	if scope == nil {
 		resp.Diagnostics.AddError("Scope Rule Not Found", "Sub-policy scope rule not found after update.")
@@ -359,10 +467,18 @@ func (r *wfSubPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	plan.Scope = scopeObj
 
+	if !plan.Template.IsNull() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, subPolicyTemplatePrivateKey, subPolicyTemplateSyncedState(ctx, plan.Template, subPolicyTemplateTypeWan, wanSubPolicyChildRules(body, plan.ID.ValueString())))...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *wfSubPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer r.client.lockPolicy(subPolicyTemplateTypeWan)()
+
 	var state WanFirewallSubPolicy
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -131,6 +131,40 @@ func TestWfSubPolicyReadRemovesMissing(t *testing.T) {
 	}
 }
 
+func TestWfSubPolicyUpdateRenameInPlace(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanFirewallSubPolicyClient(t)
+	mockClient.EXPECT().PolicyWanFirewallUpdateRule(mock.Anything, mock.MatchedBy(
+		func(in cato_models.WanFirewallUpdateRuleInput) bool {
+			return in.ID == "scope-1" && derefStr(in.Rule.Name) == "renamed-sub" && derefStr(in.Rule.Description) == "renamed desc"
+		}), "account-123").
+		Return(wanUpdateRuleResponse(cato_models.PolicyMutationStatusSuccess), nil).Once()
+	mockClient.EXPECT().PolicyWanFirewallPublishPolicyRevision(mock.Anything, mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().PolicyWanFirewall(mock.Anything, mock.Anything, "account-123").
+		Return(wanSubPolicyResponse("sub-1", "renamed-sub", "renamed desc", "scope-1", "renamed-sub"), nil).Once()
+
+	r := &wfSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getWfSubPolicySchema(ctx, t)}}
+	m := newWfSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	m.Name = types.StringValue("renamed-sub")
+	m.Description = types.StringValue("renamed desc")
+	plan := tfsdk.Plan{Schema: getWfSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: newWfSubPolicyStateWithID(ctx, t)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var got WanFirewallSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" {
+		t.Fatalf("expected sub-1 renamed in place, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
 func TestWfSubPolicyDeleteSuccess(t *testing.T) {
 	ctx := context.Background()
 	mockClient := mocks.NewWanFirewallSubPolicyClient(t)
@@ -205,7 +239,8 @@ func newWfSubPolicyModel(id string) WanFirewallSubPolicy {
 			"position": types.StringValue("LAST_IN_POLICY"),
 			"ref":      types.StringNull(),
 		}),
-		Scope: emptyWanScopeObject(id),
+		Scope:    emptyWanScopeObject(id),
+		Template: types.ObjectNull(SubPolicyTemplateRefAttrTypes),
 	}
 }
 
@@ -301,3 +336,13 @@ func wanSubPolicyResponse(subID, subName, subDesc, scopeID, scopeName string) *c
 		},
 	}
 }
+
+func wanUpdateRuleResponse(status cato_models.PolicyMutationStatus) *cato_go_sdk.PolicyWanFirewallUpdateRule {
+	return &cato_go_sdk.PolicyWanFirewallUpdateRule{
+		Policy: &cato_go_sdk.PolicyWanFirewallUpdateRule_Policy{
+			WanFirewall: &cato_go_sdk.PolicyWanFirewallUpdateRule_Policy_WanFirewall{
+				UpdateRule: cato_go_sdk.PolicyWanFirewallUpdateRule_Policy_WanFirewall_UpdateRule{Status: status},
+			},
+		},
+	}
+}
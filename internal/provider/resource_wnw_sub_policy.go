@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/planmodifiers"
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &wnwSubPolicyResource{}
+	_ resource.ResourceWithConfigure   = &wnwSubPolicyResource{}
+	_ resource.ResourceWithImportState = &wnwSubPolicyResource{}
+)
+
+func NewWnwSubPolicyResource() resource.Resource {
+	return &wnwSubPolicyResource{}
+}
+
+type wnwSubPolicyResource struct {
+	client        *catoClientData
+	subPolyClient WanNetworkSubPolicyClient
+}
+
+func (r *wnwSubPolicyResource) clientData() **catoClientData { return &r.client }
+
+func (r *wnwSubPolicyResource) getClient() WanNetworkSubPolicyClient {
+	if r.subPolyClient != nil {
+		return r.subPolyClient
+	}
+	if r.client == nil {
+		return nil
+	}
+	return r.client.catov2
+}
+
+func (r *wnwSubPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wnw_sub_policy"
+}
+
+func (r *wnwSubPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var ruleSchema resource.SchemaResponse
+	(&wanNetworkRuleResource{}).Schema(ctx, resource.SchemaRequest{}, &ruleSchema)
+	scopeAttr := ruleSchema.Schema.Attributes["rule"].(schema.SingleNestedAttribute)
+	scopeAttr.Description = "Scope of the sub-policy. This is the SUB_POLICY_SCOPE rule that defines when the " +
+		"sub-policy applies. Uses the same parameters as a cato_wnw_rule rule."
+	scopeAttr.Required = true
+	scopeAttr.Attributes["name"] = schema.StringAttribute{
+		Description: "API-managed scope name, synchronized with the sub-policy name.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("name"),
+		},
+	}
+	scopeAttr.Attributes["description"] = schema.StringAttribute{
+		Description: "API-managed scope description, synchronized with the sub-policy description.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			planmodifiers.FollowRootString("description"),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The `cato_wnw_sub_policy` resource manages a WAN Network sub-policy " +
+			"(a nested policy scoped by a SUB_POLICY_SCOPE rule). The Cato API has no updateSubPolicy " +
+			"mutation, so `name` and `description` are updated in place through the SUB_POLICY_SCOPE rule, " +
+			"which the API keeps in sync with the sub-policy. Documentation for the " +
+			"underlying API can be found at mutation.policy.wanNetwork.addSubPolicy().",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Sub-policy ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Sub-policy name. Updated in place through the SUB_POLICY_SCOPE rule name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Sub-policy description. Updated in place through the SUB_POLICY_SCOPE rule description.",
+				Required:    true,
+			},
+			"scope_rule_id": schema.StringAttribute{
+				Description: "ID of the underlying SUB_POLICY_SCOPE rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"at": schema.SingleNestedAttribute{
+				Description: "Position of the sub-policy scope within the WAN Network policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"position": schema.StringAttribute{
+						Description: "Position relative to a policy, a section or another rule.",
+						Required:    true,
+					},
+					"ref": schema.StringAttribute{
+						Description: "Identifier of the object relative to which the position is defined.",
+						Optional:    true,
+					},
+				},
+			},
+			"scope":      scopeAttr,
+			"account_id": accountIDOverrideAttribute(),
+		},
+	}
+}
+
+func (r *wnwSubPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*catoClientData)
+}
+
+func (r *wnwSubPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountID(ctx, &req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *wnwSubPolicyResource) publish(ctx context.Context) error {
+	_, err := r.getClient().PolicyWanNetworkPublishPolicyRevision(ctx, r.client.AccountId)
+	return err
+}
+
+//nolint:funlen
+func (r *wnwSubPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan WanNetworkSubPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scope rule carries the sub-policy name and description.
+	scopeAttrs := maps.Clone(plan.Scope.Attributes())
+	scopeAttrs["name"] = plan.Name
+	scopeAttrs["description"] = plan.Description
+	createScope, diags := types.ObjectValue(WanNetworkRuleRuleAttrTypes, scopeAttrs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeRule := WanNetworkRule{Rule: createScope, At: plan.At}
+	hydrated, diags := hydrateWanNetworkRuleAPI(ctx, scopeRule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	before, err := r.getClient().WanNetworkPolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+		return
+	}
+	existing := wnwSubPolicyIDs(before)
+
+	addInput := cato_models.WanNetworkAddSubPolicyInput{
+		At: hydrated.create.At,
+		Policy: &cato_models.WanNetworkAddSubPolicyDataInput{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		},
+		Scope: hydrated.create.Rule,
+	}
+
+	addResp, err := r.getClient().PolicyWanNetworkAddSubPolicy(ctx, addInput, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyWanNetworkAddSubPolicy error", err.Error())
+		return
+	}
+	addPayload := addResp.GetPolicy().GetWanNetwork().GetAddSubPolicy()
+	if addPayload.GetStatus() == nil || *addPayload.GetStatus() != cato_models.PolicyMutationStatusSuccess {
+		for _, e := range addPayload.GetErrors() {
+			resp.Diagnostics.AddError(
+				"API Error Creating Sub-Policy",
+				fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)),
+			)
+		}
+		return
+	}
+
+	if err := r.publish(ctx); err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyWanNetworkPublishPolicyRevision error", err.Error())
+		return
+	}
+
+	after, err := r.getClient().WanNetworkPolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+		return
+	}
+	subID := wnwSubPolicyIDByName(after, plan.Name.ValueString(), existing)
+	if subID == "" {
+		resp.Diagnostics.AddError("Sub-Policy Not Found", "Created WAN Network sub-policy could not be located after publish.")
+		return
+	}
+	scope := wnwScopeRule(after, subID)
+	if scope == nil {
+		resp.Diagnostics.AddError("Scope Rule Not Found", fmt.Sprintf("No SUB_POLICY_SCOPE rule found for sub-policy %s.", subID))
+		return
+	}
+
+	plan.ID = types.StringValue(subID)
+	plan.ScopeRuleID = types.StringValue(scope.GetID())
+	scopeState, diags := hydrateWanNetworkRuleState(ctx, scopeRule, scope)
+	resp.Diagnostics.Append(diags...)
+	scopeState.ID = types.StringValue(scope.GetID())
+	scopeObj, diags := types.ObjectValueFrom(ctx, WanNetworkRuleRuleAttrTypes, scopeState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Scope = scopeObj
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *wnwSubPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var state WanNetworkSubPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.getClient().WanNetworkPolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+		return
+	}
+
+	info := wnwSubPolicyInfo(body, state.ID.ValueString())
+	if info == nil {
+		tflog.Warn(ctx, "wan network sub-policy not found, resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	scope := wnwScopeRule(body, state.ID.ValueString())
+	if scope == nil {
+		tflog.Warn(ctx, "wan network sub-policy scope rule not found, resource removed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(info.GetName())
+	if info.GetDescription() == "" {
+		state.Description = types.StringNull()
+	} else {
+		state.Description = types.StringValue(info.GetDescription())
+	}
+	state.ScopeRuleID = types.StringValue(scope.GetID())
+
+	scopeRule := WanNetworkRule{Rule: state.Scope, At: state.At}
+	scopeState, diags := hydrateWanNetworkRuleState(ctx, scopeRule, scope)
+	resp.Diagnostics.Append(diags...)
+	scopeState.ID = types.StringValue(scope.GetID())
+	scopeObj, diags := types.ObjectValueFrom(ctx, WanNetworkRuleRuleAttrTypes, scopeState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Scope = scopeObj
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *wnwSubPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	accountID := accountIDOverride(ctx, req.Plan)
+	r = scopedToAccount(ctx, r, accountID)
+	defer keepAccountID(ctx, accountID, &resp.State, &resp.Diagnostics)
+
+	var plan WanNetworkSubPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state WanNetworkSubPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopeRuleID := state.ScopeRuleID.ValueString()
+	plan.ID = state.ID
+	plan.ScopeRuleID = state.ScopeRuleID
+
+	scopeRule := WanNetworkRule{Rule: plan.Scope, At: plan.At}
+	hydrated, diags := hydrateWanNetworkRuleAPI(ctx, scopeRule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hydrated.update.ID = scopeRuleID
+	// The API synchronizes scope name/description back to sub-policy metadata,
+	// which renames the sub-policy in place and keeps its child rules.
+	hydrated.update.Rule.Name = plan.Name.ValueStringPointer()
+	hydrated.update.Rule.Description = plan.Description.ValueStringPointer()
+
+	updateResp, err := r.getClient().PolicyWanNetworkUpdateRule(ctx, hydrated.update, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyWanNetworkUpdateRule error", err.Error())
+		return
+	}
+	if updateResp.Policy.WanNetwork.UpdateRule.Status != ifwMutationStatusSuccess {
+		for _, e := range updateResp.Policy.WanNetwork.UpdateRule.GetErrors() {
+			resp.Diagnostics.AddError("API Error Updating Sub-Policy Scope", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+		}
+		return
+	}
+
+	if err := r.publish(ctx); err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyWanNetworkPublishPolicyRevision error", err.Error())
+		// The scope rule is already renamed in the policy revision, track the sub-policy under its new name.
+		state.Name = plan.Name
+		state.Description = plan.Description
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	body, err := r.getClient().WanNetworkPolicy(ctx, r.client.AccountId)
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API WanNetworkPolicy error", err.Error())
+		return
+	}
+	info := wnwSubPolicyInfo(body, plan.ID.ValueString())
+	if info == nil {
+		resp.Diagnostics.AddError("Sub-Policy Not Found", "Sub-policy not found after update.")
+		return
+	}
+	renamed := !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description)
+	if renamed && (info.GetName() != plan.Name.ValueString() || info.GetDescription() != plan.Description.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Sub-Policy Not Renamed",
+			fmt.Sprintf("The API did not synchronize the name and description of sub-policy %s from its scope rule.", plan.ID.ValueString()),
+		)
+		return
+	}
+	scope := wnwScopeRule(body, plan.ID.ValueString())
+	if scope == nil {
+		resp.Diagnostics.AddError("Scope Rule Not Found", "Sub-policy scope rule not found after update.")
+		return
+	}
+	scopeState, diags := hydrateWanNetworkRuleState(ctx, scopeRule, scope)
+	resp.Diagnostics.Append(diags...)
+	scopeState.ID = types.StringValue(scope.GetID())
+	scopeObj, diags := types.ObjectValueFrom(ctx, WanNetworkRuleRuleAttrTypes, scopeState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Scope = scopeObj
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *wnwSubPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	accountID := accountIDOverride(ctx, req.State)
+	r = scopedToAccount(ctx, r, accountID)
+
+	var state WanNetworkSubPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removeInput := cato_models.WanNetworkRemoveSubPolicyInput{Ref: wnwObjectRefByID(state.ID.ValueString())}
+	removeResp, err := r.getClient().PolicyWanNetworkRemoveSubPolicy(ctx, removeInput, r.client.AccountId)
+	tflog.Debug(ctx, "Delete.PolicyWanNetworkRemoveSubPolicy.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(removeResp),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Catov2 API PolicyWanNetworkRemoveSubPolicy error", err.Error())
+		return
+	}
+	removePayload := removeResp.GetPolicy().GetWanNetwork().GetRemoveSubPolicy()
+	if removePayload.GetStatus() != nil && *removePayload.GetStatus() != cato_models.PolicyMutationStatusSuccess {
+		for _, e := range removePayload.GetErrors() {
+			resp.Diagnostics.AddError(
+				"API Error Removing Sub-Policy",
+				fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)),
+			)
+		}
+		return
+	}
+
+	if err := r.publish(ctx); err != nil {
+		resp.Diagnostics.AddError("Catov2 API Delete/PolicyWanNetworkPublishPolicyRevision error", err.Error())
+		return
+	}
+}
@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/mocks"
+)
+
+func TestNewWnwSubPolicyResource(t *testing.T) {
+	if r := NewWnwSubPolicyResource(); r == nil {
+		t.Fatal("expected resource instance, got nil")
+	} else if _, ok := r.(*wnwSubPolicyResource); !ok {
+		t.Fatalf("expected *wnwSubPolicyResource, got %T", r)
+	}
+}
+
+func TestWnwSubPolicyMetadata(t *testing.T) {
+	r := &wnwSubPolicyResource{}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "cato"}, resp)
+	if resp.TypeName != "cato_wnw_sub_policy" {
+		t.Fatalf("expected cato_wnw_sub_policy, got %q", resp.TypeName)
+	}
+}
+
+func TestWnwSubPolicyImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &wnwSubPolicyResource{}
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: getWnwSubPolicySchema(ctx, t)}}
+	resp.State.Set(ctx, newWnwSubPolicyModel(""))
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "sub-123"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var imported WanNetworkSubPolicy
+	resp.State.Get(ctx, &imported)
+	if imported.ID.ValueString() != "sub-123" {
+		t.Fatalf("expected imported id sub-123, got %q", imported.ID.ValueString())
+	}
+}
+
+func TestWnwSubPolicyCreateSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().WanNetworkPolicy(mock.Anything, "account-123").
+		Return(emptyWanNetworkPolicyResponse(), nil).Once()
+	mockClient.EXPECT().PolicyWanNetworkAddSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(wnwAddSubPolicyResponse(cato_models.PolicyMutationStatusSuccess, ""), nil).Once()
+	mockClient.EXPECT().PolicyWanNetworkPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().WanNetworkPolicy(mock.Anything, "account-123").
+		Return(wnwSubPolicyResponse("sub-1", "test-sub", "a sub", "scope-1", "scope"), nil).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: getWnwSubPolicySchema(ctx, t)}}
+	r.Create(ctx, resource.CreateRequest{Plan: newWnwSubPolicyPlan(ctx, t, "")}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var state WanNetworkSubPolicy
+	resp.State.Get(ctx, &state)
+	if state.ID.ValueString() != "sub-1" {
+		t.Fatalf("expected sub id sub-1, got %q", state.ID.ValueString())
+	}
+	if state.ScopeRuleID.ValueString() != "scope-1" {
+		t.Fatalf("expected scope rule id scope-1, got %q", state.ScopeRuleID.ValueString())
+	}
+}
+
+func TestWnwSubPolicyCreateAddError(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().WanNetworkPolicy(mock.Anything, "account-123").
+		Return(emptyWanNetworkPolicyResponse(), nil).Once()
+	mockClient.EXPECT().PolicyWanNetworkAddSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(nil, assertErr("add failed")).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: getWnwSubPolicySchema(ctx, t)}}
+	r.Create(ctx, resource.CreateRequest{Plan: newWnwSubPolicyPlan(ctx, t, "")}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for add error")
+	}
+}
+
+func TestWnwSubPolicyReadSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().WanNetworkPolicy(mock.Anything, "account-123").
+		Return(wnwSubPolicyResponse("sub-1", "renamed", "desc", "scope-1", "scope-name"), nil).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	state := newWnwSubPolicyStateWithID(ctx, t)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var got WanNetworkSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.Name.ValueString() != "renamed" {
+		t.Fatalf("expected name renamed, got %q", got.Name.ValueString())
+	}
+}
+
+func TestWnwSubPolicyReadRemovesMissing(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().WanNetworkPolicy(mock.Anything, "account-123").
+		Return(emptyWanNetworkPolicyResponse(), nil).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	state := newWnwSubPolicyStateWithID(ctx, t)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected state removed when sub-policy missing")
+	}
+}
+
+func TestWnwSubPolicyUpdateRenameInPlace(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().PolicyWanNetworkUpdateRule(mock.Anything, mock.MatchedBy(
+		func(in cato_models.WanNetworkUpdateRuleInput) bool {
+			return in.ID == "scope-1" && derefStr(in.Rule.Name) == "renamed-sub" && derefStr(in.Rule.Description) == "renamed desc"
+		}), "account-123").
+		Return(wnwUpdateRuleResponse(cato_models.PolicyMutationStatusSuccess), nil).Once()
+	mockClient.EXPECT().PolicyWanNetworkPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, nil).Once()
+	mockClient.EXPECT().WanNetworkPolicy(mock.Anything, "account-123").
+		Return(wnwSubPolicyResponse("sub-1", "renamed-sub", "renamed desc", "scope-1", "renamed-sub"), nil).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getWnwSubPolicySchema(ctx, t)}}
+	r.Update(ctx, resource.UpdateRequest{Plan: newWnwSubPolicyRenamePlan(ctx, t), State: newWnwSubPolicyStateWithID(ctx, t)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+	var got WanNetworkSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" {
+		t.Fatalf("expected sub-1 renamed in place, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
+func TestWnwSubPolicyUpdatePublishErrorKeepsRename(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().PolicyWanNetworkUpdateRule(mock.Anything, mock.Anything, "account-123").
+		Return(wnwUpdateRuleResponse(cato_models.PolicyMutationStatusSuccess), nil).Once()
+	mockClient.EXPECT().PolicyWanNetworkPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, errors.New("publish failed")).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: getWnwSubPolicySchema(ctx, t)}}
+	r.Update(ctx, resource.UpdateRequest{Plan: newWnwSubPolicyRenamePlan(ctx, t), State: newWnwSubPolicyStateWithID(ctx, t)}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for the publish error")
+	}
+	var got WanNetworkSubPolicy
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "sub-1" || got.Name.ValueString() != "renamed-sub" || got.Description.ValueString() != "renamed desc" {
+		t.Fatalf("expected sub-1 tracked under its new name, got %q named %q", got.ID.ValueString(), got.Name.ValueString())
+	}
+}
+
+func TestWnwSubPolicyDeleteSuccess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().PolicyWanNetworkRemoveSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(wnwRemoveSubPolicyResponse(cato_models.PolicyMutationStatusSuccess, ""), nil).Once()
+	mockClient.EXPECT().PolicyWanNetworkPublishPolicyRevision(mock.Anything, "account-123").
+		Return(nil, nil).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: newWnwSubPolicyStateWithID(ctx, t)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", resp.Diagnostics)
+	}
+}
+
+func TestWnwSubPolicyDeleteStatusFailure(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewWanNetworkSubPolicyClient(t)
+	mockClient.EXPECT().PolicyWanNetworkRemoveSubPolicy(mock.Anything, mock.Anything, "account-123").
+		Return(wnwRemoveSubPolicyResponse(cato_models.PolicyMutationStatusFailure, "cannot"), nil).Once()
+
+	r := &wnwSubPolicyResource{client: &catoClientData{AccountId: "account-123"}, subPolyClient: mockClient}
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: newWnwSubPolicyStateWithID(ctx, t)}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected diagnostics for remove failure status")
+	}
+}
+
+// ---- helpers ----
+
+func getWnwSubPolicySchema(ctx context.Context, t *testing.T) schema.Schema {
+	t.Helper()
+	r := &wnwSubPolicyResource{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+func emptyWnwScopeObject(id string) types.Object {
+	return types.ObjectValueMust(WanNetworkRuleRuleAttrTypes, map[string]attr.Value{
+		"id":                 nullableString(id),
+		"name":               types.StringValue("test-wnw-sub-scope"),
+		"description":        types.StringNull(),
+		"enabled":            types.BoolValue(true),
+		"rule_type":          types.StringValue("WAN"),
+		"route_type":         types.StringValue("OPTIMIZED"),
+		"source":             types.ObjectNull(WanNetworkSourceAttrTypes),
+		"destination":        types.ObjectNull(WanNetworkDestAttrTypes),
+		"application":        types.ObjectNull(WanNetworkApplicationAttrTypes),
+		"configuration":      types.ObjectNull(WanNetworkConfigurationAttrTypes),
+		"bandwidth_priority": types.ObjectNull(BandwidthPriorityAttrTypes),
+		"exceptions":         types.SetNull(WanNetworkExceptionObjectType),
+	})
+}
+
+func newWnwSubPolicyModel(id string) WanNetworkSubPolicy {
+	return WanNetworkSubPolicy{
+		ID:          nullableString(id),
+		Name:        types.StringValue("test-sub"),
+		Description: types.StringValue("a sub"),
+		ScopeRuleID: types.StringNull(),
+		At: types.ObjectValueMust(PositionAttrTypes, map[string]attr.Value{
+			"position": types.StringValue("LAST_IN_POLICY"),
+			"ref":      types.StringNull(),
+		}),
+		Scope: emptyWnwScopeObject(id),
+	}
+}
+
+func newWnwSubPolicyPlan(ctx context.Context, t *testing.T, id string) tfsdk.Plan {
+	t.Helper()
+	plan := tfsdk.Plan{Schema: getWnwSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, newWnwSubPolicyModel(id)); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+	return plan
+}
+
+func newWnwSubPolicyStateWithID(ctx context.Context, t *testing.T) tfsdk.State {
+	t.Helper()
+	m := newWnwSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	state := tfsdk.State{Schema: getWnwSubPolicySchema(ctx, t)}
+	if diags := state.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %+v", diags)
+	}
+	return state
+}
+
+func emptyWanNetworkPolicyResponse() *cato_go_sdk.WanNetworkPolicy {
+	return &cato_go_sdk.WanNetworkPolicy{
+		Policy: &cato_go_sdk.WanNetworkPolicy_Policy{
+			WanNetwork: &cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork{
+				Policy: cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy{
+					Rules: []*cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_Rules{},
+				},
+			},
+		},
+	}
+}
+
+func wnwAddSubPolicyResponse(status cato_models.PolicyMutationStatus, errMsg string) *cato_go_sdk.PolicyWanNetworkAddSubPolicy {
+	var errs []*cato_go_sdk.PolicyWanNetworkAddSubPolicy_Policy_WanNetwork_AddSubPolicy_Errors
+	if errMsg != "" {
+		code := "ERR"
+		errs = append(errs, &cato_go_sdk.PolicyWanNetworkAddSubPolicy_Policy_WanNetwork_AddSubPolicy_Errors{ErrorCode: &code, ErrorMessage: &errMsg})
+	}
+	return &cato_go_sdk.PolicyWanNetworkAddSubPolicy{
+		Policy: &cato_go_sdk.PolicyWanNetworkAddSubPolicy_Policy{
+			WanNetwork: &cato_go_sdk.PolicyWanNetworkAddSubPolicy_Policy_WanNetwork{
+				AddSubPolicy: cato_go_sdk.PolicyWanNetworkAddSubPolicy_Policy_WanNetwork_AddSubPolicy{Status: status, Errors: errs},
+			},
+		},
+	}
+}
+
+func wnwRemoveSubPolicyResponse(status cato_models.PolicyMutationStatus, errMsg string) *cato_go_sdk.PolicyWanNetworkRemoveSubPolicy {
+	var errs []*cato_go_sdk.PolicyWanNetworkRemoveSubPolicy_Policy_WanNetwork_RemoveSubPolicy_Errors
+	if errMsg != "" {
+		code := "ERR"
+		errs = append(errs, &cato_go_sdk.PolicyWanNetworkRemoveSubPolicy_Policy_WanNetwork_RemoveSubPolicy_Errors{ErrorCode: &code, ErrorMessage: &errMsg})
+	}
+	return &cato_go_sdk.PolicyWanNetworkRemoveSubPolicy{
+		Policy: &cato_go_sdk.PolicyWanNetworkRemoveSubPolicy_Policy{
+			WanNetwork: &cato_go_sdk.PolicyWanNetworkRemoveSubPolicy_Policy_WanNetwork{
+				RemoveSubPolicy: cato_go_sdk.PolicyWanNetworkRemoveSubPolicy_Policy_WanNetwork_RemoveSubPolicy{Status: status, Errors: errs},
+			},
+		},
+	}
+}
+
+func wnwSubPolicyResponse(subID, subName, subDesc, scopeID, scopeName string) *cato_go_sdk.WanNetworkPolicy {
+	scopeRule := cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_Rules_Rule{
+		ID:      scopeID,
+		Name:    scopeName,
+		Enabled: true,
+	}
+	return &cato_go_sdk.WanNetworkPolicy{
+		Policy: &cato_go_sdk.WanNetworkPolicy_Policy{
+			WanNetwork: &cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork{
+				Policy: cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy{
+					SubPolicies: []*cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_SubPolicies{
+						{
+							Policy: cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_SubPolicies_Policy{
+								ID: subID, Name: subName, Description: subDesc,
+								Enabled: true, PolicyLevel: cato_models.PolicyLevelEnumSubPolicy,
+							},
+						},
+					},
+					Rules: []*cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_Rules{
+						{
+							RuleType:  cato_models.PolicyRuleTypeEnumSubPolicyScope,
+							SubPolicy: &cato_go_sdk.WanNetworkPolicy_Policy_WanNetwork_Policy_Rules_SubPolicy{ID: subID, Name: subName},
+							Rule:      scopeRule,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newWnwSubPolicyRenamePlan(ctx context.Context, t *testing.T) tfsdk.Plan {
+	t.Helper()
+	m := newWnwSubPolicyModel("sub-1")
+	m.ScopeRuleID = types.StringValue("scope-1")
+	m.Name = types.StringValue("renamed-sub")
+	m.Description = types.StringValue("renamed desc")
+	plan := tfsdk.Plan{Schema: getWnwSubPolicySchema(ctx, t)}
+	if diags := plan.Set(ctx, m); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %+v", diags)
+	}
+	return plan
+}
+
+func wnwUpdateRuleResponse(status cato_models.PolicyMutationStatus) *cato_go_sdk.PolicyWanNetworkUpdateRule {
+	return &cato_go_sdk.PolicyWanNetworkUpdateRule{
+		Policy: &cato_go_sdk.PolicyWanNetworkUpdateRule_Policy{
+			WanNetwork: &cato_go_sdk.PolicyWanNetworkUpdateRule_Policy_WanNetwork{
+				UpdateRule: cato_go_sdk.PolicyWanNetworkUpdateRule_Policy_WanNetwork_UpdateRule{Status: status},
+			},
+		},
+	}
+}
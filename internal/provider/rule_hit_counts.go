@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+const (
+	ruleHitsDefaultLookbackDays = 30
+	ruleHitsSubTypeIfw          = "Internet Firewall"
+	ruleHitsSubTypeWan          = "WAN Firewall"
+)
+
+// ruleHit is the traffic a policy rule matched within the lookback window.
+type ruleHit struct {
+	count   int64
+	lastHit time.Time
+}
+
+// fetchRuleHits aggregates the firewall events of the given sub type (as
+// shown in the Events screen, e.g. "Internet Firewall") per rule ID.
+func fetchRuleHits(ctx context.Context, client EventsClient, accountID string, eventSubType string, lookbackDays types.Int64) (map[string]ruleHit, error) {
+	days := int64(ruleHitsDefaultLookbackDays)
+	if !lookbackDays.IsNull() && !lookbackDays.IsUnknown() {
+		days = lookbackDays.ValueInt64()
+	}
+	timeFrame := fmt.Sprintf("last.P%dD", days)
+
+	measures := []*cato_models.EventsMeasure{
+		{FieldName: cato_models.EventFieldName("event_count"), AggType: cato_models.AggregationType("sum")},
+		{FieldName: cato_models.EventFieldName("time"), AggType: cato_models.AggregationType("max")},
+	}
+	dimensions := []*cato_models.EventsDimension{
+		{FieldName: cato_models.EventFieldName("rule_id")},
+	}
+	filters := []*cato_models.EventsFilter{
+		{FieldName: cato_models.EventFieldName("event_type"), Operator: cato_models.FilterOperator("is"), Values: []string{"Security"}},
+		{FieldName: cato_models.EventFieldName("event_sub_type"), Operator: cato_models.FilterOperator("is"), Values: []string{eventSubType}},
+	}
+
+	result, err := client.Events(ctx, accountID, timeFrame, measures, dimensions, filters, nil)
+	tflog.Debug(ctx, "Read.Events.response", map[string]interface{}{
+		"response": utils.InterfaceToJSONString(result),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]map[string]string, 0, len(result.GetEvents().GetRecords()))
+	for _, record := range result.GetEvents().GetRecords() {
+		fields := make(map[string]string, len(record.FieldsMap))
+		for k, v := range record.FieldsMap {
+			fields[k] = fmt.Sprint(v)
+		}
+		records = append(records, fields)
+	}
+	return ruleHitsFromRecords(records), nil
+}
+
+// ruleHitsFromRecords sums the event records per rule ID. The aggregated time
+// comes back either as epoch milliseconds or as a timestamp string.
+func ruleHitsFromRecords(records []map[string]string) map[string]ruleHit {
+	hits := make(map[string]ruleHit)
+	for _, record := range records {
+		id := record["rule_id"]
+		if id == "" {
+			continue
+		}
+		hit := hits[id]
+		if count, err := strconv.ParseInt(record["event_count"], 10, 64); err == nil {
+			hit.count += count
+		}
+		if last, ok := ruleHitTime(record["time"]); ok && last.After(hit.lastHit) {
+			hit.lastHit = last
+		}
+		hits[id] = hit
+	}
+	return hits
+}
+
+func ruleHitTime(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), true
+	}
+	t, err := parseFlexibleTimeString(value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t.UTC(), true
+}
+
+// ruleHitValues returns the hit_count and last_hit of a rule; both are null
+// when hit counts were not requested (hits is nil).
+func ruleHitValues(hits map[string]ruleHit, ruleID string) (types.Int64, types.String) {
+	if hits == nil {
+		return types.Int64Null(), types.StringNull()
+	}
+	hit := hits[ruleID]
+	if hit.lastHit.IsZero() {
+		return types.Int64Value(hit.count), types.StringNull()
+	}
+	return types.Int64Value(hit.count), types.StringValue(hit.lastHit.Format(time.RFC3339))
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cato_go_sdk "github.com/catonetworks/cato-go-sdk"
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/mocks"
+)
+
+func TestRuleHitsFromRecords(t *testing.T) {
+	t.Parallel()
+
+	hits := ruleHitsFromRecords([]map[string]string{
+		{"rule_id": "r1", "event_count": "5", "time": "1772366400000"},
+		{"rule_id": "r1", "event_count": "2", "time": "2026-03-02T08:00:00Z"},
+		{"rule_id": "r2", "event_count": "1", "time": "2026-02-01T00:00:00"},
+		{"rule_id": "r3", "event_count": "n/a"},
+		{"rule_id": "", "event_count": "9"},
+	})
+
+	require.Len(t, hits, 3)
+	require.Equal(t, ruleHit{count: 7, lastHit: time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)}, hits["r1"])
+	require.Equal(t, ruleHit{count: 1, lastHit: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)}, hits["r2"])
+	require.Equal(t, ruleHit{}, hits["r3"])
+}
+
+func TestRuleHitValues(t *testing.T) {
+	t.Parallel()
+
+	count, last := ruleHitValues(nil, "r1")
+	require.True(t, count.IsNull())
+	require.True(t, last.IsNull())
+
+	hits := map[string]ruleHit{"r1": {count: 3, lastHit: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}}
+	count, last = ruleHitValues(hits, "r1")
+	require.Equal(t, int64(3), count.ValueInt64())
+	require.Equal(t, "2026-03-01T12:00:00Z", last.ValueString())
+
+	count, last = ruleHitValues(hits, "unused")
+	require.Equal(t, int64(0), count.ValueInt64())
+	require.True(t, last.IsNull())
+}
+
+func ruleHitsEvents(records ...map[string]string) *cato_go_sdk.Events {
+	rows := make([]*cato_go_sdk.Events_Events_Records, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, &cato_go_sdk.Events_Events_Records{FieldsMap: record})
+	}
+	return &cato_go_sdk.Events{Events: &cato_go_sdk.Events_Events{Records: rows}}
+}
+
+func ruleHitsSubTypeFilter(subType string) interface{} {
+	return mock.MatchedBy(func(filters []*cato_models.EventsFilter) bool {
+		for _, f := range filters {
+			if f.FieldName == cato_models.EventFieldName("event_sub_type") {
+				return len(f.Values) == 1 && f.Values[0] == subType
+			}
+		}
+		return false
+	})
+}
+
+func TestFetchRuleHitsLookbackWindow(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewEventsClient(t)
+	client.EXPECT().
+		Events(mock.Anything, "acc-1", "last.P7D", mock.Anything, mock.Anything, ruleHitsSubTypeFilter(ruleHitsSubTypeIfw), mock.Anything).
+		Return(ruleHitsEvents(map[string]string{"rule_id": "r1", "event_count": "4", "time": "1772352000000"}), nil).
+		Once()
+	client.EXPECT().
+		Events(mock.Anything, "acc-1", "last.P30D", mock.Anything, mock.Anything, ruleHitsSubTypeFilter(ruleHitsSubTypeWan), mock.Anything).
+		Return(ruleHitsEvents(), nil).
+		Once()
+
+	hits, err := fetchRuleHits(ctx, client, "acc-1", ruleHitsSubTypeIfw, types.Int64Value(7))
+	require.NoError(t, err)
+	count, last := ruleHitValues(hits, "r1")
+	require.Equal(t, int64(4), count.ValueInt64())
+	require.Equal(t, "2026-03-01T08:00:00Z", last.ValueString())
+
+	hits, err = fetchRuleHits(ctx, client, "acc-1", ruleHitsSubTypeWan, types.Int64Null())
+	require.NoError(t, err)
+	require.NotNil(t, hits)
+	count, last = ruleHitValues(hits, "r1")
+	require.Equal(t, int64(0), count.ValueInt64())
+	require.True(t, last.IsNull())
+}
+
+func TestFetchRuleHitsRuleWithoutEvents(t *testing.T) {
+	client := mocks.NewEventsClient(t)
+	client.EXPECT().
+		Events(mock.Anything, "acc-1", "last.P30D", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(ruleHitsEvents(map[string]string{"rule_id": "r1", "event_count": "2", "time": "2026-03-01T12:00:00Z"}), nil).
+		Once()
+
+	hits, err := fetchRuleHits(context.Background(), client, "acc-1", ruleHitsSubTypeIfw, types.Int64Unknown())
+	require.NoError(t, err)
+
+	count, last := ruleHitValues(hits, "r2")
+	require.False(t, count.IsNull())
+	require.Equal(t, int64(0), count.ValueInt64())
+	require.True(t, last.IsNull())
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/validators"
+)
+
+const (
+	lifecycleWindowScheduled = "SCHEDULED"
+	lifecycleWindowActive    = "ACTIVE"
+	lifecycleWindowExpired   = "EXPIRED"
+
+	lifecycleWindowOnExpiryDisable = "DISABLE"
+	lifecycleWindowOnExpiryDelete  = "DELETE"
+)
+
+// lifecycleWindowNow is the clock used to evaluate lifecycle windows; tests
+// replace it to pin the window status.
+var lifecycleWindowNow = time.Now
+
+// lifecycleWindowBoundaryMargin is how close to a window boundary the plan
+// leaves the status unknown, for the apply to evaluate it.
+const lifecycleWindowBoundaryMargin = time.Hour
+
+// LifecycleWindow is the Terraform model of the lifecycle_window attribute
+// shared by the rule resources. Internet and WAN firewall rules send the window
+// to the API as the rule's active_period, which enforces it at the boundaries.
+// For the other rule types the window is evaluated by the provider on every
+// plan, and on apply when the plan is close to a boundary, so a rule is only
+// enabled, disabled or removed when Terraform runs.
+type LifecycleWindow struct {
+	EffectiveFrom types.String `tfsdk:"effective_from"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	OnExpiry      types.String `tfsdk:"on_expiry"`
+	Status        types.String `tfsdk:"status"`
+}
+
+var LifecycleWindowAttrTypes = map[string]attr.Type{
+	"effective_from": types.StringType,
+	"expires_at":     types.StringType,
+	"on_expiry":      types.StringType,
+	"status":         types.StringType,
+}
+
+func lifecycleWindowAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Time window during which the rule is enforced. Outside the window the rule is pushed to the policy disabled; " +
+			"once expired it is either kept disabled or removed from the policy, depending on on_expiry. The window is evaluated " +
+			"when Terraform plans, so rules change state on the first apply after a boundary is crossed. Within an hour of a " +
+			"boundary the status is only known after apply, which evaluates the window again; an apply of a plan whose status " +
+			"has changed since fails and asks for a new plan.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"effective_from": schema.StringAttribute{
+				Description: "Start of the window (2006-01-02T15:04:05Z); the rule is disabled before this time",
+				Optional:    true,
+				Validators:  []validator.String{validators.DateTimeValidator{}},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "End of the window (2006-01-02T15:04:05Z); the on_expiry action applies from this time",
+				Optional:    true,
+				Validators:  []validator.String{validators.DateTimeValidator{}},
+			},
+			"on_expiry": schema.StringAttribute{
+				Description: "What to do with the rule once expires_at has passed (DISABLE, DELETE)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(lifecycleWindowOnExpiryDisable),
+				Validators: []validator.String{
+					stringvalidator.OneOf(lifecycleWindowOnExpiryDisable, lifecycleWindowOnExpiryDelete),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Window status at the last plan, or at the last apply close to a boundary (SCHEDULED, ACTIVE, EXPIRED)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// lifecycleWindowActivePeriodAttribute is lifecycleWindowAttribute for rule
+// types whose API has a native active_period, see planLifecycleWindowActivePeriod.
+func lifecycleWindowActivePeriodAttribute() schema.SingleNestedAttribute {
+	attribute := lifecycleWindowAttribute()
+	attribute.Description = "Time window during which the rule is enforced. The window is sent to the API as the rule's active_period, " +
+		"so the rule stops matching traffic at expires_at without a Terraform run; rule.active_period cannot be set as well. " +
+		"With on_expiry DELETE the expired rule is also removed from the policy on the first apply after expires_at. " +
+		"Within an hour of a boundary the status is only known after apply, which evaluates the window again; an apply of a " +
+		"plan whose status has changed since fails and asks for a new plan."
+	attributes := make(map[string]schema.Attribute, len(attribute.Attributes))
+	for name, a := range attribute.Attributes {
+		attributes[name] = a
+	}
+	attributes["effective_from"] = schema.StringAttribute{
+		Description: "Start of the window (2006-01-02T15:04:05Z), sent as rule.active_period.effective_from",
+		Optional:    true,
+		Validators:  []validator.String{validators.DateTimeValidator{}},
+	}
+	attributes["expires_at"] = schema.StringAttribute{
+		Description: "End of the window (2006-01-02T15:04:05Z), sent as rule.active_period.expires_at; the on_expiry action applies from this time",
+		Optional:    true,
+		Validators:  []validator.String{validators.DateTimeValidator{}},
+	}
+	attributes["on_expiry"] = schema.StringAttribute{
+		Description: "What to do with the rule once expires_at has passed: DISABLE leaves it inactive in the policy, DELETE also removes it",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(lifecycleWindowOnExpiryDisable),
+		Validators: []validator.String{
+			stringvalidator.OneOf(lifecycleWindowOnExpiryDisable, lifecycleWindowOnExpiryDelete),
+		},
+	}
+	attribute.Attributes = attributes
+	return attribute
+}
+
+// lifecycleWindowStatus returns the status of the window at now. Unset bounds
+// are open-ended.
+func lifecycleWindowStatus(w LifecycleWindow, now time.Time) (string, error) {
+	if !w.ExpiresAt.IsNull() {
+		to, err := time.Parse(time.RFC3339, w.ExpiresAt.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("invalid expires_at %q: %w", w.ExpiresAt.ValueString(), err)
+		}
+		if !now.Before(to) {
+			return lifecycleWindowExpired, nil
+		}
+	}
+	if !w.EffectiveFrom.IsNull() {
+		from, err := time.Parse(time.RFC3339, w.EffectiveFrom.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("invalid effective_from %q: %w", w.EffectiveFrom.ValueString(), err)
+		}
+		if now.Before(from) {
+			return lifecycleWindowScheduled, nil
+		}
+	}
+	return lifecycleWindowActive, nil
+}
+
+// lifecycleWindowNearBoundary reports whether now is within
+// lifecycleWindowBoundaryMargin of a bound of the window. The bounds are
+// checked by lifecycleWindowStatus.
+func lifecycleWindowNearBoundary(w LifecycleWindow, now time.Time) bool {
+	for _, bound := range []types.String{w.EffectiveFrom, w.ExpiresAt} {
+		if bound.IsNull() {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, bound.ValueString())
+		if err == nil && now.Sub(at).Abs() < lifecycleWindowBoundaryMargin {
+			return true
+		}
+	}
+	return false
+}
+
+func lifecycleWindowFromObject(ctx context.Context, obj types.Object) (LifecycleWindow, bool, diag.Diagnostics) {
+	var w LifecycleWindow
+	if obj.IsNull() || obj.IsUnknown() {
+		return w, false, nil
+	}
+	diags := obj.As(ctx, &w, basetypes.ObjectAsOptions{})
+	return w, !diags.HasError(), diags
+}
+
+// lifecycleWindowTombstoned reports whether the window removed the rule from
+// the policy; the resource then stays in state without an API object.
+func lifecycleWindowTombstoned(ctx context.Context, obj types.Object) bool {
+	w, ok, _ := lifecycleWindowFromObject(ctx, obj)
+	return ok && w.Status.ValueString() == lifecycleWindowExpired && w.OnExpiry.ValueString() == lifecycleWindowOnExpiryDelete
+}
+
+// lifecycleWindowEnforced reports whether the window keeps the rule out of
+// effect, in which case the rule is sent to the API disabled.
+func lifecycleWindowEnforced(ctx context.Context, obj types.Object) bool {
+	w, ok, _ := lifecycleWindowFromObject(ctx, obj)
+	return ok && !w.Status.IsNull() && !w.Status.IsUnknown() && w.Status.ValueString() != lifecycleWindowActive
+}
+
+// lifecycleWindowRule returns rule with enabled forced to false while the
+// window is not active. rule is the "rule" object of a rule resource.
+func lifecycleWindowRule(ctx context.Context, rule, window types.Object) (types.Object, diag.Diagnostics) {
+	if !lifecycleWindowEnforced(ctx, window) || rule.IsNull() || rule.IsUnknown() {
+		return rule, nil
+	}
+	attrs := make(map[string]attr.Value, len(rule.Attributes()))
+	for k, v := range rule.Attributes() {
+		attrs[k] = v
+	}
+	attrs["enabled"] = types.BoolValue(false)
+	return types.ObjectValue(rule.AttributeTypes(ctx), attrs)
+}
+
+// keepLifecycleWindowEnabled writes the configured rule.enabled back to state
+// after the API returned the window-disabled value, so the override does not
+// show up as drift.
+func keepLifecycleWindowEnabled(ctx context.Context, window types.Object, enabled types.Bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	if !lifecycleWindowEnforced(ctx, window) || enabled.IsNull() || enabled.IsUnknown() {
+		return
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("rule").AtName("enabled"), enabled)...)
+}
+
+// ruleEnabled returns rule.enabled of a rule resource model's "rule" object.
+func ruleEnabled(rule types.Object) types.Bool {
+	if rule.IsNull() || rule.IsUnknown() {
+		return types.BoolNull()
+	}
+	if v, ok := rule.Attributes()["enabled"].(types.Bool); ok {
+		return v
+	}
+	return types.BoolNull()
+}
+
+// setLifecycleWindowTombstone stores the plan as state for a rule the window
+// removed from the policy. Values only the API could compute are left null.
+func setLifecycleWindowTombstone(plan tfsdk.Plan, state *tfsdk.State, diags *diag.Diagnostics) {
+	raw, err := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("Unable to store expired rule", err.Error())
+		return
+	}
+	state.Raw = raw
+	diags.AddWarning("Rule removed by lifecycle_window",
+		"The rule has expired and lifecycle_window.on_expiry is DELETE; it was removed from the policy and is kept in state only. "+
+			"Remove the resource from the configuration, or move expires_at into the future to recreate the rule.")
+}
+
+// modifyLifecycleWindowPlan evaluates lifecycle_window against the current
+// time, plans its status and warns about rules that are already expired. The
+// status is left unknown close to a boundary, see resolveLifecycleWindow. A
+// tombstoned rule whose window is reopened is replaced so it is created again.
+func modifyLifecycleWindowPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var prior types.Object
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifecycle_window"), &prior)...)
+	}
+	tombstoned := lifecycleWindowTombstoned(ctx, prior)
+
+	var obj types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("lifecycle_window"), &obj)...)
+	w, ok, diags := lifecycleWindowFromObject(ctx, obj)
+	resp.Diagnostics.Append(diags...)
+	if !ok || w.EffectiveFrom.IsUnknown() || w.ExpiresAt.IsUnknown() || w.OnExpiry.IsUnknown() {
+		if tombstoned && obj.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("lifecycle_window"))
+		}
+		return
+	}
+
+	if !w.EffectiveFrom.IsNull() && !w.ExpiresAt.IsNull() && w.EffectiveFrom.ValueString() >= w.ExpiresAt.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("lifecycle_window").AtName("expires_at"), "Invalid lifecycle_window",
+			fmt.Sprintf("expires_at (%s) must be later than effective_from (%s)", w.ExpiresAt.ValueString(), w.EffectiveFrom.ValueString()))
+		return
+	}
+	status, err := lifecycleWindowStatus(w, lifecycleWindowNow().UTC())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("lifecycle_window"), "Invalid lifecycle_window", err.Error())
+		return
+	}
+	statusPath := path.Root("lifecycle_window").AtName("status")
+	if !tombstoned && lifecycleWindowNearBoundary(w, lifecycleWindowNow().UTC()) {
+		// A saved plan may be applied on the other side of the boundary.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, statusPath, types.StringUnknown())...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, statusPath, types.StringValue(status))...)
+
+	if status == lifecycleWindowExpired {
+		action := "pushed to the policy disabled"
+		if w.OnExpiry.ValueString() == lifecycleWindowOnExpiryDelete {
+			action = "removed from the policy"
+		}
+		resp.Diagnostics.AddAttributeWarning(path.Root("lifecycle_window").AtName("expires_at"), "Rule has expired",
+			fmt.Sprintf("lifecycle_window.expires_at (%s) is in the past; the rule will be %s.", w.ExpiresAt.ValueString(), action))
+	}
+
+	if tombstoned &&
+		(status != lifecycleWindowExpired || w.OnExpiry.ValueString() != lifecycleWindowOnExpiryDelete) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("lifecycle_window"))
+	}
+}
+
+// planLifecycleWindowActivePeriod plans rule.active_period from the configured
+// lifecycle_window, for rule types whose API enforces the window natively. The
+// rule is then never disabled by the provider.
+func planLifecycleWindowActivePeriod(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var window types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lifecycle_window"), &window)...)
+	if resp.Diagnostics.HasError() || window.IsNull() {
+		return
+	}
+	activePeriodPath := path.Root("rule").AtName("active_period")
+	var configured types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, activePeriodPath, &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configured.IsNull() {
+		resp.Diagnostics.AddAttributeError(activePeriodPath, "Conflicting rule window",
+			"lifecycle_window is sent to the API as rule.active_period; set only one of them.")
+		return
+	}
+
+	activePeriod := types.ObjectUnknown(ActivePeriodAttrTypes)
+	if !window.IsUnknown() {
+		var w LifecycleWindow
+		resp.Diagnostics.Append(window.As(ctx, &w, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var diags diag.Diagnostics
+		activePeriod, diags = types.ObjectValue(ActivePeriodAttrTypes, map[string]attr.Value{
+			"effective_from":     w.EffectiveFrom,
+			"expires_at":         w.ExpiresAt,
+			"use_effective_from": lifecycleWindowBoundSet(w.EffectiveFrom),
+			"use_expires_at":     lifecycleWindowBoundSet(w.ExpiresAt),
+		})
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, activePeriodPath, activePeriod)...)
+}
+
+// lifecycleWindowBoundSet returns the active_period use_* flag of a bound.
+func lifecycleWindowBoundSet(bound types.String) types.Bool {
+	if bound.IsUnknown() {
+		return types.BoolUnknown()
+	}
+	return types.BoolValue(!bound.IsNull())
+}
+
+// resolveLifecycleWindow evaluates lifecycle_window again at apply time. A
+// status the plan left unknown is set in both the plan and window; a planned
+// status the window no longer has fails the apply, as the plan is stale.
+func resolveLifecycleWindow(ctx context.Context, plan *tfsdk.Plan, window *types.Object, diags *diag.Diagnostics) {
+	w, ok, d := lifecycleWindowFromObject(ctx, *window)
+	diags.Append(d...)
+	if !ok || w.EffectiveFrom.IsUnknown() || w.ExpiresAt.IsUnknown() {
+		return
+	}
+	status, err := lifecycleWindowStatus(w, lifecycleWindowNow().UTC())
+	if err != nil {
+		diags.AddAttributeError(path.Root("lifecycle_window"), "Invalid lifecycle_window", err.Error())
+		return
+	}
+	if !w.Status.IsUnknown() {
+		if !w.Status.IsNull() && w.Status.ValueString() != status {
+			diags.AddAttributeError(path.Root("lifecycle_window").AtName("status"), "Stale lifecycle_window plan",
+				fmt.Sprintf("The lifecycle_window status went from %s to %s since the plan was made; plan again.", w.Status.ValueString(), status))
+		}
+		return
+	}
+
+	w.Status = types.StringValue(status)
+	resolved, d := types.ObjectValueFrom(ctx, LifecycleWindowAttrTypes, w)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	*window = resolved
+	diags.Append(plan.SetAttribute(ctx, path.Root("lifecycle_window").AtName("status"), w.Status)...)
+}
+
+// removeLifecycleWindowExpiredRule removes a rule whose window expired with
+// on_expiry DELETE through the resource's own Delete, then stores the tombstone.
+func removeLifecycleWindowExpiredRule(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+	remove func(context.Context, resource.DeleteRequest, *resource.DeleteResponse),
+) {
+	var prior types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifecycle_window"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !lifecycleWindowTombstoned(ctx, prior) {
+		del := resource.DeleteResponse{State: resp.State}
+		remove(ctx, resource.DeleteRequest{State: req.State, ProviderMeta: req.ProviderMeta}, &del)
+		resp.Diagnostics.Append(del.Diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	setLifecycleWindowTombstone(req.Plan, &resp.State, &resp.Diagnostics)
+}
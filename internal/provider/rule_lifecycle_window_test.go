@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/require"
+)
+
+var lifecycleWindowTestRuleAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"enabled": types.BoolType,
+}
+
+type lifecycleWindowTestModel struct {
+	Rule            types.Object `tfsdk:"rule"`
+	LifecycleWindow types.Object `tfsdk:"lifecycle_window"`
+}
+
+func lifecycleWindowTestSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rule": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"id":      schema.StringAttribute{Computed: true},
+					"enabled": schema.BoolAttribute{Required: true},
+				},
+			},
+			"lifecycle_window": lifecycleWindowAttribute(),
+		},
+	}
+}
+
+func pinLifecycleWindowNow(t *testing.T, now string) {
+	t.Helper()
+	ts, err := time.Parse(time.RFC3339, now)
+	require.NoError(t, err)
+	prev := lifecycleWindowNow
+	lifecycleWindowNow = func() time.Time { return ts }
+	t.Cleanup(func() { lifecycleWindowNow = prev })
+}
+
+func newLifecycleWindow(from, to, onExpiry, status string) types.Object {
+	str := func(v string) types.String {
+		if v == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+	return types.ObjectValueMust(LifecycleWindowAttrTypes, map[string]attr.Value{
+		"effective_from": str(from),
+		"expires_at":     str(to),
+		"on_expiry":      str(onExpiry),
+		"status":         str(status),
+	})
+}
+
+func newLifecycleWindowTestRule(id types.String, enabled bool) types.Object {
+	return types.ObjectValueMust(lifecycleWindowTestRuleAttrTypes, map[string]attr.Value{
+		"id":      id,
+		"enabled": types.BoolValue(enabled),
+	})
+}
+
+func lifecycleWindowModifyPlan(t *testing.T, prior *lifecycleWindowTestModel, planned lifecycleWindowTestModel) *resource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+	s := lifecycleWindowTestSchema()
+
+	plan := tfsdk.Plan{Schema: s}
+	require.False(t, plan.Set(ctx, planned).HasError())
+	state := tfsdk.State{Schema: s}
+	if prior != nil {
+		require.False(t, state.Set(ctx, *prior).HasError())
+	} else {
+		state.Raw = plan.Raw.Copy()
+		state.RemoveResource(ctx)
+	}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	modifyLifecycleWindowPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	return resp
+}
+
+func plannedLifecycleWindowStatus(t *testing.T, resp *resource.ModifyPlanResponse) string {
+	t.Helper()
+	var status types.String
+	require.False(t, resp.Plan.GetAttribute(context.Background(), path.Root("lifecycle_window").AtName("status"), &status).HasError())
+	return status.ValueString()
+}
+
+func TestLifecycleWindowStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{name: "open", want: lifecycleWindowActive},
+		{name: "before start", from: "2026-03-02T00:00:00Z", want: lifecycleWindowScheduled},
+		{name: "inside", from: "2026-02-01T00:00:00Z", to: "2026-04-01T00:00:00Z", want: lifecycleWindowActive},
+		{name: "at expiry", to: "2026-03-01T12:00:00Z", want: lifecycleWindowExpired},
+		{name: "after expiry", from: "2026-01-01T00:00:00Z", to: "2026-02-01T00:00:00Z", want: lifecycleWindowExpired},
+	}
+	for _, tc := range cases {
+		var w LifecycleWindow
+		require.False(t, newLifecycleWindow(tc.from, tc.to, lifecycleWindowOnExpiryDisable, "").
+			As(context.Background(), &w, basetypes.ObjectAsOptions{}).HasError(), tc.name)
+		got, err := lifecycleWindowStatus(w, now)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.want, got, tc.name)
+	}
+}
+
+func TestModifyLifecycleWindowPlanSetsStatus(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-01T12:00:00Z")
+
+	resp := lifecycleWindowModifyPlan(t, nil, lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringUnknown(), true),
+		LifecycleWindow: newLifecycleWindow("2026-03-10T00:00:00Z", "2026-04-01T00:00:00Z", lifecycleWindowOnExpiryDisable, ""),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Equal(t, 0, resp.Diagnostics.WarningsCount())
+	require.Equal(t, lifecycleWindowScheduled, plannedLifecycleWindowStatus(t, resp))
+}
+
+func TestModifyLifecycleWindowPlanWarnsWhenExpired(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-01T12:00:00Z")
+
+	resp := lifecycleWindowModifyPlan(t, nil, lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringUnknown(), true),
+		LifecycleWindow: newLifecycleWindow("", "2026-02-01T00:00:00Z", lifecycleWindowOnExpiryDelete, ""),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	require.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "removed from the policy")
+	require.Equal(t, lifecycleWindowExpired, plannedLifecycleWindowStatus(t, resp))
+}
+
+func TestModifyLifecycleWindowPlanRejectsInvertedWindow(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-01T12:00:00Z")
+
+	resp := lifecycleWindowModifyPlan(t, nil, lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringUnknown(), true),
+		LifecycleWindow: newLifecycleWindow("2026-04-01T00:00:00Z", "2026-03-10T00:00:00Z", lifecycleWindowOnExpiryDisable, ""),
+	})
+	require.True(t, resp.Diagnostics.HasError())
+}
+
+func TestModifyLifecycleWindowPlanReplacesReopenedTombstone(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-01T12:00:00Z")
+
+	prior := lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringValue("rule-1"), true),
+		LifecycleWindow: newLifecycleWindow("", "2026-02-01T00:00:00Z", lifecycleWindowOnExpiryDelete, lifecycleWindowExpired),
+	}
+
+	resp := lifecycleWindowModifyPlan(t, &prior, lifecycleWindowTestModel{
+		Rule:            prior.Rule,
+		LifecycleWindow: newLifecycleWindow("", "2026-05-01T00:00:00Z", lifecycleWindowOnExpiryDelete, ""),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Equal(t, path.Paths{path.Root("lifecycle_window")}, resp.RequiresReplace)
+
+	resp = lifecycleWindowModifyPlan(t, &prior, lifecycleWindowTestModel{
+		Rule:            prior.Rule,
+		LifecycleWindow: newLifecycleWindow("", "2026-02-01T00:00:00Z", lifecycleWindowOnExpiryDelete, ""),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Empty(t, resp.RequiresReplace)
+}
+
+func TestModifyLifecycleWindowPlanLeavesStatusUnknownNearBoundary(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-01T11:30:00Z")
+
+	resp := lifecycleWindowModifyPlan(t, nil, lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringUnknown(), true),
+		LifecycleWindow: newLifecycleWindow("", "2026-03-01T12:00:00Z", lifecycleWindowOnExpiryDisable, ""),
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	var status types.String
+	require.False(t, resp.Plan.GetAttribute(context.Background(), path.Root("lifecycle_window").AtName("status"), &status).HasError())
+	require.True(t, status.IsUnknown())
+}
+
+func lifecycleWindowTestPlan(t *testing.T, window types.Object) tfsdk.Plan {
+	t.Helper()
+	plan := tfsdk.Plan{Schema: lifecycleWindowTestSchema()}
+	require.False(t, plan.Set(context.Background(), lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringUnknown(), true),
+		LifecycleWindow: window,
+	}).HasError())
+	return plan
+}
+
+func TestResolveLifecycleWindowSetsUnknownStatus(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-01T12:10:00Z")
+	ctx := context.Background()
+
+	window := types.ObjectValueMust(LifecycleWindowAttrTypes, map[string]attr.Value{
+		"effective_from": types.StringNull(),
+		"expires_at":     types.StringValue("2026-03-01T12:00:00Z"),
+		"on_expiry":      types.StringValue(lifecycleWindowOnExpiryDisable),
+		"status":         types.StringUnknown(),
+	})
+	plan := lifecycleWindowTestPlan(t, window)
+
+	var diags diag.Diagnostics
+	resolveLifecycleWindow(ctx, &plan, &window, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.True(t, lifecycleWindowEnforced(ctx, window))
+
+	var status types.String
+	require.False(t, plan.GetAttribute(ctx, path.Root("lifecycle_window").AtName("status"), &status).HasError())
+	require.Equal(t, lifecycleWindowExpired, status.ValueString())
+}
+
+func TestResolveLifecycleWindowRejectsStalePlan(t *testing.T) {
+	pinLifecycleWindowNow(t, "2026-03-10T00:00:00Z")
+
+	window := newLifecycleWindow("", "2026-03-01T12:00:00Z", lifecycleWindowOnExpiryDelete, lifecycleWindowActive)
+	plan := lifecycleWindowTestPlan(t, window)
+
+	var diags diag.Diagnostics
+	resolveLifecycleWindow(context.Background(), &plan, &window, &diags)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags.Errors()[0].Detail(), "from ACTIVE to EXPIRED")
+}
+
+func TestLifecycleWindowRuleDisablesOutsideWindow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	rule := newLifecycleWindowTestRule(types.StringValue("rule-1"), true)
+
+	got, diags := lifecycleWindowRule(ctx, rule, newLifecycleWindow("", "", lifecycleWindowOnExpiryDisable, lifecycleWindowActive))
+	require.False(t, diags.HasError())
+	require.True(t, ruleEnabled(got).ValueBool())
+
+	got, diags = lifecycleWindowRule(ctx, rule, newLifecycleWindow("2026-04-01T00:00:00Z", "", lifecycleWindowOnExpiryDisable, lifecycleWindowScheduled))
+	require.False(t, diags.HasError())
+	require.False(t, ruleEnabled(got).ValueBool())
+	require.Equal(t, "rule-1", got.Attributes()["id"].(types.String).ValueString())
+
+	got, diags = lifecycleWindowRule(ctx, rule, types.ObjectNull(LifecycleWindowAttrTypes))
+	require.False(t, diags.HasError())
+	require.True(t, ruleEnabled(got).ValueBool())
+}
+
+func TestKeepLifecycleWindowEnabledRestoresConfiguredValue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	window := newLifecycleWindow("", "2026-02-01T00:00:00Z", lifecycleWindowOnExpiryDisable, lifecycleWindowExpired)
+	state := tfsdk.State{Schema: lifecycleWindowTestSchema()}
+	diags := state.Set(ctx, lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringValue("rule-1"), false),
+		LifecycleWindow: window,
+	})
+	keepLifecycleWindowEnabled(ctx, window, types.BoolValue(true), &state, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var got lifecycleWindowTestModel
+	require.False(t, state.Get(ctx, &got).HasError())
+	require.True(t, ruleEnabled(got.Rule).ValueBool())
+}
+
+func TestSetLifecycleWindowTombstoneNullsUnknowns(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	s := lifecycleWindowTestSchema()
+	plan := tfsdk.Plan{Schema: s}
+	require.False(t, plan.Set(ctx, lifecycleWindowTestModel{
+		Rule:            newLifecycleWindowTestRule(types.StringUnknown(), true),
+		LifecycleWindow: newLifecycleWindow("", "2026-02-01T00:00:00Z", lifecycleWindowOnExpiryDelete, lifecycleWindowExpired),
+	}).HasError())
+
+	state := tfsdk.State{Schema: s}
+	diags := state.Set(ctx, lifecycleWindowTestModel{
+		Rule:            types.ObjectNull(lifecycleWindowTestRuleAttrTypes),
+		LifecycleWindow: types.ObjectNull(LifecycleWindowAttrTypes),
+	})
+	setLifecycleWindowTombstone(plan, &state, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, 1, diags.WarningsCount())
+
+	var got lifecycleWindowTestModel
+	require.False(t, state.Get(ctx, &got).HasError())
+	require.True(t, got.Rule.Attributes()["id"].IsNull())
+	require.True(t, lifecycleWindowTombstoned(ctx, got.LifecycleWindow))
+}
+
+var lifecycleWindowActivePeriodTestRuleAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"active_period": types.ObjectType{AttrTypes: ActivePeriodAttrTypes},
+}
+
+func lifecycleWindowActivePeriodTestSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rule": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Computed: true},
+					"active_period": schema.SingleNestedAttribute{
+						Optional: true,
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"effective_from":     schema.StringAttribute{Optional: true, Computed: true},
+							"expires_at":         schema.StringAttribute{Optional: true, Computed: true},
+							"use_effective_from": schema.BoolAttribute{Computed: true},
+							"use_expires_at":     schema.BoolAttribute{Computed: true},
+						},
+					},
+				},
+			},
+			"lifecycle_window": lifecycleWindowActivePeriodAttribute(),
+		},
+	}
+}
+
+func planLifecycleWindowActivePeriodTest(t *testing.T, activePeriod, window types.Object) *resource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+	s := lifecycleWindowActivePeriodTestSchema()
+
+	plan := tfsdk.Plan{Schema: s}
+	require.False(t, plan.Set(ctx, lifecycleWindowTestModel{
+		Rule: types.ObjectValueMust(lifecycleWindowActivePeriodTestRuleAttrTypes, map[string]attr.Value{
+			"id":            types.StringNull(),
+			"active_period": activePeriod,
+		}),
+		LifecycleWindow: window,
+	}).HasError())
+	config := tfsdk.Config{Schema: s, Raw: plan.Raw.Copy()}
+	state := tfsdk.State{Schema: s, Raw: plan.Raw.Copy()}
+	state.RemoveResource(ctx)
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	planLifecycleWindowActivePeriod(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, resp)
+	return resp
+}
+
+func TestPlanLifecycleWindowActivePeriod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resp := planLifecycleWindowActivePeriodTest(t, types.ObjectNull(ActivePeriodAttrTypes),
+		newLifecycleWindow("", "2026-04-01T00:00:00Z", lifecycleWindowOnExpiryDisable, ""))
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var activePeriod types.Object
+	require.False(t, resp.Plan.GetAttribute(ctx, path.Root("rule").AtName("active_period"), &activePeriod).HasError())
+	require.Equal(t, types.ObjectValueMust(ActivePeriodAttrTypes, map[string]attr.Value{
+		"effective_from":     types.StringNull(),
+		"expires_at":         types.StringValue("2026-04-01T00:00:00Z"),
+		"use_effective_from": types.BoolValue(false),
+		"use_expires_at":     types.BoolValue(true),
+	}), activePeriod)
+}
+
+func TestPlanLifecycleWindowActivePeriodRejectsBoth(t *testing.T) {
+	t.Parallel()
+
+	resp := planLifecycleWindowActivePeriodTest(t,
+		types.ObjectValueMust(ActivePeriodAttrTypes, map[string]attr.Value{
+			"effective_from":     types.StringNull(),
+			"expires_at":         types.StringValue("2026-05-01T00:00:00Z"),
+			"use_effective_from": types.BoolNull(),
+			"use_expires_at":     types.BoolNull(),
+		}),
+		newLifecycleWindow("", "2026-04-01T00:00:00Z", lifecycleWindowOnExpiryDisable, ""))
+	require.True(t, resp.Diagnostics.HasError())
+}
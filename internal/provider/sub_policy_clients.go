@@ -9,8 +9,9 @@ import (
 )
 
 // InternetFirewallSubPolicyClient is the narrow SDK surface used by the
-// cato_if_sub_policy resource. It exists so the resource can be unit tested with
-// generated mocks.
+// cato_if_sub_policy resource, including the rule mutations used to sync child
+// rules from a cato_sub_policy_template. It exists so the resource can be unit
+// tested with generated mocks.
 type InternetFirewallSubPolicyClient interface {
 	PolicyInternetFirewall(
 		ctx context.Context,
@@ -46,10 +47,37 @@ type InternetFirewallSubPolicyClient interface {
 		accountID string,
 		interceptors ...clientv2.RequestInterceptor,
 	) (*cato_go_sdk.PolicyInternetFirewallPublishPolicyRevision, error)
+	PolicyInternetFirewallAddRule(
+		ctx context.Context,
+		internetFirewallAddRuleInput cato_models.InternetFirewallAddRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallAddRule, error)
+	PolicyInternetFirewallMoveRule(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		policyMoveRuleInput cato_models.PolicyMoveRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallMoveRule, error)
+	PolicyInternetFirewallRemoveRule(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		internetFirewallRemoveRuleInput cato_models.InternetFirewallRemoveRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallRemoveRule, error)
+	PolicyInternetFirewallDiscardPolicyRevision(
+		ctx context.Context,
+		internetFirewallPolicyMutationInput *cato_models.InternetFirewallPolicyMutationInput,
+		policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyInternetFirewallDiscardPolicyRevision, error)
 }
 
 // WanFirewallSubPolicyClient is the narrow SDK surface used by the
-// cato_wf_sub_policy resource.
+// cato_wf_sub_policy resource, including template child rule sync.
 type WanFirewallSubPolicyClient interface {
 	PolicyWanFirewall(
 		ctx context.Context,
@@ -81,6 +109,30 @@ type WanFirewallSubPolicyClient interface {
 		accountID string,
 		interceptors ...clientv2.RequestInterceptor,
 	) (*cato_go_sdk.PolicyWanFirewallPublishPolicyRevision, error)
+	PolicyWanFirewallAddRule(
+		ctx context.Context,
+		wanFirewallAddRuleInput cato_models.WanFirewallAddRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallAddRule, error)
+	PolicyWanFirewallMoveRule(
+		ctx context.Context,
+		policyMoveRuleInput cato_models.PolicyMoveRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallMoveRule, error)
+	PolicyWanFirewallRemoveRule(
+		ctx context.Context,
+		wanFirewallRemoveRuleInput cato_models.WanFirewallRemoveRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallRemoveRule, error)
+	PolicyWanFirewallDiscardPolicyRevision(
+		ctx context.Context,
+		policyDiscardRevisionInput *cato_models.PolicyDiscardRevisionInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanFirewallDiscardPolicyRevision, error)
 }
 
 // SocketLanSubPolicyClient is the narrow SDK surface used by the
@@ -126,3 +178,69 @@ type SocketLanSubPolicyClient interface {
 		interceptors ...clientv2.RequestInterceptor,
 	) (*cato_go_sdk.PolicySocketLanPublishPolicyRevision, error)
 }
+
+// TLSInspectSubPolicyClient is the narrow SDK surface used by the
+// cato_tls_sub_policy resource.
+type TLSInspectSubPolicyClient interface {
+	Tlsinspectpolicy(
+		ctx context.Context,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.Tlsinspectpolicy, error)
+	PolicyTLSInspectAddSubPolicy(
+		ctx context.Context,
+		tlsInspectAddSubPolicyInput cato_models.TLSInspectAddSubPolicyInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyTLSInspectAddSubPolicy, error)
+	PolicyTLSInspectRemoveSubPolicy(
+		ctx context.Context,
+		tlsInspectRemoveSubPolicyInput cato_models.TLSInspectRemoveSubPolicyInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyTLSInspectRemoveSubPolicy, error)
+	PolicyTLSInspectUpdateRule(
+		ctx context.Context,
+		tlsInspectUpdateRuleInput cato_models.TLSInspectUpdateRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyTLSInspectUpdateRule, error)
+	PolicyTLSInspectPublishPolicyRevision(
+		ctx context.Context,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyTLSInspectPublishPolicyRevision, error)
+}
+
+// WanNetworkSubPolicyClient is the narrow SDK surface used by the
+// cato_wnw_sub_policy resource.
+type WanNetworkSubPolicyClient interface {
+	WanNetworkPolicy(
+		ctx context.Context,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.WanNetworkPolicy, error)
+	PolicyWanNetworkAddSubPolicy(
+		ctx context.Context,
+		wanNetworkAddSubPolicyInput cato_models.WanNetworkAddSubPolicyInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanNetworkAddSubPolicy, error)
+	PolicyWanNetworkRemoveSubPolicy(
+		ctx context.Context,
+		wanNetworkRemoveSubPolicyInput cato_models.WanNetworkRemoveSubPolicyInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanNetworkRemoveSubPolicy, error)
+	PolicyWanNetworkUpdateRule(
+		ctx context.Context,
+		wanNetworkUpdateRuleInput cato_models.WanNetworkUpdateRuleInput,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanNetworkUpdateRule, error)
+	PolicyWanNetworkPublishPolicyRevision(
+		ctx context.Context,
+		accountID string,
+		interceptors ...clientv2.RequestInterceptor,
+	) (*cato_go_sdk.PolicyWanNetworkPublishPolicyRevision, error)
+}
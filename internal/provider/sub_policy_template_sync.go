@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// decodeSubPolicyTemplateRef reads a sub-policy template attribute and checks
+// that it was built for the given policy type. ok is false when no template is
+// set.
+func decodeSubPolicyTemplateRef(ctx context.Context, template types.Object, policyType string) (ref SubPolicyTemplateRef, ok bool, diags diag.Diagnostics) {
+	if template.IsNull() || template.IsUnknown() {
+		return ref, false, diags
+	}
+	diags.Append(template.As(ctx, &ref, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return ref, false, diags
+	}
+	if ref.PolicyType.ValueString() != policyType {
+		diags.AddError(
+			"Invalid Sub-Policy Template",
+			fmt.Sprintf("Template %s holds %s rules and cannot be instantiated in a %s sub-policy.",
+				ref.ID.ValueString(), ref.PolicyType.ValueString(), policyType),
+		)
+		return ref, false, diags
+	}
+	return ref, true, diags
+}
+
+// syncIfwSubPolicyTemplate reconciles the child rules of an Internet Firewall
+// sub-policy with a cato_sub_policy_template. Rules are matched by name:
+// template rules missing from the sub-policy are added in front of its cleanup
+// rule, matching rules are updated and any other child rule is removed. When
+// the resulting order differs from the template, every rule is moved in
+// template order in front of the cleanup rule. The caller publishes, so all
+// changes land in one revision; changed reports whether anything was sent.
+//
+//nolint:funlen
+func syncIfwSubPolicyTemplate(ctx context.Context, client InternetFirewallSubPolicyClient, accountID, subID string, template types.Object) (bool, diag.Diagnostics) {
+	ref, ok, diags := decodeSubPolicyTemplateRef(ctx, template, subPolicyTemplateTypeIfw)
+	if !ok {
+		return false, diags
+	}
+	var rules []ifwTemplateRule
+	if err := json.Unmarshal([]byte(ref.Rules.ValueString()), &rules); err != nil {
+		diags.AddError("Invalid Sub-Policy Template", err.Error())
+		return false, diags
+	}
+
+	body, err := client.PolicyInternetFirewall(ctx, &cato_models.InternetFirewallPolicyInput{}, accountID)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyInternetFirewall error", err.Error())
+		return false, diags
+	}
+	cleanupID := ifwSubPolicyCleanupRuleID(body, subID)
+	if cleanupID == "" {
+		diags.AddError("Cleanup Rule Not Found", fmt.Sprintf("No cleanup rule found for sub-policy %s.", subID))
+		return false, diags
+	}
+	children := ifwSubPolicyChildRules(body, subID)
+	existing := make(map[string]string, len(children))
+	for _, c := range children {
+		existing[c.name] = c.id
+	}
+
+	mutation := &cato_models.InternetFirewallPolicyMutationInput{}
+	changed := false
+	wanted := make(map[string]struct{}, len(rules))
+	desired := make([]string, 0, len(rules))
+	var added []string
+	for _, rule := range rules {
+		wanted[rule.Name] = struct{}{}
+		if id, found := existing[rule.Name]; found {
+			updateResp, err := client.PolicyInternetFirewallUpdateRule(ctx, mutation, cato_models.InternetFirewallUpdateRuleInput{ID: id, Rule: rule.Update}, accountID)
+			if err != nil {
+				diags.AddError("Catov2 API PolicyInternetFirewallUpdateRule error", err.Error())
+				return changed, diags
+			}
+			changed = true
+			if updateResp.Policy.InternetFirewall.UpdateRule.Status != ifwMutationStatusSuccess {
+				for _, e := range updateResp.Policy.InternetFirewall.UpdateRule.GetErrors() {
+					diags.AddError("API Error Updating Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+				}
+				return changed, diags
+			}
+			desired = append(desired, id)
+			continue
+		}
+
+		beforeRule := cato_models.PolicyRulePositionEnumBeforeRule
+		addInput := cato_models.InternetFirewallAddRuleInput{
+			At:   &cato_models.PolicyRulePositionInput{Position: &beforeRule, Ref: &cleanupID},
+			Rule: rule.Add,
+		}
+		addResp, err := client.PolicyInternetFirewallAddRule(ctx, addInput, accountID)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyInternetFirewallAddRule error", err.Error())
+			return changed, diags
+		}
+		changed = true
+		if addResp.Policy.InternetFirewall.AddRule.Status != ifwMutationStatusSuccess {
+			for _, e := range addResp.Policy.InternetFirewall.AddRule.GetErrors() {
+				diags.AddError("API Error Adding Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+			}
+			return changed, diags
+		}
+		id := addResp.GetPolicy().GetInternetFirewall().GetAddRule().Rule.GetRule().ID
+		desired = append(desired, id)
+		added = append(added, id)
+	}
+
+	var kept []string
+	for _, c := range children {
+		if _, found := wanted[c.name]; found {
+			kept = append(kept, c.id)
+			continue
+		}
+		removeResp, err := client.PolicyInternetFirewallRemoveRule(ctx, mutation, cato_models.InternetFirewallRemoveRuleInput{ID: c.id}, accountID)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyInternetFirewallRemoveRule error", err.Error())
+			return changed, diags
+		}
+		changed = true
+		if removeResp.Policy.InternetFirewall.RemoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range removeResp.Policy.InternetFirewall.RemoveRule.GetErrors() {
+				diags.AddError("API Error Removing Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+			}
+			return changed, diags
+		}
+	}
+
+	// Added rules land in front of the cleanup rule, after the kept ones.
+	if slices.Equal(append(kept, added...), desired) {
+		return changed, diags
+	}
+	for _, id := range desired {
+		beforeRule := cato_models.PolicyRulePositionEnumBeforeRule
+		moveInput := cato_models.PolicyMoveRuleInput{
+			ID: id,
+			To: &cato_models.PolicyRulePositionInput{Position: &beforeRule, Ref: &cleanupID},
+		}
+		moveResp, err := client.PolicyInternetFirewallMoveRule(ctx, mutation, moveInput, accountID)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyInternetFirewallMoveRule error", err.Error())
+			return changed, diags
+		}
+		if moveResp.Policy.InternetFirewall.MoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range moveResp.Policy.InternetFirewall.MoveRule.GetErrors() {
+				diags.AddError("API Error Moving Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+			}
+			return changed, diags
+		}
+	}
+	return changed, diags
+}
+
+// syncWanSubPolicyTemplate mirrors syncIfwSubPolicyTemplate for WAN Firewall.
+//
+//nolint:funlen
+func syncWanSubPolicyTemplate(ctx context.Context, client WanFirewallSubPolicyClient, accountID, subID string, template types.Object) (bool, diag.Diagnostics) {
+	ref, ok, diags := decodeSubPolicyTemplateRef(ctx, template, subPolicyTemplateTypeWan)
+	if !ok {
+		return false, diags
+	}
+	var rules []wanTemplateRule
+	if err := json.Unmarshal([]byte(ref.Rules.ValueString()), &rules); err != nil {
+		diags.AddError("Invalid Sub-Policy Template", err.Error())
+		return false, diags
+	}
+
+	body, err := client.PolicyWanFirewall(ctx, &cato_models.WanFirewallPolicyInput{}, accountID)
+	if err != nil {
+		diags.AddError("Catov2 API PolicyWanFirewall error", err.Error())
+		return false, diags
+	}
+	cleanupID := wanSubPolicyCleanupRuleID(body, subID)
+	if cleanupID == "" {
+		diags.AddError("Cleanup Rule Not Found", fmt.Sprintf("No cleanup rule found for sub-policy %s.", subID))
+		return false, diags
+	}
+	children := wanSubPolicyChildRules(body, subID)
+	existing := make(map[string]string, len(children))
+	for _, c := range children {
+		existing[c.name] = c.id
+	}
+
+	changed := false
+	wanted := make(map[string]struct{}, len(rules))
+	desired := make([]string, 0, len(rules))
+	var added []string
+	for _, rule := range rules {
+		wanted[rule.Name] = struct{}{}
+		if id, found := existing[rule.Name]; found {
+			updateResp, err := client.PolicyWanFirewallUpdateRule(ctx, cato_models.WanFirewallUpdateRuleInput{ID: id, Rule: rule.Update}, accountID)
+			if err != nil {
+				diags.AddError("Catov2 API PolicyWanFirewallUpdateRule error", err.Error())
+				return changed, diags
+			}
+			changed = true
+			if updateResp.Policy.WanFirewall.UpdateRule.Status != ifwMutationStatusSuccess {
+				for _, e := range updateResp.Policy.WanFirewall.UpdateRule.GetErrors() {
+					diags.AddError("API Error Updating Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+				}
+				return changed, diags
+			}
+			desired = append(desired, id)
+			continue
+		}
+
+		beforeRule := cato_models.PolicyRulePositionEnumBeforeRule
+		addInput := cato_models.WanFirewallAddRuleInput{
+			At:   &cato_models.PolicyRulePositionInput{Position: &beforeRule, Ref: &cleanupID},
+			Rule: rule.Add,
+		}
+		addResp, err := client.PolicyWanFirewallAddRule(ctx, addInput, accountID)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanFirewallAddRule error", err.Error())
+			return changed, diags
+		}
+		changed = true
+		if addResp.Policy.WanFirewall.AddRule.Status != ifwMutationStatusSuccess {
+			for _, e := range addResp.Policy.WanFirewall.AddRule.GetErrors() {
+				diags.AddError("API Error Adding Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+			}
+			return changed, diags
+		}
+		id := addResp.GetPolicy().GetWanFirewall().GetAddRule().Rule.GetRule().ID
+		desired = append(desired, id)
+		added = append(added, id)
+	}
+
+	var kept []string
+	for _, c := range children {
+		if _, found := wanted[c.name]; found {
+			kept = append(kept, c.id)
+			continue
+		}
+		removeResp, err := client.PolicyWanFirewallRemoveRule(ctx, cato_models.WanFirewallRemoveRuleInput{ID: c.id}, accountID)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanFirewallRemoveRule error", err.Error())
+			return changed, diags
+		}
+		changed = true
+		if removeResp.Policy.WanFirewall.RemoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range removeResp.Policy.WanFirewall.RemoveRule.GetErrors() {
+				diags.AddError("API Error Removing Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+			}
+			return changed, diags
+		}
+	}
+
+	if slices.Equal(append(kept, added...), desired) {
+		return changed, diags
+	}
+	for _, id := range desired {
+		beforeRule := cato_models.PolicyRulePositionEnumBeforeRule
+		moveInput := cato_models.PolicyMoveRuleInput{
+			ID: id,
+			To: &cato_models.PolicyRulePositionInput{Position: &beforeRule, Ref: &cleanupID},
+		}
+		moveResp, err := client.PolicyWanFirewallMoveRule(ctx, moveInput, accountID)
+		if err != nil {
+			diags.AddError("Catov2 API PolicyWanFirewallMoveRule error", err.Error())
+			return changed, diags
+		}
+		if moveResp.Policy.WanFirewall.MoveRule.Status != ifwMutationStatusSuccess {
+			for _, e := range moveResp.Policy.WanFirewall.MoveRule.GetErrors() {
+				diags.AddError("API Error Moving Template Rule", fmt.Sprintf("%s : %s", derefStr(e.ErrorCode), derefStr(e.ErrorMessage)))
+			}
+			return changed, diags
+		}
+	}
+	return changed, diags
+}
+
+// subPolicyTemplatePrivateKey is the private state key holding the child rules
+// of a sub-policy as last synced from its template.
+const subPolicyTemplatePrivateKey = "template_rules"
+
+// subPolicyTemplateSynced is the private state value stored under
+// subPolicyTemplatePrivateKey: the template revision and the content
+// fingerprints of the child rules, in order, once that revision was synced.
+type subPolicyTemplateSynced struct {
+	Revision string   `json:"revision"`
+	Rules    []string `json:"rules"`
+}
+
+// subPolicyTemplateSyncedState encodes the private state value recording the
+// given child rules as the synced content of the template revision.
+func subPolicyTemplateSyncedState(ctx context.Context, template types.Object, policyType string, children []subPolicyChildRule) []byte {
+	ref, ok, diags := decodeSubPolicyTemplateRef(ctx, template, policyType)
+	if !ok || diags.HasError() {
+		return nil
+	}
+	synced := subPolicyTemplateSynced{Revision: ref.Revision.ValueString(), Rules: make([]string, 0, len(children))}
+	for _, child := range children {
+		synced.Rules = append(synced.Rules, child.content)
+	}
+	encoded, err := json.Marshal(synced)
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+// subPolicyTemplateDrifted reports whether the child rules of a sub-policy no
+// longer match its template: the rule names, in order, differ from the
+// template rule names, or the rule content differs from what was synced for
+// the template revision. synced is the private state value stored under
+// subPolicyTemplatePrivateKey, content is not compared when it is missing or
+// recorded for another revision.
+func subPolicyTemplateDrifted(ctx context.Context, template types.Object, policyType string, children []subPolicyChildRule, synced []byte) bool {
+	ref, ok, diags := decodeSubPolicyTemplateRef(ctx, template, policyType)
+	if !ok || diags.HasError() {
+		return false
+	}
+	var rules []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(ref.Rules.ValueString()), &rules); err != nil {
+		return false
+	}
+	if len(rules) != len(children) {
+		return true
+	}
+	for i := range rules {
+		if rules[i].Name != children[i].name {
+			return true
+		}
+	}
+	var prior subPolicyTemplateSynced
+	if len(synced) == 0 || json.Unmarshal(synced, &prior) != nil || prior.Revision != ref.Revision.ValueString() {
+		return false
+	}
+	if len(prior.Rules) != len(children) {
+		return true
+	}
+	for i := range children {
+		if prior.Rules[i] != children[i].content {
+			return true
+		}
+	}
+	return false
+}
+
+// markSubPolicyTemplateDrifted clears the template revision in state so the
+// next plan re-applies the template.
+func markSubPolicyTemplateDrifted(template types.Object) (types.Object, diag.Diagnostics) {
+	attrs := make(map[string]attr.Value, len(template.Attributes()))
+	for k, v := range template.Attributes() {
+		attrs[k] = v
+	}
+	attrs["revision"] = types.StringValue("")
+	return types.ObjectValue(SubPolicyTemplateRefAttrTypes, attrs)
+}
@@ -6,7 +6,8 @@ import (
 )
 
 type GlobalIPRangesModel struct {
-	Ranges types.Set `tfsdk:"ranges"` // []GlobalIPRange
+	Ranges    types.Set    `tfsdk:"ranges"` // []GlobalIPRange
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 type GlobalIPRange struct {
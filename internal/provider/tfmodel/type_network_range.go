@@ -20,6 +20,7 @@ type NetworkRange struct {
 	Subnet           types.String `tfsdk:"subnet"`
 	TranslatedSubnet types.String `tfsdk:"translated_subnet"`
 	Vlan             types.Int64  `tfsdk:"vlan"`
+	AccountID        types.String `tfsdk:"account_id"`
 }
 
 type DhcpSettings struct {
@@ -31,9 +32,10 @@ type DhcpSettings struct {
 }
 
 type NetworkRangeLookup struct {
-	SiteIDFilter types.List `tfsdk:"site_id_filter"`
-	NameFilter   types.List `tfsdk:"name_filter"`
-	Items        types.List `tfsdk:"items"`
+	SiteIDFilter types.List   `tfsdk:"site_id_filter"`
+	NameFilter   types.List   `tfsdk:"name_filter"`
+	Items        types.List   `tfsdk:"items"`
+	AccountID    types.String `tfsdk:"account_id"`
 }
 
 var DhcpSettingsAttrTypes = map[string]attr.Type{
@@ -15,6 +15,7 @@ type SocketSite struct {
 	NativeRange    types.Object `tfsdk:"native_range"`
 	SiteLocation   types.Object `tfsdk:"site_location"`
 	Sockets        types.Set    `tfsdk:"sockets"` // []Socket
+	AccountID      types.String `tfsdk:"account_id"`
 }
 
 type NativeRange struct {
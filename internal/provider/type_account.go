@@ -9,4 +9,5 @@ type Account struct {
 	Tenancy     types.String `tfsdk:"tenancy"`
 	Timezone    types.String `tfsdk:"timezone"`
 	Type        types.String `tfsdk:"type"`
+	AccountID   types.String `tfsdk:"account_id"`
 }
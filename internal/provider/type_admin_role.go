@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AdminRoleModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"` // []AdminRolePermission
+}
+
+type AdminRolePermission struct {
+	Access types.String `tfsdk:"access"`
+	Area   types.String `tfsdk:"area"`
+}
+
+var AdminRolePermissionTypes = map[string]attr.Type{
+	"access": types.StringType,
+	"area":   types.StringType,
+}
+
+type AdminRolesLookup struct {
+	NameFilter        types.List   `tfsdk:"name_filter"`
+	IncludePredefined types.Bool   `tfsdk:"include_predefined"`
+	Items             types.List   `tfsdk:"items"`
+	AccountID         types.String `tfsdk:"account_id"`
+}
+
+var AdminRoleItemAttrTypes = map[string]attr.Type{
+	"description":   types.StringType,
+	"id":            types.StringType,
+	"is_predefined": types.BoolType,
+	"name":          types.StringType,
+	"permissions":   types.SetType{ElemType: types.ObjectType{AttrTypes: AdminRolePermissionTypes}},
+}
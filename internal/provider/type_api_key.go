@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type APIKeyModel struct {
+	AccountID      types.String `tfsdk:"account_id"`
+	AllowedIPs     types.Set    `tfsdk:"allowed_ips"` // []string
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Description    types.String `tfsdk:"description"`
+	ExpirationDays types.Int64  `tfsdk:"expiration_days"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Role           types.Object `tfsdk:"role"` // IDNameRefModel
+	RotateAt       types.String `tfsdk:"rotate_at"`
+	RotationDays   types.Int64  `tfsdk:"rotation_days"`
+	Secret         types.String `tfsdk:"secret"`
+	StoreSecret    types.Bool   `tfsdk:"store_secret"`
+}
+
+type TemporaryAPIKeyModel struct {
+	AccountID  types.String `tfsdk:"account_id"`
+	AllowedIPs types.Set    `tfsdk:"allowed_ips"` // []string
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Role       types.Object `tfsdk:"role"` // IDNameRefModel
+	Secret     types.String `tfsdk:"secret"`
+	TTLMinutes types.Int64  `tfsdk:"ttl_minutes"`
+}
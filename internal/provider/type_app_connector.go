@@ -8,6 +8,7 @@ import (
 )
 
 type AppConnectorModel struct {
+	AccountID            types.String `tfsdk:"account_id"`
 	Description          types.String `tfsdk:"description"`
 	GroupName            types.String `tfsdk:"group_name"`
 	ID                   types.String `tfsdk:"id"`
@@ -59,3 +60,10 @@ var PreferredPopLocationModelTypes = map[string]attr.Type{
 	"primary":        types.ObjectType{AttrTypes: parse.IDNameRefModelTypes},
 	"secondary":      types.ObjectType{AttrTypes: parse.IDNameRefModelTypes},
 }
+
+type AppConnectorTokenModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	ConnectorID types.String `tfsdk:"connector_id"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Token       types.String `tfsdk:"token"`
+}
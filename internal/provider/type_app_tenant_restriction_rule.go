@@ -34,9 +34,11 @@ var AppTenantRestrictionRuleAttrTypes = map[string]attr.Type{
 
 // AppTenantRestrictionRule is the Terraform model for cato_app_tenant_restriction_rule.
 type AppTenantRestrictionRule struct {
-	ID   types.String `tfsdk:"id"`
-	At   types.Object `tfsdk:"at"`
-	Rule types.Object `tfsdk:"rule"`
+	ID              types.String `tfsdk:"id"`
+	At              types.Object `tfsdk:"at"`
+	Rule            types.Object `tfsdk:"rule"`
+	AccountID       types.String `tfsdk:"account_id"`
+	LifecycleWindow types.Object `tfsdk:"lifecycle_window"`
 }
 
 // AppTenantRestrictionRuleRulePlan maps the nested rule block (ObjectAs).
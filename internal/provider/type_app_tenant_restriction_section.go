@@ -4,7 +4,8 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // AppTenantRestrictionSection mirrors WAN section layout for Application Control policy.
 type AppTenantRestrictionSection struct {
-	ID      types.String `tfsdk:"id"`
-	At      types.Object `tfsdk:"at"`
-	Section types.Object `tfsdk:"section"`
+	ID        types.String `tfsdk:"id"`
+	At        types.Object `tfsdk:"at"`
+	Section   types.Object `tfsdk:"section"`
+	AccountID types.String `tfsdk:"account_id"`
 }
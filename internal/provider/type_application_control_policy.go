@@ -11,4 +11,5 @@ type ApplicationControlPolicyModel struct {
 	PublishedBy        types.String `tfsdk:"published_by"`
 	RevisionID         types.String `tfsdk:"revision_id"`
 	RevisionName       types.String `tfsdk:"revision_name"`
+	AccountID          types.String `tfsdk:"account_id"`
 }
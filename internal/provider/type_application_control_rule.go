@@ -70,9 +70,11 @@ var ApplicationControlRuleAttrTypes = map[string]attr.Type{
 
 // ApplicationControlRule is the Terraform model for cato_application_control_rule.
 type ApplicationControlRule struct {
-	ID   types.String `tfsdk:"id"`
-	At   types.Object `tfsdk:"at"`
-	Rule types.Object `tfsdk:"rule"`
+	ID              types.String `tfsdk:"id"`
+	At              types.Object `tfsdk:"at"`
+	Rule            types.Object `tfsdk:"rule"`
+	AccountID       types.String `tfsdk:"account_id"`
+	LifecycleWindow types.Object `tfsdk:"lifecycle_window"`
 }
 
 // ApplicationControlRuleRulePlan maps the nested rule block.
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CustomAppModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	Criteria    types.List   `tfsdk:"criteria"` // []CustomAppCriterion
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+type CustomAppCriterion struct {
+	Domains   types.Set    `tfsdk:"domains"`    // []types.String
+	Fqdns     types.Set    `tfsdk:"fqdns"`      // []types.String
+	IPRanges  types.List   `tfsdk:"ip_ranges"`  // []CustomAppIPRange
+	IPs       types.Set    `tfsdk:"ips"`        // []types.String
+	PortRange types.Object `tfsdk:"port_range"` // PortRange
+	Ports     types.Set    `tfsdk:"ports"`      // []types.Int64
+	Protocol  types.String `tfsdk:"protocol"`
+	Subnets   types.Set    `tfsdk:"subnets"` // []types.String
+}
+
+var CustomAppCriterionTypes = map[string]attr.Type{
+	"domains":    types.SetType{ElemType: types.StringType},
+	"fqdns":      types.SetType{ElemType: types.StringType},
+	"ip_ranges":  types.ListType{ElemType: types.ObjectType{AttrTypes: CustomAppIPRangeTypes}},
+	"ips":        types.SetType{ElemType: types.StringType},
+	"port_range": types.ObjectType{AttrTypes: PortRangeTypes},
+	"ports":      types.SetType{ElemType: types.Int64Type},
+	"protocol":   types.StringType,
+	"subnets":    types.SetType{ElemType: types.StringType},
+}
+
+type CustomAppIPRange struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+var CustomAppIPRangeTypes = map[string]attr.Type{
+	"from": types.StringType,
+	"to":   types.StringType,
+}
+
+type CustomCategoryModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	Applications types.Set    `tfsdk:"applications"` // []IDNameRefModel
+	CustomApps   types.Set    `tfsdk:"custom_apps"`  // []IDNameRefModel
+	Description  types.String `tfsdk:"description"`
+	Domains      types.Set    `tfsdk:"domains"` // []types.String
+	Fqdns        types.Set    `tfsdk:"fqdns"`   // []types.String
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+}
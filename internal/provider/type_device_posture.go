@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DevicePostureCheckModel struct {
+	AccountID      types.String `tfsdk:"account_id"`
+	Antivirus      types.Object `tfsdk:"antivirus"`   // DevicePostureAntivirus
+	Certificate    types.Object `tfsdk:"certificate"` // DevicePostureCertificate
+	Description    types.String `tfsdk:"description"`
+	DiskEncryption types.Object `tfsdk:"disk_encryption"` // DevicePostureVendor
+	Firewall       types.Object `tfsdk:"firewall"`        // DevicePostureVendor
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	OsVersion      types.Object `tfsdk:"os_version"` // DevicePostureOsVersion
+	Platform       types.String `tfsdk:"platform"`
+	Process        types.Object `tfsdk:"process"`  // DevicePostureProcess
+	Registry       types.Object `tfsdk:"registry"` // DevicePostureRegistry
+	Type           types.String `tfsdk:"type"`
+}
+
+type DevicePostureAntivirus struct {
+	MaxDefinitionsAgeDays types.Int64  `tfsdk:"max_definitions_age_days"`
+	RealTimeProtection    types.Bool   `tfsdk:"real_time_protection"`
+	Vendor                types.String `tfsdk:"vendor"`
+}
+
+var DevicePostureAntivirusTypes = map[string]attr.Type{
+	"max_definitions_age_days": types.Int64Type,
+	"real_time_protection":     types.BoolType,
+	"vendor":                   types.StringType,
+}
+
+type DevicePostureVendor struct {
+	Vendor types.String `tfsdk:"vendor"`
+}
+
+var DevicePostureVendorTypes = map[string]attr.Type{
+	"vendor": types.StringType,
+}
+
+type DevicePostureOsVersion struct {
+	MinVersion types.String `tfsdk:"min_version"`
+}
+
+var DevicePostureOsVersionTypes = map[string]attr.Type{
+	"min_version": types.StringType,
+}
+
+type DevicePostureCertificate struct {
+	Issuer  types.String `tfsdk:"issuer"`
+	Store   types.String `tfsdk:"store"`
+	Subject types.String `tfsdk:"subject"`
+}
+
+var DevicePostureCertificateTypes = map[string]attr.Type{
+	"issuer":  types.StringType,
+	"store":   types.StringType,
+	"subject": types.StringType,
+}
+
+type DevicePostureRegistry struct {
+	Path      types.String `tfsdk:"path"`
+	ValueData types.String `tfsdk:"value_data"`
+	ValueName types.String `tfsdk:"value_name"`
+}
+
+var DevicePostureRegistryTypes = map[string]attr.Type{
+	"path":       types.StringType,
+	"value_data": types.StringType,
+	"value_name": types.StringType,
+}
+
+type DevicePostureProcess struct {
+	Name   types.String `tfsdk:"name"`
+	Signer types.String `tfsdk:"signer"`
+}
+
+var DevicePostureProcessTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"signer": types.StringType,
+}
+
+type DevicePostureProfileModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	Checks      types.Set    `tfsdk:"checks"` // []IDNameRefModel
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
+)
+
+type DlpContentProfileModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	Conditions  types.List   `tfsdk:"conditions"` // []DlpDataTypeCondition
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Operator    types.String `tfsdk:"operator"`
+}
+
+type DlpDataTypeCondition struct {
+	DataType  types.Object `tfsdk:"data_type"` // IDNameRefModel
+	Threshold types.Int64  `tfsdk:"threshold"`
+}
+
+var DlpDataTypeConditionTypes = map[string]attr.Type{
+	"data_type": types.ObjectType{AttrTypes: parse.IDNameRefModelTypes},
+	"threshold": types.Int64Type,
+}
+
+type DlpEdmProfileModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	Columns      types.List   `tfsdk:"columns"` // []string
+	Delimiter    types.String `tfsdk:"delimiter"`
+	Description  types.String `tfsdk:"description"`
+	HasHeader    types.Bool   `tfsdk:"has_header"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	RowCount     types.Int64  `tfsdk:"row_count"`
+	SourceFile   types.String `tfsdk:"source_file"`
+	SourceSha256 types.String `tfsdk:"source_sha256"`
+}
+
+type DlpDataTypesLookup struct {
+	CategoryFilter types.List   `tfsdk:"category_filter"`
+	NameFilter     types.List   `tfsdk:"name_filter"`
+	Items          types.List   `tfsdk:"items"`
+	AccountID      types.String `tfsdk:"account_id"`
+}
+
+var DlpDataTypeItemAttrTypes = map[string]attr.Type{
+	"category":    types.StringType,
+	"description": types.StringType,
+	"id":          types.StringType,
+	"name":        types.StringType,
+}
@@ -10,12 +10,14 @@ type Group struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Members     types.Set    `tfsdk:"members"`
+	AccountID   types.String `tfsdk:"account_id"`
 }
 
 type GroupMembers struct {
 	ID        types.String `tfsdk:"id"`
 	GroupName types.String `tfsdk:"group_name"`
 	Members   types.Set    `tfsdk:"members"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 type GroupMember struct {
@@ -34,9 +36,10 @@ var GroupMemberObjectType = types.ObjectType{AttrTypes: GroupMemberAttrTypes}
 
 // GroupsLookup is the type for the groups data source with filters
 type GroupsLookup struct {
-	IDFilter   types.List `tfsdk:"id_filter"`
-	NameFilter types.List `tfsdk:"name_filter"`
-	Items      types.List `tfsdk:"items"`
+	IDFilter   types.List   `tfsdk:"id_filter"`
+	NameFilter types.List   `tfsdk:"name_filter"`
+	Items      types.List   `tfsdk:"items"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
 
 // GroupItemAttrTypes defines the attribute types for a group item in the lookup results
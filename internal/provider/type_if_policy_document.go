@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IfPolicyDocumentModel struct {
+	AccountID         types.String `tfsdk:"account_id"`
+	DeleteUnmanaged   types.Bool   `tfsdk:"delete_unmanaged"`
+	ID                types.String `tfsdk:"id"`
+	Rules             types.Map    `tfsdk:"rules"`    // map[rule name]IfPolicyDocumentRuleTypes
+	Sections          types.List   `tfsdk:"sections"` // []IfPolicyDocumentSection
+	UnmanagedRules    types.List   `tfsdk:"unmanaged_rules"`
+	UnmanagedSections types.List   `tfsdk:"unmanaged_sections"`
+}
+
+type IfPolicyDocumentSection struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Rules types.List   `tfsdk:"rules"` // []string
+}
+
+var IfPolicyDocumentSectionTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"name":  types.StringType,
+	"rules": types.ListType{ElemType: types.StringType},
+}
+
+// IfPolicyDocumentRuleTypes are the attributes of a cato_if_rule rule without its name,
+// which is the key of the rule in the document
+var IfPolicyDocumentRuleTypes = func() map[string]attr.Type {
+	ruleTypes := maps.Clone(InternetFirewallRuleRuleAttrTypes)
+	delete(ruleTypes, "name")
+	return ruleTypes
+}()
@@ -8,7 +8,7 @@ import (
 // resource. A sub-policy owns a SUB_POLICY_SCOPE rule (exposed here as the
 // embedded scope object, reusing the cato_if_rule schema and hydrators) plus an
 // API-managed cleanup rule. The Cato API has no updateSubPolicy mutation, so
-// name and description are RequiresReplace.
+// name and description are updated through the scope rule.
 type InternetFirewallSubPolicy struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -16,4 +16,6 @@ type InternetFirewallSubPolicy struct {
 	At          types.Object `tfsdk:"at"`            // *PolicyRulePositionInput
 	ScopeRuleID types.String `tfsdk:"scope_rule_id"` // computed SUB_POLICY_SCOPE rule id
 	Scope       types.Object `tfsdk:"scope"`         // PolicyPolicyInternetFirewallPolicyRulesRule
+	Template    types.Object `tfsdk:"template"`      // SubPolicyTemplateRef
+	AccountID   types.String `tfsdk:"account_id"`
 }
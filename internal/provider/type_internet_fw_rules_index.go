@@ -6,6 +6,10 @@ type IfwRulesIndex struct {
 	SectionToStartAfterID types.String `tfsdk:"section_to_start_after_id"`
 	RuleData              types.Map    `tfsdk:"rule_data"`
 	SectionData           types.Map    `tfsdk:"section_data"`
+	AccountID             types.String `tfsdk:"account_id"`
+	PlannedMoves          types.List   `tfsdk:"planned_moves"`
+	UnmanagedRules        types.String `tfsdk:"unmanaged_rules"`
+	UnmanagedRuleNames    types.List   `tfsdk:"unmanaged_rule_names"`
 }
 
 type IfwRulesSectionDataIndex struct {
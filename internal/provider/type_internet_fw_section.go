@@ -3,9 +3,10 @@ package provider
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type InternetFirewallSection struct {
-	ID      types.String `tfsdk:"id"`
-	At      types.Object `tfsdk:"at"`
-	Section types.Object `tfsdk:"section"`
+	ID        types.String `tfsdk:"id"`
+	At        types.Object `tfsdk:"at"`
+	Section   types.Object `tfsdk:"section"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 type PolicySectionPositionInput struct {
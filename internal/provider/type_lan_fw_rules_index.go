@@ -6,9 +6,13 @@ import (
 )
 
 type LanFwRulesIndex struct {
-	SectionData   types.Map `tfsdk:"section_data"`   // map[opaque_key]LanFwSectionData
-	NetworkRules  types.Map `tfsdk:"network_rules"`  // map[opaque_key]LanNetworkRule
-	FirewallRules types.Map `tfsdk:"firewall_rules"` // map[opaque_key]LanFirewallRule
+	SectionData        types.Map    `tfsdk:"section_data"`   // map[opaque_key]LanFwSectionData
+	NetworkRules       types.Map    `tfsdk:"network_rules"`  // map[opaque_key]LanNetworkRule
+	FirewallRules      types.Map    `tfsdk:"firewall_rules"` // map[opaque_key]LanFirewallRule
+	AccountID          types.String `tfsdk:"account_id"`
+	PlannedMoves       types.List   `tfsdk:"planned_moves"`
+	UnmanagedRules     types.String `tfsdk:"unmanaged_rules"`
+	UnmanagedRuleNames types.List   `tfsdk:"unmanaged_rule_names"`
 }
 
 type LanFwSectionData struct {
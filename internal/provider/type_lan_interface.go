@@ -13,6 +13,7 @@ type LanInterface struct {
 	Subnet           types.String `tfsdk:"subnet"`
 	TranslatedSubnet types.String `tfsdk:"translated_subnet"`
 	VrrpType         types.String `tfsdk:"vrrp_type"`
+	AccountID        types.String `tfsdk:"account_id"`
 }
 
 type LanInterfaceLagMember struct {
@@ -21,4 +22,5 @@ type LanInterfaceLagMember struct {
 	InterfaceID types.String `tfsdk:"interface_id"`
 	Name        types.String `tfsdk:"name"`
 	DestType    types.String `tfsdk:"dest_type"`
+	AccountID   types.String `tfsdk:"account_id"`
 }
@@ -16,6 +16,7 @@ type LanFirewallSubPolicy struct {
 	At          types.Object `tfsdk:"at"`            // PolicyRulePositionInput
 	ScopeRuleID types.String `tfsdk:"scope_rule_id"` // computed SUB_POLICY_SCOPE rule id
 	Scope       types.Object `tfsdk:"scope"`         // LanFirewallSubPolicyScope
+	AccountID   types.String `tfsdk:"account_id"`
 }
 
 type LanFirewallSubPolicyScope struct {
@@ -67,6 +67,7 @@ type LicenseResource struct {
 	LicenseID   types.String `tfsdk:"license_id"`
 	BW          types.Int64  `tfsdk:"bw"`
 	LicenseInfo types.Object `tfsdk:"license_info"`
+	AccountID   types.String `tfsdk:"account_id"`
 }
 
 type LicenseInfoResource struct {
@@ -112,6 +113,7 @@ type LicenseDataSource struct {
 	IsAssigned               types.Bool     `tfsdk:"is_assigned"`
 	GlobalLicenseAllocations types.Object   `tfsdk:"global_license_allocations"`
 	Licenses                 []types.Object `tfsdk:"licenses"`
+	AccountID                types.String   `tfsdk:"account_id"`
 }
 
 var LicenseDataSourceObjectType = types.ObjectType{AttrTypes: LicenseDataSourceAttrTypes}
@@ -181,3 +183,20 @@ var SiteAllocationAttrTypes = map[string]attr.Type{
 	"site":                NameIDObjectType,
 	"allocated_bandwidth": types.Int64Type,
 }
+
+// LicensePoolAllocationModel describes the license pool allocation resource model.
+type LicensePoolAllocationModel struct {
+	AccountID          types.String `tfsdk:"account_id"`
+	AllocatedBandwidth types.Map    `tfsdk:"allocated_bandwidth"` // map[site_id]int64
+	Allocations        types.Map    `tfsdk:"allocations"`         // map[site_id]LicensePoolSiteAllocationModel
+	ID                 types.String `tfsdk:"id"`
+	LicenseID          types.String `tfsdk:"license_id"`
+	PoolAvailable      types.Int64  `tfsdk:"pool_available"`
+	PoolTotal          types.Int64  `tfsdk:"pool_total"`
+	TotalBandwidth     types.Int64  `tfsdk:"total_bandwidth"`
+}
+
+type LicensePoolSiteAllocationModel struct {
+	Bandwidth types.Int64 `tfsdk:"bandwidth"`
+	Weight    types.Int64 `tfsdk:"weight"`
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MailingListModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	Emails    types.Set    `tfsdk:"emails"` // []string
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type WebhookModel struct {
+	AccountID              types.String `tfsdk:"account_id"`
+	Headers                types.Map    `tfsdk:"headers"` // map[string]string
+	ID                     types.String `tfsdk:"id"`
+	Method                 types.String `tfsdk:"method"`
+	Name                   types.String `tfsdk:"name"`
+	PayloadTemplate        types.String `tfsdk:"payload_template"`
+	SecretHeadersWo        types.Map    `tfsdk:"secret_headers_wo"` // map[string]string, write-only
+	SecretHeadersWoVersion types.Int64  `tfsdk:"secret_headers_wo_version"`
+	URL                    types.String `tfsdk:"url"`
+}
+
+type SubscriptionGroupModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	Emails       types.Set    `tfsdk:"emails"` // []string
+	ID           types.String `tfsdk:"id"`
+	MailingLists types.Set    `tfsdk:"mailing_lists"` // []IDNameRefModel
+	Name         types.String `tfsdk:"name"`
+	Webhooks     types.Set    `tfsdk:"webhooks"` // []IDNameRefModel
+}
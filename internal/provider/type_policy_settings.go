@@ -0,0 +1,14 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// PolicySettingsModel holds the account-level settings of a rule-based policy
+// (cato_if_policy, cato_wf_policy and cato_tls_policy).
+type PolicySettingsModel struct {
+	AccountID        types.String `tfsdk:"account_id"`
+	Audit            types.Object `tfsdk:"audit"` // PolicyAudit
+	DefaultAction    types.String `tfsdk:"default_action"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	ID               types.String `tfsdk:"id"`
+	LogImplicitRules types.Bool   `tfsdk:"log_implicit_rules"`
+}
@@ -6,9 +6,10 @@ import (
 )
 
 type PrivAccessPolicyModel struct {
-	Audit   types.Object `tfsdk:"audit"` // PolicyAudit
-	Enabled types.Bool   `tfsdk:"enabled"`
-	ID      types.String `tfsdk:"id"`
+	AccountID types.String `tfsdk:"account_id"`
+	Audit     types.Object `tfsdk:"audit"` // PolicyAudit
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	ID        types.String `tfsdk:"id"`
 }
 
 type PolicyAudit struct {
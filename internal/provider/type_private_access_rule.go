@@ -8,6 +8,7 @@ import (
 )
 
 type PrivateAccessRuleModel struct {
+	AccountID         types.String `tfsdk:"account_id"`
 	Action            types.String `tfsdk:"action"`             // e.g. "ALLOW"
 	ActivePeriod      types.Object `tfsdk:"active_period"`      // PolicyRuleActivePeriod
 	Applications      types.Set    `tfsdk:"applications"`       // []IDNameRefModel
@@ -6,9 +6,10 @@ import (
 )
 
 type PrivateAccessRuleBulkModel struct {
-	RuleData types.Map    `tfsdk:"rule_data"` // map[rule_name]PrivateAccessBulkRule
-	Publish  types.Int64  `tfsdk:"publish"`
-	ID       types.String `tfsdk:"id"`
+	RuleData  types.Map    `tfsdk:"rule_data"` // map[rule_name]PrivateAccessBulkRule
+	Publish   types.Int64  `tfsdk:"publish"`
+	ID        types.String `tfsdk:"id"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 type PrivateAccessBulkRule struct {
@@ -6,6 +6,7 @@ import (
 )
 
 type PrivateAppModel struct {
+	AccountID          types.String `tfsdk:"account_id"`
 	AllowIcmpProtocol  types.Bool   `tfsdk:"allow_icmp_protocol"`
 	CreationTime       types.String `tfsdk:"creation_time"`
 	Description        types.String `tfsdk:"description"`
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrivateAppsBulkModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	Apps      types.Map    `tfsdk:"apps"`   // map[app_name]PrivateAppsBulkApp
+	Errors    types.Map    `tfsdk:"errors"` // map[app_name]string
+	ID        types.String `tfsdk:"id"`
+}
+
+type PrivateAppsBulkApp struct {
+	AllowIcmpProtocol  types.Bool   `tfsdk:"allow_icmp_protocol"`
+	ConnectorGroupName types.String `tfsdk:"connector_group_name"`
+	Description        types.String `tfsdk:"description"`
+	ID                 types.String `tfsdk:"id"`
+	InternalAppAddress types.String `tfsdk:"internal_app_address"`
+	ProtocolPorts      types.Set    `tfsdk:"protocol_ports"` // []ProtocolPort
+	PublishedAppDomain types.String `tfsdk:"published_app_domain"`
+}
+
+var PrivateAppsBulkAppTypes = map[string]attr.Type{
+	"allow_icmp_protocol":  types.BoolType,
+	"connector_group_name": types.StringType,
+	"description":          types.StringType,
+	"id":                   types.StringType,
+	"internal_app_address": types.StringType,
+	"protocol_ports":       types.SetType{ElemType: types.ObjectType{AttrTypes: ProtocolPortTypes}},
+	"published_app_domain": types.StringType,
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SdpUserModel struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	Email       types.String `tfsdk:"email"`
+	FirstName   types.String `tfsdk:"first_name"`
+	ID          types.String `tfsdk:"id"`
+	LastName    types.String `tfsdk:"last_name"`
+	LicenseID   types.String `tfsdk:"license_id"`
+	Name        types.String `tfsdk:"name"`
+	PhoneNumber types.String `tfsdk:"phone_number"`
+	Status      types.String `tfsdk:"status"`
+	UserGroups  types.Set    `tfsdk:"user_groups"` // []IDNameRefModel
+}
+
+// UsersLookup is the type for the users data source with filters
+type UsersLookup struct {
+	IDFilter    types.List   `tfsdk:"id_filter"`
+	NameFilter  types.List   `tfsdk:"name_filter"`
+	EmailFilter types.List   `tfsdk:"email_filter"`
+	Items       types.List   `tfsdk:"items"`
+	AccountID   types.String `tfsdk:"account_id"`
+}
+
+// UserItemAttrTypes defines the attribute types for a user item in the lookup results
+var UserItemAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"name":  types.StringType,
+	"email": types.StringType,
+}
@@ -15,6 +15,7 @@ type SiteIpsecIkeV2 struct {
 	InterfaceID          types.String `tfsdk:"interface_id"`
 	SiteLocation         types.Object `tfsdk:"site_location"`
 	IPSec                types.Object `tfsdk:"ipsec"`
+	AccountID            types.String `tfsdk:"account_id"`
 }
 
 type AddIpsecSiteLocationInput struct {
@@ -27,6 +28,8 @@ type AddIpsecSiteLocationInput struct {
 
 type AddIpsecIkeV2SiteTunnelsInput struct {
 	SiteID             types.String `tfsdk:"site_id"`
+	IkeVersion         types.String `tfsdk:"ike_version"`         // IPSecIkeVersion enum
+	Dpd                types.Object `tfsdk:"dpd"`                 // IpsecDpdInput
 	Primary            types.Object `tfsdk:"primary"`             // AddIpsecIkeV2TunnelsInput
 	Secondary          types.Object `tfsdk:"secondary"`           // AddIpsecIkeV2TunnelsInput
 	ConnectionMode     types.String `tfsdk:"connection_mode"`     // ConnectionMode enum
@@ -50,6 +53,22 @@ type AddIpsecIkeV2TunnelInput struct {
 	PrivateSiteIP types.String `tfsdk:"private_site_ip"`
 	LastMileBw    types.Object `tfsdk:"last_mile_bw"` // *LastMileBwInput
 	Psk           types.String `tfsdk:"psk"`
+	RoutingType   types.String `tfsdk:"routing_type"` // IPSecRoutingType enum
+	Monitoring    types.Object `tfsdk:"monitoring"`   // *IpsecTunnelMonitoringInput
+}
+
+type IpsecTunnelMonitoringInput struct {
+	Type             types.String `tfsdk:"type"` // IPSecTunnelMonitoringType enum
+	DestinationIP    types.String `tfsdk:"destination_ip"`
+	URL              types.String `tfsdk:"url"`
+	Interval         types.Int64  `tfsdk:"interval"`
+	FailureThreshold types.Int64  `tfsdk:"failure_threshold"`
+}
+
+type IpsecDpdInput struct {
+	Enabled  types.Bool  `tfsdk:"enabled"`
+	Interval types.Int64 `tfsdk:"interval"`
+	Timeout  types.Int64 `tfsdk:"timeout"`
 }
 
 type LastMileBwInput struct {
@@ -83,6 +102,22 @@ var TunnelResourceAttrTypes = map[string]attr.Type{
 	"private_site_ip": types.StringType,
 	"psk":             types.StringType,
 	"last_mile_bw":    types.ObjectType{AttrTypes: LastMileBwResourceAttrTypes},
+	"routing_type":    types.StringType,
+	"monitoring":      types.ObjectType{AttrTypes: IpsecTunnelMonitoringResourceAttrTypes},
+}
+
+var IpsecTunnelMonitoringResourceAttrTypes = map[string]attr.Type{
+	"type":              types.StringType,
+	"destination_ip":    types.StringType,
+	"url":               types.StringType,
+	"interval":          types.Int64Type,
+	"failure_threshold": types.Int64Type,
+}
+
+var IpsecDpdResourceAttrTypes = map[string]attr.Type{
+	"enabled":  types.BoolType,
+	"interval": types.Int64Type,
+	"timeout":  types.Int64Type,
 }
 
 var LastMileBwResourceAttrTypes = map[string]attr.Type{
@@ -101,6 +136,8 @@ var IpsecTunnelsResourceAttrTypes = map[string]attr.Type{
 
 var IpsecResourceAttrTypes = map[string]attr.Type{
 	"site_id":             types.StringType,
+	"ike_version":         types.StringType,
+	"dpd":                 types.ObjectType{AttrTypes: IpsecDpdResourceAttrTypes},
 	"primary":             types.ObjectType{AttrTypes: IpsecTunnelsResourceAttrTypes},
 	"secondary":           types.ObjectType{AttrTypes: IpsecTunnelsResourceAttrTypes},
 	"connection_mode":     types.StringType,
@@ -0,0 +1,15 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type SiteStaticRoute struct {
+	ID             types.String `tfsdk:"id"`
+	SiteID         types.String `tfsdk:"site_id"`
+	Name           types.String `tfsdk:"name"`
+	Subnet         types.String `tfsdk:"subnet"`
+	NextHop        types.String `tfsdk:"next_hop"`
+	InterfaceID    types.String `tfsdk:"interface_id"`
+	InterfaceIndex types.String `tfsdk:"interface_index"`
+	Metric         types.Int64  `tfsdk:"metric"`
+	AccountID      types.String `tfsdk:"account_id"`
+}
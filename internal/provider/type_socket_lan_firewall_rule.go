@@ -7,8 +7,9 @@ import (
 
 // SocketLanFirewallRule represents the top-level resource structure for firewall rules
 type SocketLanFirewallRule struct {
-	Rule types.Object `tfsdk:"rule" json:"rule,omitempty"`
-	At   types.Object `tfsdk:"at" json:"at,omitempty"`
+	Rule      types.Object `tfsdk:"rule" json:"rule,omitempty"`
+	At        types.Object `tfsdk:"at" json:"at,omitempty"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 // SocketLanFirewallRulePositionInput represents the position input for firewall rules
@@ -7,8 +7,9 @@ import (
 
 // SocketLanNetworkRule represents the top-level resource structure
 type SocketLanNetworkRule struct {
-	Rule types.Object `tfsdk:"rule" json:"rule,omitempty"`
-	At   types.Object `tfsdk:"at" json:"at,omitempty"`
+	Rule      types.Object `tfsdk:"rule" json:"rule,omitempty"`
+	At        types.Object `tfsdk:"at" json:"at,omitempty"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 // SocketLanNetworkRuleData represents the rule data within the resource
@@ -8,4 +8,5 @@ type StaticHost struct {
 	Name       types.String `tfsdk:"name"`
 	IP         types.String `tfsdk:"ip"`
 	MacAddress types.String `tfsdk:"mac_address"`
+	AccountID  types.String `tfsdk:"account_id"`
 }
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SubPolicyTemplate is the Terraform model for the cato_sub_policy_template
+// resource. A template is a provider-side rule list; it has no API object of
+// its own and is materialized as the child rules of every cato_if_sub_policy or
+// cato_wf_sub_policy that references its ref attribute.
+type SubPolicyTemplate struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IfRules     types.List   `tfsdk:"if_rules"` // []PolicyPolicyInternetFirewallPolicyRulesRule without id
+	WfRules     types.List   `tfsdk:"wf_rules"` // []PolicyPolicyWanFirewallPolicyRulesRule without id
+	Ref         types.Object `tfsdk:"ref"`      // SubPolicyTemplateRef
+}
+
+// SubPolicyTemplateRef is the computed hand-off object a sub-policy resource
+// receives in its template attribute. Rules carries the hydrated API inputs as
+// JSON so the sub-policy can sync child rules without re-hydrating the template.
+type SubPolicyTemplateRef struct {
+	ID         types.String `tfsdk:"id"`
+	PolicyType types.String `tfsdk:"policy_type"`
+	Revision   types.String `tfsdk:"revision"`
+	Rules      types.String `tfsdk:"rules"`
+}
+
+var SubPolicyTemplateRefAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"policy_type": types.StringType,
+	"revision":    types.StringType,
+	"rules":       types.StringType,
+}
+
+// ifwTemplateRule is one Internet Firewall rule of a template as serialized in
+// SubPolicyTemplateRef.Rules.
+type ifwTemplateRule struct {
+	Name   string                                           `json:"name"`
+	Add    *cato_models.InternetFirewallAddRuleDataInput    `json:"add"`
+	Update *cato_models.InternetFirewallUpdateRuleDataInput `json:"update"`
+}
+
+// wanTemplateRule is one WAN Firewall rule of a template as serialized in
+// SubPolicyTemplateRef.Rules.
+type wanTemplateRule struct {
+	Name   string                                      `json:"name"`
+	Add    *cato_models.WanFirewallAddRuleDataInput    `json:"add"`
+	Update *cato_models.WanFirewallUpdateRuleDataInput `json:"update"`
+}
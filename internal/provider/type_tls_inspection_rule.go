@@ -7,9 +7,12 @@ import (
 
 // TLSInspectionRule represents the top-level resource structure
 type TLSInspectionRule struct {
-	At   types.Object `tfsdk:"at"`
-	Rule types.Object `tfsdk:"rule"`
-	ID   types.String `tfsdk:"id"`
+	At              types.Object `tfsdk:"at"`
+	Rule            types.Object `tfsdk:"rule"`
+	ID              types.String `tfsdk:"id"`
+	SubPolicyID     types.String `tfsdk:"sub_policy_id"`
+	AccountID       types.String `tfsdk:"account_id"`
+	LifecycleWindow types.Object `tfsdk:"lifecycle_window"`
 }
 
 // PolicyPolicyTLSInspectPolicyRulesRule represents the rule structure
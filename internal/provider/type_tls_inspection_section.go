@@ -3,7 +3,8 @@ package provider
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type TLSInspectionSection struct {
-	ID      types.String `tfsdk:"id"`
-	At      types.Object `tfsdk:"at"`
-	Section types.Object `tfsdk:"section"`
+	ID        types.String `tfsdk:"id"`
+	At        types.Object `tfsdk:"at"`
+	Section   types.Object `tfsdk:"section"`
+	AccountID types.String `tfsdk:"account_id"`
 }
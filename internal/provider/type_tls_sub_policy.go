@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TLSInspectSubPolicy is the Terraform model for the cato_tls_sub_policy
+// resource. It reuses the cato_tls_rule schema and hydrators for the embedded
+// scope object.
+type TLSInspectSubPolicy struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	At          types.Object `tfsdk:"at"`            // *PolicyRulePositionInput
+	ScopeRuleID types.String `tfsdk:"scope_rule_id"` // computed SUB_POLICY_SCOPE rule id
+	Scope       types.Object `tfsdk:"scope"`         // PolicyPolicyTLSInspectPolicyRulesRule
+	AccountID   types.String `tfsdk:"account_id"`
+}
@@ -7,9 +7,11 @@ import (
 )
 
 type WanFirewallRule struct {
-	Rule        types.Object `tfsdk:"rule" json:"rule,omitempty"` // PolicyPolicyWanFirewallPolicyRulesRule
-	At          types.Object `tfsdk:"at" json:"at,omitempty"`     // *PolicyRulePositionInput
-	SubPolicyID types.String `tfsdk:"sub_policy_id" json:"sub_policy_id,omitempty"`
+	Rule            types.Object `tfsdk:"rule" json:"rule,omitempty"` // PolicyPolicyWanFirewallPolicyRulesRule
+	At              types.Object `tfsdk:"at" json:"at,omitempty"`     // *PolicyRulePositionInput
+	SubPolicyID     types.String `tfsdk:"sub_policy_id" json:"sub_policy_id,omitempty"`
+	AccountID       types.String `tfsdk:"account_id"`
+	LifecycleWindow types.Object `tfsdk:"lifecycle_window"`
 }
 
 // type PolicyRulePositionInput struct {
@@ -12,6 +12,7 @@ type WanInterface struct {
 	Role                types.String `tfsdk:"role"`
 	Precedence          types.String `tfsdk:"precedence"`
 	// OffCloud            types.Object `tfsdk:"off_cloud"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 // type OffCloud struct {
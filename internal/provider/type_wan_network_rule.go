@@ -8,8 +8,11 @@ import (
 
 // WanNetworkRule represents the top-level resource
 type WanNetworkRule struct {
-	Rule types.Object `tfsdk:"rule" json:"rule,omitempty"` // PolicyPolicyWanNetworkPolicyRulesRule
-	At   types.Object `tfsdk:"at" json:"at,omitempty"`     // *PolicyRulePositionInput
+	Rule            types.Object `tfsdk:"rule" json:"rule,omitempty"` // PolicyPolicyWanNetworkPolicyRulesRule
+	At              types.Object `tfsdk:"at" json:"at,omitempty"`     // *PolicyRulePositionInput
+	SubPolicyID     types.String `tfsdk:"sub_policy_id" json:"sub_policy_id,omitempty"`
+	AccountID       types.String `tfsdk:"account_id"`
+	LifecycleWindow types.Object `tfsdk:"lifecycle_window"`
 }
 
 // PolicyPolicyWanNetworkPolicyRulesRule represents a WAN Network rule
@@ -7,7 +7,8 @@ import (
 // WanFirewallSubPolicy is the Terraform model for the cato_wf_sub_policy
 // resource. It mirrors InternetFirewallSubPolicy but reuses the cato_wf_rule
 // schema and hydrators for the embedded scope object. The Cato API has no
-// updateSubPolicy mutation, so name and description are RequiresReplace.
+// updateSubPolicy mutation, so name and description are updated through the
+// scope rule.
 type WanFirewallSubPolicy struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -15,4 +16,6 @@ type WanFirewallSubPolicy struct {
 	At          types.Object `tfsdk:"at"`            // *PolicyRulePositionInput
 	ScopeRuleID types.String `tfsdk:"scope_rule_id"` // computed SUB_POLICY_SCOPE rule id
 	Scope       types.Object `tfsdk:"scope"`         // PolicyPolicyWanFirewallPolicyRulesRule
+	Template    types.Object `tfsdk:"template"`      // SubPolicyTemplateRef
+	AccountID   types.String `tfsdk:"account_id"`
 }
@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WanNetworkSubPolicy is the Terraform model for the cato_wnw_sub_policy
+// resource. It reuses the cato_wnw_rule schema and hydrators for the embedded
+// scope object.
+type WanNetworkSubPolicy struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	At          types.Object `tfsdk:"at"`            // *PolicyRulePositionInput
+	ScopeRuleID types.String `tfsdk:"scope_rule_id"` // computed SUB_POLICY_SCOPE rule id
+	Scope       types.Object `tfsdk:"scope"`         // PolicyPolicyWanNetworkPolicyRulesRule
+	AccountID   types.String `tfsdk:"account_id"`
+}
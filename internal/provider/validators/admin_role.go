@@ -0,0 +1,31 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	cato_models "github.com/catonetworks/cato-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AdminRoleAreaValidator validates that the provided string is a valid admin role permission area
+type AdminRoleAreaValidator struct{}
+
+func (v AdminRoleAreaValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	area := cato_models.AccountRolePermissionArea(req.ConfigValue.ValueString())
+	if !area.IsValid() {
+		resp.Diagnostics.AddError("Field validation error", fmt.Sprintf("invalid permission area (%s: %s)\n - valid options: %+v",
+			req.Path.String(), area, cato_models.AllAccountRolePermissionArea))
+	}
+}
+
+func (v AdminRoleAreaValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Permission area must be one of: %v", cato_models.AllAccountRolePermissionArea)
+}
+func (v AdminRoleAreaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+func GetBgpRouteFilterValidator() BgpRouteFilterValidator {
+	return BgpRouteFilterValidator{}
+}
+
+// BgpRouteFilterValidator validates a single BGP peer route filter rule:
+// the rule must match on a prefix or a community, and the ge / le prefix length bounds
+// must fit the matched prefix
+type BgpRouteFilterValidator struct{}
+
+func (v BgpRouteFilterValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+
+	prefix := stringAttr(attrs, "prefix")
+	ge := int64Attr(attrs, "ge")
+	le := int64Attr(attrs, "le")
+	community, _ := attrs["community"].(types.List)
+
+	if prefix.IsUnknown() || community.IsUnknown() {
+		return
+	}
+	if prefix.IsNull() && (community.IsNull() || len(community.Elements()) == 0) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Configuration",
+			"route filter must match on a prefix, a community or both")
+		return
+	}
+
+	if prefix.IsNull() {
+		for name, value := range map[string]types.Int64{"ge": ge, "le": le} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(req.Path.AtName(name), "Invalid Configuration",
+					fmt.Sprintf("%s can only be set together with prefix", name))
+			}
+		}
+		return
+	}
+
+	_, ipNet, err := net.ParseCIDR(prefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("prefix"), "Invalid Configuration",
+			fmt.Sprintf("prefix '%s' is not a valid CIDR notation", prefix.ValueString()))
+		return
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+
+	if utils.HasValue(ge) && ge.ValueInt64() < int64(prefixLen) {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("ge"), "Invalid Configuration",
+			fmt.Sprintf("ge (%d) must not be lower than the prefix length (%d)", ge.ValueInt64(), prefixLen))
+	}
+	if utils.HasValue(le) && le.ValueInt64() < int64(prefixLen) {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("le"), "Invalid Configuration",
+			fmt.Sprintf("le (%d) must not be lower than the prefix length (%d)", le.ValueInt64(), prefixLen))
+	}
+	if utils.HasValue(ge) && utils.HasValue(le) && ge.ValueInt64() > le.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("le"), "Invalid Configuration",
+			fmt.Sprintf("le (%d) must not be lower than ge (%d)", le.ValueInt64(), ge.ValueInt64()))
+	}
+}
+
+func (v BgpRouteFilterValidator) Description(_ context.Context) string {
+	return "Validates the BGP route filter matches on a prefix or community with consistent prefix length bounds"
+}
+
+func (v BgpRouteFilterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
@@ -0,0 +1,113 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	testBgpCommunityAttrTypes = map[string]attr.Type{
+		"from": types.Int64Type,
+		"to":   types.Int64Type,
+	}
+	testBgpRouteFilterAttrTypes = map[string]attr.Type{
+		"action":    types.StringType,
+		"prefix":    types.StringType,
+		"ge":        types.Int64Type,
+		"le":        types.Int64Type,
+		"community": types.ListType{ElemType: types.ObjectType{AttrTypes: testBgpCommunityAttrTypes}},
+	}
+)
+
+func testBgpRouteFilter(prefix types.String, ge, le types.Int64, communities int) types.Object {
+	community := types.ListNull(types.ObjectType{AttrTypes: testBgpCommunityAttrTypes})
+	if communities > 0 {
+		elems := make([]attr.Value, 0, communities)
+		for i := range communities {
+			elems = append(elems, types.ObjectValueMust(testBgpCommunityAttrTypes, map[string]attr.Value{
+				"from": types.Int64Value(int64(65000 + i)),
+				"to":   types.Int64Value(int64(65000 + i)),
+			}))
+		}
+		community = types.ListValueMust(types.ObjectType{AttrTypes: testBgpCommunityAttrTypes}, elems)
+	}
+	return types.ObjectValueMust(testBgpRouteFilterAttrTypes, map[string]attr.Value{
+		"action":    types.StringValue("ACCEPT"),
+		"prefix":    prefix,
+		"ge":        ge,
+		"le":        le,
+		"community": community,
+	})
+}
+
+func TestBgpRouteFilterValidator(t *testing.T) {
+	t.Parallel()
+
+	null := types.Int64Null()
+	tests := []struct {
+		name       string
+		filter     types.Object
+		wantErrors int
+	}{
+		{
+			name:   "exact prefix",
+			filter: testBgpRouteFilter(types.StringValue("10.0.0.0/8"), null, null, 0),
+		},
+		{
+			name:   "prefix with length range",
+			filter: testBgpRouteFilter(types.StringValue("10.0.0.0/8"), types.Int64Value(16), types.Int64Value(24), 0),
+		},
+		{
+			name:   "community only",
+			filter: testBgpRouteFilter(types.StringNull(), null, null, 2),
+		},
+		{
+			name:   "unknown prefix skips validation",
+			filter: testBgpRouteFilter(types.StringUnknown(), types.Int64Value(4), null, 0),
+		},
+		{
+			name:       "no match criteria",
+			filter:     testBgpRouteFilter(types.StringNull(), null, null, 0),
+			wantErrors: 1,
+		},
+		{
+			name:       "length bounds without prefix",
+			filter:     testBgpRouteFilter(types.StringNull(), types.Int64Value(16), types.Int64Value(24), 1),
+			wantErrors: 2,
+		},
+		{
+			name:       "invalid prefix",
+			filter:     testBgpRouteFilter(types.StringValue("10.0.0.0"), null, null, 0),
+			wantErrors: 1,
+		},
+		{
+			name:       "bounds shorter than prefix",
+			filter:     testBgpRouteFilter(types.StringValue("10.1.0.0/16"), types.Int64Value(8), types.Int64Value(12), 0),
+			wantErrors: 2,
+		},
+		{
+			name:       "ge greater than le",
+			filter:     testBgpRouteFilter(types.StringValue("10.0.0.0/8"), types.Int64Value(24), types.Int64Value(16), 0),
+			wantErrors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ObjectRequest{Path: path.Root("inbound_filter").AtListIndex(0), ConfigValue: tt.filter}
+			resp := &validator.ObjectResponse{}
+			GetBgpRouteFilterValidator().ValidateObject(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Fatalf("expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+// protocols that carry port numbers
+var customAppPortProtocols = []string{"TCP", "UDP", "TCP_UDP"}
+
+// criterion attributes matching the traffic destination, at least one must be set
+var customAppMatchAttrs = []string{"ports", "port_range", "domains", "fqdns", "ips", "ip_ranges", "subnets"}
+
+func GetCustomAppCriterionValidator() CustomAppCriterionValidator {
+	return CustomAppCriterionValidator{}
+}
+
+// CustomAppCriterionValidator validates a single custom application criterion:
+// it must match on at least one destination attribute, ports require a TCP / UDP protocol
+// and the port and IP ranges must be ordered
+type CustomAppCriterionValidator struct{}
+
+func (v CustomAppCriterionValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+
+	hasMatch := false
+	for _, name := range customAppMatchAttrs {
+		if value, ok := attrs[name]; ok && !value.IsNull() {
+			hasMatch = true
+			break
+		}
+	}
+	if !hasMatch {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Configuration",
+			fmt.Sprintf("criterion must match on at least one of: %v", customAppMatchAttrs))
+	}
+
+	hasPorts := !attrs["ports"].IsNull() || !attrs["port_range"].IsNull()
+	protocol := stringAttr(attrs, "protocol")
+	if hasPorts && !protocol.IsUnknown() && !slices.Contains(customAppPortProtocols, protocol.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("protocol"), "Invalid Configuration",
+			fmt.Sprintf("ports require the protocol to be one of %v", customAppPortProtocols))
+	}
+
+	v.checkPortRange(&resp.Diagnostics, req.Path.AtName("port_range"), attrs["port_range"])
+	v.checkIPRanges(&resp.Diagnostics, req.Path.AtName("ip_ranges"), attrs["ip_ranges"])
+}
+
+func (v CustomAppCriterionValidator) checkPortRange(diags *diag.Diagnostics, p path.Path, value attr.Value) {
+	attrs, ok := objectAttrs(value)
+	if !ok {
+		return
+	}
+	from := int64Attr(attrs, "from")
+	to := int64Attr(attrs, "to")
+	if utils.HasValue(from) && utils.HasValue(to) && from.ValueInt64() > to.ValueInt64() {
+		diags.AddAttributeError(p.AtName("to"), "Invalid Configuration",
+			fmt.Sprintf("port range end (%d) must not be lower than its start (%d)", to.ValueInt64(), from.ValueInt64()))
+	}
+}
+
+func (v CustomAppCriterionValidator) checkIPRanges(diags *diag.Diagnostics, p path.Path, value attr.Value) {
+	ranges, ok := value.(types.List)
+	if !ok || ranges.IsNull() || ranges.IsUnknown() {
+		return
+	}
+
+	for i, elem := range ranges.Elements() {
+		attrs, ok := objectAttrs(elem)
+		if !ok {
+			continue
+		}
+		from := stringAttr(attrs, "from")
+		to := stringAttr(attrs, "to")
+		if !utils.HasValue(from) || !utils.HasValue(to) {
+			continue
+		}
+
+		rangePath := p.AtListIndex(i)
+		fromIP, fromErr := netip.ParseAddr(from.ValueString())
+		toIP, toErr := netip.ParseAddr(to.ValueString())
+		if fromErr != nil {
+			diags.AddAttributeError(rangePath.AtName("from"), "Invalid Configuration",
+				fmt.Sprintf("'%s' is not a valid IP address", from.ValueString()))
+		}
+		if toErr != nil {
+			diags.AddAttributeError(rangePath.AtName("to"), "Invalid Configuration",
+				fmt.Sprintf("'%s' is not a valid IP address", to.ValueString()))
+		}
+		if fromErr == nil && toErr == nil && fromIP.Compare(toIP) > 0 {
+			diags.AddAttributeError(rangePath.AtName("to"), "Invalid Configuration",
+				fmt.Sprintf("IP range end (%s) must not be lower than its start (%s)", to.ValueString(), from.ValueString()))
+		}
+	}
+}
+
+func (v CustomAppCriterionValidator) Description(_ context.Context) string {
+	return "Validates the custom application criterion match attributes and ranges"
+}
+
+func (v CustomAppCriterionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
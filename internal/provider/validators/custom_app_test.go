@@ -0,0 +1,159 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	testPortRangeAttrTypes = map[string]attr.Type{
+		"from": types.Int64Type,
+		"to":   types.Int64Type,
+	}
+	testIPRangeAttrTypes = map[string]attr.Type{
+		"from": types.StringType,
+		"to":   types.StringType,
+	}
+	testCustomAppCriterionAttrTypes = map[string]attr.Type{
+		"protocol":   types.StringType,
+		"ports":      types.SetType{ElemType: types.Int64Type},
+		"port_range": types.ObjectType{AttrTypes: testPortRangeAttrTypes},
+		"domains":    types.SetType{ElemType: types.StringType},
+		"fqdns":      types.SetType{ElemType: types.StringType},
+		"ips":        types.SetType{ElemType: types.StringType},
+		"ip_ranges":  types.ListType{ElemType: types.ObjectType{AttrTypes: testIPRangeAttrTypes}},
+		"subnets":    types.SetType{ElemType: types.StringType},
+	}
+)
+
+type testCustomAppCriterion struct {
+	protocol  types.String
+	ports     []int64
+	portRange []int64
+	domains   []string
+	ipRanges  [][2]string
+}
+
+func (c testCustomAppCriterion) object() types.Object {
+	ports := types.SetNull(types.Int64Type)
+	if c.ports != nil {
+		elems := make([]attr.Value, 0, len(c.ports))
+		for _, p := range c.ports {
+			elems = append(elems, types.Int64Value(p))
+		}
+		ports = types.SetValueMust(types.Int64Type, elems)
+	}
+
+	portRange := types.ObjectNull(testPortRangeAttrTypes)
+	if c.portRange != nil {
+		portRange = types.ObjectValueMust(testPortRangeAttrTypes, map[string]attr.Value{
+			"from": types.Int64Value(c.portRange[0]),
+			"to":   types.Int64Value(c.portRange[1]),
+		})
+	}
+
+	domains := types.SetNull(types.StringType)
+	if c.domains != nil {
+		elems := make([]attr.Value, 0, len(c.domains))
+		for _, d := range c.domains {
+			elems = append(elems, types.StringValue(d))
+		}
+		domains = types.SetValueMust(types.StringType, elems)
+	}
+
+	ipRanges := types.ListNull(types.ObjectType{AttrTypes: testIPRangeAttrTypes})
+	if c.ipRanges != nil {
+		elems := make([]attr.Value, 0, len(c.ipRanges))
+		for _, r := range c.ipRanges {
+			elems = append(elems, types.ObjectValueMust(testIPRangeAttrTypes, map[string]attr.Value{
+				"from": types.StringValue(r[0]),
+				"to":   types.StringValue(r[1]),
+			}))
+		}
+		ipRanges = types.ListValueMust(types.ObjectType{AttrTypes: testIPRangeAttrTypes}, elems)
+	}
+
+	return types.ObjectValueMust(testCustomAppCriterionAttrTypes, map[string]attr.Value{
+		"protocol":   c.protocol,
+		"ports":      ports,
+		"port_range": portRange,
+		"domains":    domains,
+		"fqdns":      types.SetNull(types.StringType),
+		"ips":        types.SetNull(types.StringType),
+		"ip_ranges":  ipRanges,
+		"subnets":    types.SetNull(types.StringType),
+	})
+}
+
+func TestCustomAppCriterionValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		criterion  testCustomAppCriterion
+		wantErrors int
+	}{
+		{
+			name:      "TCP ports and domains",
+			criterion: testCustomAppCriterion{protocol: types.StringValue("TCP"), ports: []int64{443, 8443}, domains: []string{"*.example.com"}},
+		},
+		{
+			name:      "UDP port range",
+			criterion: testCustomAppCriterion{protocol: types.StringValue("UDP"), portRange: []int64{5000, 5100}},
+		},
+		{
+			name:      "IP range without protocol",
+			criterion: testCustomAppCriterion{protocol: types.StringNull(), ipRanges: [][2]string{{"10.0.0.1", "10.0.0.20"}}},
+		},
+		{
+			name:      "unknown protocol skips the port check",
+			criterion: testCustomAppCriterion{protocol: types.StringUnknown(), ports: []int64{443}},
+		},
+		{
+			name:       "no match attributes",
+			criterion:  testCustomAppCriterion{protocol: types.StringValue("TCP")},
+			wantErrors: 1,
+		},
+		{
+			name:       "ports without protocol",
+			criterion:  testCustomAppCriterion{protocol: types.StringNull(), ports: []int64{443}},
+			wantErrors: 1,
+		},
+		{
+			name:       "ports with ICMP",
+			criterion:  testCustomAppCriterion{protocol: types.StringValue("ICMP"), portRange: []int64{1, 10}},
+			wantErrors: 1,
+		},
+		{
+			name:       "reversed port range",
+			criterion:  testCustomAppCriterion{protocol: types.StringValue("TCP"), portRange: []int64{100, 10}},
+			wantErrors: 1,
+		},
+		{
+			name: "invalid and reversed IP ranges",
+			criterion: testCustomAppCriterion{protocol: types.StringNull(), ipRanges: [][2]string{
+				{"10.0.0.20", "10.0.0.1"}, {"10.0.0", "10.0.0.1"},
+			}},
+			wantErrors: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ObjectRequest{Path: path.Root("criteria").AtListIndex(0), ConfigValue: tt.criterion.object()}
+			resp := &validator.ObjectResponse{}
+			GetCustomAppCriterionValidator().ValidateObject(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Fatalf("expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+		})
+	}
+}
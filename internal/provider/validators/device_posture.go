@@ -0,0 +1,43 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DevicePosturePlatformValidator validates a device posture check block is only set
+// for the platforms the check type is supported on
+type DevicePosturePlatformValidator struct {
+	Platforms []string
+}
+
+func (v DevicePosturePlatformValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var platform types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("platform"), &platform)...)
+	if resp.Diagnostics.HasError() || platform.IsNull() || platform.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.Platforms, platform.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Configuration",
+			fmt.Sprintf("%s check is not supported on platform %s, supported platforms: %v",
+				req.Path.String(), platform.ValueString(), v.Platforms))
+	}
+}
+
+func (v DevicePosturePlatformValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Check is only supported on platforms: %v", v.Platforms)
+}
+
+func (v DevicePosturePlatformValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
@@ -0,0 +1,83 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDevicePosturePlatformValidator(t *testing.T) {
+	t.Parallel()
+
+	registryAttrTypes := map[string]attr.Type{"path": types.StringType}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"platform": schema.StringAttribute{Optional: true},
+			"registry": schema.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: map[string]schema.Attribute{"path": schema.StringAttribute{Optional: true}},
+			},
+		},
+	}
+	registryValue := types.ObjectValueMust(registryAttrTypes, map[string]attr.Value{
+		"path": types.StringValue(`HKLM\SOFTWARE\Example`),
+	})
+
+	config := func(platform tftypes.Value) tfsdk.Config {
+		objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"platform": tftypes.String,
+			"registry": tftypes.Object{AttributeTypes: map[string]tftypes.Type{"path": tftypes.String}},
+		}}
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+				"platform": platform,
+				"registry": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"path": tftypes.String}},
+					map[string]tftypes.Value{"path": tftypes.NewValue(tftypes.String, `HKLM\SOFTWARE\Example`)}),
+			}),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		platform   tftypes.Value
+		value      types.Object
+		wantErrors int
+	}{
+		{name: "supported platform", platform: tftypes.NewValue(tftypes.String, "WINDOWS"), value: registryValue},
+		{
+			name: "unsupported platform", platform: tftypes.NewValue(tftypes.String, "MACOS"), value: registryValue,
+			wantErrors: 1,
+		},
+		{name: "unknown platform", platform: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), value: registryValue},
+		{
+			name: "block not set", platform: tftypes.NewValue(tftypes.String, "MACOS"),
+			value: types.ObjectNull(registryAttrTypes),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("registry"),
+				Config:      config(tt.platform),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.ObjectResponse{}
+			DevicePosturePlatformValidator{Platforms: []string{"WINDOWS"}}.ValidateObject(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Fatalf("expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+		})
+	}
+}
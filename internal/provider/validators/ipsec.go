@@ -0,0 +1,216 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/catonetworks/terraform-provider-cato/internal/utils"
+)
+
+const (
+	IpsecIkeV1 = "IKEV1"
+	IpsecIkeV2 = "IKEV2"
+
+	IpsecRoutingPolicyBased = "POLICY_BASED"
+	IpsecRoutingRouteBased  = "ROUTE_BASED"
+
+	IpsecMonitoringICMP = "ICMP"
+	IpsecMonitoringHTTP = "HTTP"
+)
+
+// IKEv1 does not negotiate a separate PRF and does not support AEAD ciphers in phase 1
+var (
+	ipsecIkeV1PrfValues        = []string{"AUTOMATIC", "NONE"}
+	ipsecIkeV1InitCipherDenied = []string{"AES_GCM_128", "AES_GCM_256"}
+)
+
+// IKEv1 sites support a single tunnel per primary / secondary group
+const ipsecIkeV1MaxTunnels = 1
+
+func GetIpsecValidator() IpsecValidator {
+	return IpsecValidator{}
+}
+
+// IpsecValidator validates the combinations of the ipsec settings allowed for each IKE version
+type IpsecValidator struct{}
+
+func (v IpsecValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+
+	ikeVersion := IpsecIkeV2
+	if version := stringAttr(attrs, "ike_version"); version.IsUnknown() {
+		return
+	} else if !version.IsNull() {
+		ikeVersion = version.ValueString()
+	}
+
+	if ikeVersion == IpsecIkeV1 {
+		v.checkIkeV1Message(&resp.Diagnostics, req.Path.AtName("init_message"), attrs["init_message"], true)
+		v.checkIkeV1Message(&resp.Diagnostics, req.Path.AtName("auth_message"), attrs["auth_message"], false)
+	}
+
+	v.checkDpd(&resp.Diagnostics, req.Path.AtName("dpd"), attrs["dpd"])
+
+	for _, group := range []string{"primary", "secondary"} {
+		v.checkTunnelGroup(&resp.Diagnostics, req.Path.AtName(group), attrs[group], ikeVersion)
+	}
+}
+
+func (v IpsecValidator) checkIkeV1Message(diags *diag.Diagnostics, p path.Path, value attr.Value, initMessage bool) {
+	attrs, ok := objectAttrs(value)
+	if !ok {
+		return
+	}
+
+	if prf := stringAttr(attrs, "prf"); utils.HasValue(prf) && !slices.Contains(ipsecIkeV1PrfValues, prf.ValueString()) {
+		diags.AddAttributeError(p.AtName("prf"), "Invalid Configuration",
+			fmt.Sprintf("prf '%s' is not supported with IKEV1, valid values: %v", prf.ValueString(), ipsecIkeV1PrfValues))
+	}
+
+	if !initMessage {
+		return
+	}
+	if cipher := stringAttr(attrs, "cipher"); utils.HasValue(cipher) && slices.Contains(ipsecIkeV1InitCipherDenied, cipher.ValueString()) {
+		diags.AddAttributeError(p.AtName("cipher"), "Invalid Configuration",
+			fmt.Sprintf("cipher '%s' is not supported in the IKEV1 init message", cipher.ValueString()))
+	}
+}
+
+func (v IpsecValidator) checkDpd(diags *diag.Diagnostics, p path.Path, value attr.Value) {
+	attrs, ok := objectAttrs(value)
+	if !ok {
+		return
+	}
+
+	interval := int64Attr(attrs, "interval")
+	timeout := int64Attr(attrs, "timeout")
+	if !utils.HasValue(interval) || !utils.HasValue(timeout) {
+		return
+	}
+	if timeout.ValueInt64() <= interval.ValueInt64() {
+		diags.AddAttributeError(p.AtName("timeout"), "Invalid Configuration",
+			fmt.Sprintf("dpd timeout (%d) must be greater than the dpd interval (%d)", timeout.ValueInt64(), interval.ValueInt64()))
+	}
+}
+
+func (v IpsecValidator) checkTunnelGroup(diags *diag.Diagnostics, p path.Path, value attr.Value, ikeVersion string) {
+	attrs, ok := objectAttrs(value)
+	if !ok {
+		return
+	}
+	tunnels, ok := attrs["tunnels"].(types.List)
+	if !ok || tunnels.IsNull() || tunnels.IsUnknown() {
+		return
+	}
+
+	if ikeVersion == IpsecIkeV1 && len(tunnels.Elements()) > ipsecIkeV1MaxTunnels {
+		diags.AddAttributeError(p.AtName("tunnels"), "Invalid Configuration",
+			fmt.Sprintf("IKEV1 sites support at most %d tunnel per group, got %d", ipsecIkeV1MaxTunnels, len(tunnels.Elements())))
+	}
+
+	for i, elem := range tunnels.Elements() {
+		tunnelAttrs, ok := objectAttrs(elem)
+		if !ok {
+			continue
+		}
+		tunnelPath := p.AtName("tunnels").AtListIndex(i)
+		v.checkRoutingType(diags, tunnelPath, tunnelAttrs, ikeVersion)
+		v.checkMonitoring(diags, tunnelPath.AtName("monitoring"), tunnelAttrs["monitoring"])
+	}
+}
+
+func (v IpsecValidator) checkRoutingType(diags *diag.Diagnostics, p path.Path, attrs map[string]attr.Value, ikeVersion string) {
+	routingType := stringAttr(attrs, "routing_type")
+	if !utils.HasValue(routingType) || routingType.ValueString() != IpsecRoutingRouteBased {
+		return
+	}
+
+	if ikeVersion == IpsecIkeV1 {
+		diags.AddAttributeError(p.AtName("routing_type"), "Invalid Configuration",
+			"routing_type ROUTE_BASED is only supported with IKEV2")
+		return
+	}
+
+	// route-based tunnels run BGP over the tunnel private IPs
+	for _, name := range []string{"private_cato_ip", "private_site_ip"} {
+		if ip := stringAttr(attrs, name); ip.IsNull() {
+			diags.AddAttributeError(p.AtName(name), "Invalid Configuration",
+				fmt.Sprintf("%s is required when routing_type is ROUTE_BASED", name))
+		}
+	}
+}
+
+func (v IpsecValidator) checkMonitoring(diags *diag.Diagnostics, p path.Path, value attr.Value) {
+	attrs, ok := objectAttrs(value)
+	if !ok {
+		return
+	}
+
+	monitoringType := stringAttr(attrs, "type")
+	if !utils.HasValue(monitoringType) {
+		return
+	}
+	destinationIP := stringAttr(attrs, "destination_ip")
+	url := stringAttr(attrs, "url")
+
+	switch monitoringType.ValueString() {
+	case IpsecMonitoringICMP:
+		if destinationIP.IsNull() {
+			diags.AddAttributeError(p.AtName("destination_ip"), "Invalid Configuration",
+				"destination_ip is required for ICMP tunnel monitoring")
+		}
+		if !url.IsNull() {
+			diags.AddAttributeError(p.AtName("url"), "Invalid Configuration",
+				"url can only be set for HTTP tunnel monitoring")
+		}
+	case IpsecMonitoringHTTP:
+		if url.IsNull() {
+			diags.AddAttributeError(p.AtName("url"), "Invalid Configuration",
+				"url is required for HTTP tunnel monitoring")
+		}
+		if !destinationIP.IsNull() {
+			diags.AddAttributeError(p.AtName("destination_ip"), "Invalid Configuration",
+				"destination_ip can only be set for ICMP tunnel monitoring")
+		}
+	}
+}
+
+func (v IpsecValidator) Description(_ context.Context) string {
+	return "Validates the ipsec settings allowed for the selected IKE version"
+}
+
+func (v IpsecValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func objectAttrs(value attr.Value) (map[string]attr.Value, bool) {
+	obj, ok := value.(types.Object)
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		return nil, false
+	}
+	return obj.Attributes(), true
+}
+
+func stringAttr(attrs map[string]attr.Value, name string) types.String {
+	if value, ok := attrs[name].(types.String); ok {
+		return value
+	}
+	return types.StringNull()
+}
+
+func int64Attr(attrs map[string]attr.Value, name string) types.Int64 {
+	if value, ok := attrs[name].(types.Int64); ok {
+		return value
+	}
+	return types.Int64Null()
+}
@@ -0,0 +1,219 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	testIpsecMessageAttrTypes = map[string]attr.Type{
+		"cipher": types.StringType,
+		"prf":    types.StringType,
+	}
+	testIpsecMonitoringAttrTypes = map[string]attr.Type{
+		"type":           types.StringType,
+		"destination_ip": types.StringType,
+		"url":            types.StringType,
+	}
+	testIpsecTunnelAttrTypes = map[string]attr.Type{
+		"routing_type":    types.StringType,
+		"private_cato_ip": types.StringType,
+		"private_site_ip": types.StringType,
+		"monitoring":      types.ObjectType{AttrTypes: testIpsecMonitoringAttrTypes},
+	}
+	testIpsecGroupAttrTypes = map[string]attr.Type{
+		"tunnels": types.ListType{ElemType: types.ObjectType{AttrTypes: testIpsecTunnelAttrTypes}},
+	}
+	testIpsecDpdAttrTypes = map[string]attr.Type{
+		"interval": types.Int64Type,
+		"timeout":  types.Int64Type,
+	}
+	testIpsecAttrTypes = map[string]attr.Type{
+		"ike_version":  types.StringType,
+		"dpd":          types.ObjectType{AttrTypes: testIpsecDpdAttrTypes},
+		"init_message": types.ObjectType{AttrTypes: testIpsecMessageAttrTypes},
+		"auth_message": types.ObjectType{AttrTypes: testIpsecMessageAttrTypes},
+		"primary":      types.ObjectType{AttrTypes: testIpsecGroupAttrTypes},
+		"secondary":    types.ObjectType{AttrTypes: testIpsecGroupAttrTypes},
+	}
+)
+
+type testIpsecTunnel struct {
+	routingType   string
+	privateIPs    bool
+	monitorType   string
+	monitorIP     string
+	monitorURL    string
+	hasMonitoring bool
+}
+
+type testIpsecConfig struct {
+	ikeVersion  types.String
+	initCipher  string
+	authPrf     string
+	dpdInterval int64
+	dpdTimeout  int64
+	tunnels     []testIpsecTunnel
+}
+
+func optionalString(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func (c testIpsecConfig) object() types.Object {
+	message := func(cipher, prf string) types.Object {
+		if cipher == "" && prf == "" {
+			return types.ObjectNull(testIpsecMessageAttrTypes)
+		}
+		return types.ObjectValueMust(testIpsecMessageAttrTypes, map[string]attr.Value{
+			"cipher": optionalString(cipher),
+			"prf":    optionalString(prf),
+		})
+	}
+
+	dpd := types.ObjectNull(testIpsecDpdAttrTypes)
+	if c.dpdInterval != 0 || c.dpdTimeout != 0 {
+		dpd = types.ObjectValueMust(testIpsecDpdAttrTypes, map[string]attr.Value{
+			"interval": types.Int64Value(c.dpdInterval),
+			"timeout":  types.Int64Value(c.dpdTimeout),
+		})
+	}
+
+	tunnels := make([]attr.Value, 0, len(c.tunnels))
+	for _, tunnel := range c.tunnels {
+		monitoring := types.ObjectNull(testIpsecMonitoringAttrTypes)
+		if tunnel.hasMonitoring {
+			monitoring = types.ObjectValueMust(testIpsecMonitoringAttrTypes, map[string]attr.Value{
+				"type":           types.StringValue(tunnel.monitorType),
+				"destination_ip": optionalString(tunnel.monitorIP),
+				"url":            optionalString(tunnel.monitorURL),
+			})
+		}
+		privateIP := types.StringNull()
+		if tunnel.privateIPs {
+			privateIP = types.StringValue("169.254.0.1")
+		}
+		tunnels = append(tunnels, types.ObjectValueMust(testIpsecTunnelAttrTypes, map[string]attr.Value{
+			"routing_type":    optionalString(tunnel.routingType),
+			"private_cato_ip": privateIP,
+			"private_site_ip": privateIP,
+			"monitoring":      monitoring,
+		}))
+	}
+
+	return types.ObjectValueMust(testIpsecAttrTypes, map[string]attr.Value{
+		"ike_version":  c.ikeVersion,
+		"dpd":          dpd,
+		"init_message": message(c.initCipher, ""),
+		"auth_message": message("", c.authPrf),
+		"primary": types.ObjectValueMust(testIpsecGroupAttrTypes, map[string]attr.Value{
+			"tunnels": types.ListValueMust(types.ObjectType{AttrTypes: testIpsecTunnelAttrTypes}, tunnels),
+		}),
+		"secondary": types.ObjectNull(testIpsecGroupAttrTypes),
+	})
+}
+
+func TestIpsecValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		cfg        testIpsecConfig
+		wantErrors int
+	}{
+		{
+			name: "default IKEV2 route based with private IPs",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringNull(),
+				initCipher: "AES_GCM_256",
+				tunnels:    []testIpsecTunnel{{routingType: IpsecRoutingRouteBased, privateIPs: true}, {}},
+			},
+		},
+		{
+			name: "unknown IKE version skips validation",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringUnknown(),
+				tunnels:    []testIpsecTunnel{{routingType: IpsecRoutingRouteBased}},
+			},
+		},
+		{
+			name: "route based requires private IPs",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringValue(IpsecIkeV2),
+				tunnels:    []testIpsecTunnel{{routingType: IpsecRoutingRouteBased}},
+			},
+			wantErrors: 2,
+		},
+		{
+			name: "IKEV1 policy based single tunnel",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringValue(IpsecIkeV1),
+				initCipher: "AES_CBC_256",
+				authPrf:    "AUTOMATIC",
+				tunnels:    []testIpsecTunnel{{routingType: IpsecRoutingPolicyBased}},
+			},
+		},
+		{
+			name: "IKEV1 rejects GCM init cipher, PRF, route based and multiple tunnels",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringValue(IpsecIkeV1),
+				initCipher: "AES_GCM_128",
+				authPrf:    "SHA256",
+				tunnels:    []testIpsecTunnel{{routingType: IpsecRoutingRouteBased, privateIPs: true}, {}},
+			},
+			wantErrors: 4,
+		},
+		{
+			name: "dpd timeout must exceed interval",
+			cfg: testIpsecConfig{
+				ikeVersion:  types.StringValue(IpsecIkeV2),
+				dpdInterval: 10,
+				dpdTimeout:  10,
+			},
+			wantErrors: 1,
+		},
+		{
+			name: "valid monitoring probes",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringValue(IpsecIkeV2),
+				tunnels: []testIpsecTunnel{
+					{hasMonitoring: true, monitorType: IpsecMonitoringICMP, monitorIP: "10.0.0.1"},
+					{hasMonitoring: true, monitorType: IpsecMonitoringHTTP, monitorURL: "http://10.0.0.1/health"},
+				},
+			},
+		},
+		{
+			name: "monitoring destinations must match the probe type",
+			cfg: testIpsecConfig{
+				ikeVersion: types.StringValue(IpsecIkeV2),
+				tunnels: []testIpsecTunnel{
+					{hasMonitoring: true, monitorType: IpsecMonitoringICMP, monitorURL: "http://10.0.0.1/health"},
+					{hasMonitoring: true, monitorType: IpsecMonitoringHTTP, monitorIP: "10.0.0.1"},
+				},
+			},
+			wantErrors: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ObjectRequest{Path: path.Root("ipsec"), ConfigValue: tt.cfg.object()}
+			resp := &validator.ObjectResponse{}
+			GetIpsecValidator().ValidateObject(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Fatalf("expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/catonetworks/terraform-provider-cato/internal/provider/parse"
 )
 
-// PlatformValidator validates that the provided set of strings are valid platforms
+// PlatformValidator validates that the provided set of strings (or a single string) are valid platforms
 type PlatformValidator struct{}
 
 func (v PlatformValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
@@ -35,6 +35,18 @@ func (v PlatformValidator) ValidateSet(ctx context.Context, req validator.SetReq
 	}
 }
 
+func (v PlatformValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	platform := cato_models.OperatingSystem(req.ConfigValue.ValueString())
+	if !platform.IsValid() {
+		resp.Diagnostics.AddError("Field validation error", fmt.Sprintf("invalid platform (%s: %s)\n - valid options: %+v", req.Path.String(),
+			platform, cato_models.AllOperatingSystem))
+	}
+}
+
 func (v PlatformValidator) Description(_ context.Context) string {
 	return fmt.Sprintf("Platforms must be one of: %v", cato_models.AllOperatingSystem)
 }